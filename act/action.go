@@ -0,0 +1,105 @@
+// Package act provides actions - what a creature does on its turn: attack an
+// enemy, cast a spell, support an ally, move, or do nothing.
+package act
+
+import (
+	"fmt"
+
+	"github.com/rozag/cabasi/atk"
+)
+
+// Kind represents a kind of an action.
+type Kind uint8
+
+const (
+	// KindNone means the creature does nothing.
+	KindNone Kind = iota
+	// KindAttack makes an attack against enemies.
+	KindAttack
+	// KindSpell casts a spell from a spellbook against enemies.
+	KindSpell
+	// KindSupport uses a support - an ability or an item - on allies.
+	KindSupport
+	// KindMove moves the creature to another zone.
+	KindMove
+	// KindCharge closes on an enemy out of the reach of a creature already
+	// engaged in the melee, pulling the enemy into the melee.
+	KindCharge
+)
+
+// String returns the string representation of the Kind.
+func (k Kind) String() string {
+	switch k {
+	case KindNone:
+		return "None"
+	case KindAttack:
+		return "Attack"
+	case KindSpell:
+		return "Spell"
+	case KindSupport:
+		return "Support"
+	case KindMove:
+		return "Move"
+	case KindCharge:
+		return "Charge"
+	default:
+		panic(fmt.Errorf("unknown Kind: %d", k))
+	}
+}
+
+// IsHostile checks if actions of the kind target enemies. Supports target
+// allies instead, and moves and charges have no targets.
+func (k Kind) IsHostile() bool {
+	return k == KindAttack || k == KindSpell
+}
+
+// Action is an action picked by a creature. Idx is the index of the attack,
+// the spellbook, or the support in the creature's respective slice depending
+// on the Kind, or the index of the charged enemy for charges. IsEnhanced
+// applies to spells only. Zone is the zone to move to and applies to moves
+// only.
+type Action struct {
+	Idx        uint
+	Kind       Kind
+	IsEnhanced bool
+	Zone       atk.Zone
+}
+
+// None returns the action of doing nothing.
+func None() Action {
+	return Action{Idx: 0, Kind: KindNone, IsEnhanced: false, Zone: 0}
+}
+
+// Attack returns the action of making the attack at the provided index.
+func Attack(attackIdx uint) Action {
+	return Action{Idx: attackIdx, Kind: KindAttack, IsEnhanced: false, Zone: 0}
+}
+
+// Spell returns the action of casting the spell from the spellbook at the
+// provided index.
+func Spell(bookIdx uint, isEnhanced bool) Action {
+	return Action{Idx: bookIdx, Kind: KindSpell, IsEnhanced: isEnhanced, Zone: 0}
+}
+
+// Use returns the action of using the support at the provided index.
+func Use(supportIdx uint) Action {
+	return Action{Idx: supportIdx, Kind: KindSupport, IsEnhanced: false, Zone: 0}
+}
+
+// Move returns the action of moving to the provided zone.
+func Move(zone atk.Zone) Action {
+	return Action{Idx: 0, Kind: KindMove, IsEnhanced: false, Zone: zone}
+}
+
+// Charge returns the action of charging the enemy at the provided index.
+func Charge(enemyIdx uint) Action {
+	return Action{Idx: enemyIdx, Kind: KindCharge, IsEnhanced: false, Zone: 0}
+}
+
+// String returns the string representation of the Action.
+func (a Action) String() string {
+	return fmt.Sprintf(
+		"Action{Kind: %s, Idx: %d, IsEnhanced: %t, Zone: %s}",
+		a.Kind, a.Idx, a.IsEnhanced, a.Zone,
+	)
+}
@@ -0,0 +1,76 @@
+package act
+
+import (
+	"testing"
+
+	"github.com/rozag/cabasi/atk"
+)
+
+func TestKindIsHostile(t *testing.T) {
+	tests := []struct {
+		kind Kind
+		want bool
+	}{
+		{kind: KindNone, want: false},
+		{kind: KindAttack, want: true},
+		{kind: KindSpell, want: true},
+		{kind: KindSupport, want: false},
+		{kind: KindMove, want: false},
+		{kind: KindCharge, want: false},
+	}
+	for _, test := range tests {
+		t.Run(test.kind.String(), func(t *testing.T) {
+			if got := test.kind.IsHostile(); got != test.want {
+				t.Fatalf("Kind.IsHostile() = %t, want %t", got, test.want)
+			}
+		})
+	}
+}
+
+func TestActionConstructors(t *testing.T) {
+	tests := []struct {
+		name string
+		got  Action
+		want Action
+	}{
+		{
+			name: "None",
+			got:  None(),
+			want: Action{Idx: 0, Kind: KindNone, IsEnhanced: false, Zone: 0},
+		},
+		{
+			name: "Attack",
+			got:  Attack(2),
+			want: Action{Idx: 2, Kind: KindAttack, IsEnhanced: false, Zone: 0},
+		},
+		{
+			name: "Spell",
+			got:  Spell(1, true),
+			want: Action{Idx: 1, Kind: KindSpell, IsEnhanced: true, Zone: 0},
+		},
+		{
+			name: "Use",
+			got:  Use(3),
+			want: Action{Idx: 3, Kind: KindSupport, IsEnhanced: false, Zone: 0},
+		},
+		{
+			name: "Move",
+			got:  Move(atk.ZoneFar),
+			want: Action{
+				Idx: 0, Kind: KindMove, IsEnhanced: false, Zone: atk.ZoneFar,
+			},
+		},
+		{
+			name: "Charge",
+			got:  Charge(1),
+			want: Action{Idx: 1, Kind: KindCharge, IsEnhanced: false, Zone: 0},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.got != test.want {
+				t.Fatalf("%s() = %s, want %s", test.name, test.got, test.want)
+			}
+		})
+	}
+}
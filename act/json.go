@@ -0,0 +1,98 @@
+package act
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/rozag/cabasi/atk"
+	"github.com/rozag/cabasi/internal/codec"
+)
+
+// supportJSON is the JSON encoding of a Support. Dice is omitted if it's not
+// set, e.g. for SupportProtect.
+type supportJSON struct {
+	Name    string          `json:"name"`
+	Kind    string          `json:"kind"`
+	Dice    string          `json:"dice,omitempty"`
+	Charges json.RawMessage `json:"charges"`
+	DiceCnt uint8           `json:"diceCnt"`
+	Rounds  int8            `json:"rounds"`
+}
+
+// MarshalJSON implements json.Marshaler. Kind and Dice are encoded as their
+// string representations, e.g. "Heal" and "d6", and charges as a number or
+// "unlimited".
+func (s *Support) MarshalJSON() ([]byte, error) {
+	var errs []error
+
+	kind, err := s.Kind.MarshalText()
+	if err != nil {
+		errs = append(errs, codec.WrapField("kind", err))
+	}
+
+	var d []byte
+	if s.Dice != 0 {
+		if d, err = s.Dice.MarshalText(); err != nil {
+			errs = append(errs, codec.WrapField("dice", err))
+		}
+	}
+
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	// Charges are always encoded successfully.
+	charges, _ := atk.Charges(s.Charges).MarshalJSON()
+	data, err := json.Marshal(supportJSON{
+		Name: s.Name, Kind: string(kind), Dice: string(d), Charges: charges,
+		DiceCnt: s.DiceCnt, Rounds: s.Rounds,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode support: %w", err)
+	}
+	return data, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Missing charges default to
+// unlimited. Errors of the fields are reported as *codec.FieldError. The
+// decoded support isn't validated, see Validate.
+func (s *Support) UnmarshalJSON(data []byte) error {
+	var raw supportJSON
+	if err := codec.DecodeJSON(data, &raw); err != nil {
+		// Suppressing wrapcheck "error returned from external package is
+		// unwrapped" because the error already reports the failed field.
+		return err // nolint:wrapcheck
+	}
+
+	var errs []error
+	support := Support{
+		Name: raw.Name, Kind: SupportHeal, Dice: 0, DiceCnt: raw.DiceCnt,
+		Rounds: raw.Rounds, Charges: -1,
+	}
+
+	if err := support.Kind.UnmarshalText([]byte(raw.Kind)); err != nil {
+		errs = append(errs, codec.WrapField("kind", err))
+	}
+
+	if len(raw.Dice) > 0 {
+		if err := support.Dice.UnmarshalText([]byte(raw.Dice)); err != nil {
+			errs = append(errs, codec.WrapField("dice", err))
+		}
+	}
+
+	if len(raw.Charges) > 0 && string(raw.Charges) != "null" {
+		var charges atk.Charges
+		if err := charges.UnmarshalJSON(raw.Charges); err != nil {
+			errs = append(errs, codec.WrapField("charges", err))
+		}
+		support.Charges = int8(charges)
+	}
+
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	*s = support
+	return nil
+}
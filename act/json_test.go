@@ -0,0 +1,110 @@
+package act
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/rozag/cabasi/dice"
+	"github.com/rozag/cabasi/internal/codec"
+)
+
+func TestSupportMarshalJSON(t *testing.T) {
+	shield := Support{
+		Name: "Shield of Faith", Kind: SupportProtect,
+		Dice: 0, DiceCnt: 0, Rounds: 2, Charges: -1,
+	}
+	data, err := json.Marshal(&shield)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+
+	want := `{"name":"Shield of Faith","kind":"Protect",` +
+		`"charges":"unlimited","diceCnt":0,"rounds":2}`
+	if string(data) != want {
+		t.Fatalf("json.Marshal() = %s, want %s", data, want)
+	}
+}
+
+func TestSupportJSONRoundTrip(t *testing.T) {
+	potion := Support{
+		Name: "Healing Potion", Kind: SupportHeal,
+		Dice: dice.D6, DiceCnt: 2, Rounds: 0, Charges: 1,
+	}
+	shield := Support{
+		Name: "Shield of Faith", Kind: SupportProtect,
+		Dice: 0, DiceCnt: 0, Rounds: 2, Charges: -1,
+	}
+	for _, support := range []Support{potion, shield} {
+		t.Run(support.Name, func(t *testing.T) {
+			data, err := json.Marshal(&support)
+			if err != nil {
+				t.Fatalf("json.Marshal() error = %v", err)
+			}
+
+			var decoded Support
+			if err := json.Unmarshal(data, &decoded); err != nil {
+				t.Fatalf("json.Unmarshal(%s) error = %v", data, err)
+			}
+			if decoded != support {
+				t.Fatalf(
+					"json.Unmarshal(%s) = %s, want %s",
+					data, decoded.String(), support.String(),
+				)
+			}
+		})
+	}
+}
+
+func TestSupportMarshalJSONErrors(t *testing.T) {
+	support := Support{
+		Name: "Healing Potion", Kind: SupportHeal,
+		Dice: dice.D6, DiceCnt: 2, Rounds: 0, Charges: 1,
+	}
+	support.Kind = SupportKind(42)
+	if _, err := support.MarshalJSON(); err == nil {
+		t.Fatal("Support.MarshalJSON(): want error, got nil")
+	}
+}
+
+func TestSupportUnmarshalJSONErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     string
+		wantPath string
+	}{
+		{
+			name:     "UnknownKind",
+			data:     `{"name": "Prayer", "kind": "Bless", "rounds": 1}`,
+			wantPath: "kind",
+		},
+		{
+			name:     "UnknownDice",
+			data:     `{"name": "Potion", "kind": "Heal", "dice": "d3"}`,
+			wantPath: "dice",
+		},
+		{
+			name:     "InvalidCharges",
+			data:     `{"name": "Potion", "kind": "Heal", "charges": -2}`,
+			wantPath: "charges",
+		},
+		{
+			name:     "UnknownField",
+			data:     `{"name": "Potion", "kind": "Heal", "heals": 4}`,
+			wantPath: "heals",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var got Support
+			err := got.UnmarshalJSON([]byte(test.data))
+			var fieldErr *codec.FieldError
+			if !errors.As(err, &fieldErr) || fieldErr.Path != test.wantPath {
+				t.Fatalf(
+					"Support.UnmarshalJSON() error = %v, want %s field error",
+					err, test.wantPath,
+				)
+			}
+		})
+	}
+}
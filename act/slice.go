@@ -0,0 +1,43 @@
+package act
+
+import "strings"
+
+// SupportSlice is a `[]Support` with helper methods.
+type SupportSlice []Support
+
+// String returns the string representation of the SupportSlice.
+func (ss SupportSlice) String() string {
+	var sb strings.Builder
+	sb.WriteString("[]Support{")
+	for i, support := range ss {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(support.String())
+	}
+	sb.WriteString("}")
+	return sb.String()
+}
+
+// Equals checks if the SupportSlice is equal to the other SupportSlice.
+func (ss SupportSlice) Equals(other SupportSlice) bool {
+	if ss == nil && other == nil {
+		return true
+	}
+
+	if ss == nil || other == nil {
+		return false
+	}
+
+	if len(ss) != len(other) {
+		return false
+	}
+
+	for i := range ss {
+		if !ss[i].Equals(&other[i]) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,57 @@
+package act
+
+import (
+	"testing"
+
+	"github.com/rozag/cabasi/dice"
+)
+
+func TestSupportSliceEquals(t *testing.T) {
+	potion := Support{
+		Name: "Healing Potion", Kind: SupportHeal,
+		Dice: dice.D6, DiceCnt: 2, Rounds: 0, Charges: 1,
+	}
+	shield := Support{
+		Name: "Shield of Faith", Kind: SupportProtect,
+		Dice: 0, DiceCnt: 0, Rounds: 2, Charges: -1,
+	}
+	tests := []struct {
+		name        string
+		this, other SupportSlice
+		want        bool
+	}{
+		{name: "EqualNil", this: nil, other: nil, want: true},
+		{
+			name: "EqualEmpty",
+			this: SupportSlice{}, other: SupportSlice{},
+			want: true,
+		},
+		{
+			name: "NilNotEqualToEmpty",
+			this: nil, other: SupportSlice{},
+			want: false,
+		},
+		{
+			name: "EqualNormal",
+			this: SupportSlice{potion, shield}, other: SupportSlice{potion, shield},
+			want: true,
+		},
+		{
+			name: "DifferentLengths",
+			this: SupportSlice{potion}, other: SupportSlice{potion, shield},
+			want: false,
+		},
+		{
+			name: "DifferentSupports",
+			this: SupportSlice{potion}, other: SupportSlice{shield},
+			want: false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.this.Equals(test.other); got != test.want {
+				t.Fatalf("SupportSlice.Equals() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,180 @@
+package act
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/rozag/cabasi/atk"
+	"github.com/rozag/cabasi/dice"
+	"github.com/rozag/cabasi/internal/codec"
+)
+
+// SupportKind represents how a support helps an ally.
+type SupportKind uint8
+
+const (
+	// SupportHeal restores the ally's HP.
+	SupportHeal SupportKind = iota
+	// SupportProtect grants the ally atk.ConditionProtected.
+	SupportProtect
+	// SupportEnhance grants the ally atk.ConditionEnhanced.
+	SupportEnhance
+)
+
+// String returns the string representation of the SupportKind.
+func (k SupportKind) String() string {
+	switch k {
+	case SupportHeal:
+		return "Heal"
+	case SupportProtect:
+		return "Protect"
+	case SupportEnhance:
+		return "Enhance"
+	default:
+		panic(fmt.Errorf("unknown SupportKind: %d", k))
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler, the SupportKind is encoded as
+// its string representation, e.g. "Heal".
+func (k SupportKind) MarshalText() ([]byte, error) {
+	return codec.MarshalText("support kind", k, supportKinds()...)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, it decodes a SupportKind
+// encoded as its string representation, case-insensitive.
+func (k *SupportKind) UnmarshalText(text []byte) error {
+	value, err := codec.UnmarshalText("support kind", text, supportKinds()...)
+	if err != nil {
+		return err
+	}
+	*k = value
+	return nil
+}
+
+// supportKinds returns all the known support kinds.
+func supportKinds() []SupportKind {
+	return []SupportKind{SupportHeal, SupportProtect, SupportEnhance}
+}
+
+// Support is a non-damaging ability or item a creature uses on an ally.
+// Healing rolls Dice and sums them up, protection and enhancement last for
+// Rounds (<0 means until the end of the battle). Items, e.g. healing potions,
+// are supports with limited Charges.
+type Support struct {
+	Name    string
+	Kind    SupportKind
+	Dice    dice.Dice
+	DiceCnt uint8
+	Rounds  int8
+	Charges int8 // -1 means unlimited
+}
+
+// String returns the string representation of the Support.
+func (s *Support) String() string {
+	return fmt.Sprintf(
+		"Support{"+
+			"Name: %q"+
+			", Kind: %s"+
+			", Dice: %s"+
+			", DiceCnt: %d"+
+			", Rounds: %d"+
+			", Charges: %d"+
+			"}",
+		s.Name,
+		s.Kind,
+		s.Dice,
+		s.DiceCnt,
+		s.Rounds,
+		s.Charges,
+	)
+}
+
+// Validate checks if the freshly created support is valid. It returns an
+// error with `Unwrap() []error` method to get all the errors or `nil` if the
+// support is valid. The errors are reported as *codec.FieldError, e.g. "dice".
+func (s *Support) Validate() error {
+	var errs []error
+
+	if len(s.Name) == 0 {
+		errs = append(
+			errs, codec.WrapField("name", errors.New("support must have a name")),
+		)
+	}
+
+	switch s.Kind {
+	case SupportHeal:
+		switch s.Dice {
+		case dice.D4, dice.D6, dice.D8, dice.D10, dice.D12, dice.D20:
+			// OK
+		default:
+			errs = append(
+				errs, codec.WrapField("dice", fmt.Errorf("invalid dice: %d", s.Dice)),
+			)
+		}
+
+		if s.DiceCnt == 0 {
+			errs = append(errs, codec.WrapField(
+				"diceCnt", errors.New("dice count must be at least 1"),
+			))
+		}
+
+		if s.Rounds != 0 {
+			errs = append(errs, codec.WrapField("rounds", fmt.Errorf(
+				"healing must last for 0 rounds, got %d", s.Rounds,
+			)))
+		}
+	case SupportProtect, SupportEnhance:
+		if s.Dice != 0 || s.DiceCnt != 0 {
+			errs = append(errs, codec.WrapField("dice", fmt.Errorf(
+				"support %s cannot have dice, got %d%s", s.Kind, s.DiceCnt, s.Dice,
+			)))
+		}
+
+		if s.Rounds == 0 {
+			errs = append(errs, codec.WrapField("rounds", fmt.Errorf(
+				"support %s must last for at least 1 round", s.Kind,
+			)))
+		}
+	default:
+		errs = append(errs, codec.WrapField(
+			"kind", fmt.Errorf("invalid support kind: %d", s.Kind),
+		))
+	}
+
+	return errors.Join(errs...)
+}
+
+// Heal rolls the HP the support restores. It returns 0 for supports other
+// than SupportHeal.
+func (s *Support) Heal(rng dice.RNG) uint8 {
+	if s.Kind != SupportHeal {
+		return 0
+	}
+	return atk.PoolSum.Roll(s.Dice, s.DiceCnt, rng)
+}
+
+// Grants returns the beneficial condition the support grants. It returns
+// atk.ConditionNone for SupportHeal and panics if the kind is unknown.
+func (s *Support) Grants() atk.Condition {
+	switch s.Kind {
+	case SupportProtect:
+		return atk.Condition{Kind: atk.ConditionProtected, Rounds: s.Rounds}
+	case SupportEnhance:
+		return atk.Condition{Kind: atk.ConditionEnhanced, Rounds: s.Rounds}
+	case SupportHeal:
+		return atk.Condition{Kind: atk.ConditionNone, Rounds: 0}
+	default:
+		panic(fmt.Errorf("unknown SupportKind: %d", s.Kind))
+	}
+}
+
+// Equals checks if the Support is equal to the other Support.
+func (s *Support) Equals(other *Support) bool {
+	return s.Name == other.Name &&
+		s.Kind == other.Kind &&
+		s.Dice == other.Dice &&
+		s.DiceCnt == other.DiceCnt &&
+		s.Rounds == other.Rounds &&
+		s.Charges == other.Charges
+}
@@ -0,0 +1,195 @@
+package act
+
+import (
+	"testing"
+
+	"github.com/rozag/cabasi/atk"
+	"github.com/rozag/cabasi/dice"
+	"github.com/rozag/cabasi/dice/dicetest"
+)
+
+func TestSupportValidate(t *testing.T) {
+	potion := Support{
+		Name: "Healing Potion", Kind: SupportHeal,
+		Dice: dice.D6, DiceCnt: 2, Rounds: 0, Charges: 1,
+	}
+	shield := Support{
+		Name: "Shield of Faith", Kind: SupportProtect,
+		Dice: 0, DiceCnt: 0, Rounds: 2, Charges: -1,
+	}
+	tests := []struct {
+		name       string
+		support    Support
+		modify     func(s *Support)
+		wantErrCnt int
+	}{
+		{
+			name:       "ValidHeal",
+			support:    potion,
+			modify:     func(*Support) {},
+			wantErrCnt: 0,
+		},
+		{
+			name:       "ValidProtect",
+			support:    shield,
+			modify:     func(*Support) {},
+			wantErrCnt: 0,
+		},
+		{
+			name:       "EmptyName",
+			support:    potion,
+			modify:     func(s *Support) { s.Name = "" },
+			wantErrCnt: 1,
+		},
+		{
+			name:       "UnknownKind",
+			support:    potion,
+			modify:     func(s *Support) { s.Kind = SupportKind(42) },
+			wantErrCnt: 1,
+		},
+		{
+			name:    "HealWithoutDice",
+			support: potion,
+			modify: func(s *Support) {
+				s.Dice = 0
+				s.DiceCnt = 0
+			},
+			wantErrCnt: 2,
+		},
+		{
+			name:       "HealWithRounds",
+			support:    potion,
+			modify:     func(s *Support) { s.Rounds = 1 },
+			wantErrCnt: 1,
+		},
+		{
+			name:    "ProtectWithDice",
+			support: shield,
+			modify: func(s *Support) {
+				s.Dice = dice.D6
+				s.DiceCnt = 1
+			},
+			wantErrCnt: 1,
+		},
+		{
+			name:       "EnhanceWithoutRounds",
+			support:    shield,
+			modify:     func(s *Support) { s.Kind, s.Rounds = SupportEnhance, 0 },
+			wantErrCnt: 1,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.modify(&test.support)
+			err := test.support.Validate()
+
+			errCnt := 0
+			if jointErr, ok := err.(interface{ Unwrap() []error }); ok {
+				errCnt = len(jointErr.Unwrap())
+			}
+			if errCnt != test.wantErrCnt {
+				t.Fatalf(
+					"Support.Validate(): want %d errors, got %d: %v",
+					test.wantErrCnt, errCnt, err,
+				)
+			}
+		})
+	}
+}
+
+func TestSupportHeal(t *testing.T) {
+	potion := Support{
+		Name: "Healing Potion", Kind: SupportHeal,
+		Dice: dice.D6, DiceCnt: 2, Rounds: 0, Charges: 1,
+	}
+	if got := potion.Heal(dicetest.NewSequence(t, 2, 5)); got != 7 {
+		t.Errorf("Support.Heal() = %d, want 7", got)
+	}
+
+	shield := Support{
+		Name: "Shield of Faith", Kind: SupportProtect,
+		Dice: 0, DiceCnt: 0, Rounds: 2, Charges: -1,
+	}
+	if got := shield.Heal(dicetest.NewSequence(t)); got != 0 {
+		t.Errorf("Support.Heal() = %d, want 0 for protection", got)
+	}
+}
+
+func TestSupportGrants(t *testing.T) {
+	potion := Support{
+		Name: "Healing Potion", Kind: SupportHeal,
+		Dice: dice.D6, DiceCnt: 2, Rounds: 0, Charges: 1,
+	}
+	shield := Support{
+		Name: "Shield of Faith", Kind: SupportProtect,
+		Dice: 0, DiceCnt: 0, Rounds: 2, Charges: -1,
+	}
+	tests := []struct {
+		name    string
+		support Support
+		want    atk.Condition
+	}{
+		{
+			name:    "Heal",
+			support: potion,
+			want:    atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+		},
+		{
+			name:    "Protect",
+			support: shield,
+			want:    atk.Condition{Kind: atk.ConditionProtected, Rounds: 2},
+		},
+		{
+			name: "Enhance",
+			support: Support{
+				Name: "Bless", Kind: SupportEnhance,
+				Dice: 0, DiceCnt: 0, Rounds: -1, Charges: -1,
+			},
+			want: atk.Condition{Kind: atk.ConditionEnhanced, Rounds: -1},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.support.Grants(); got != test.want {
+				t.Fatalf("Support.Grants() = %s, want %s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestSupportKindText(t *testing.T) {
+	for _, kind := range []SupportKind{
+		SupportHeal, SupportProtect, SupportEnhance,
+	} {
+		t.Run(kind.String(), func(t *testing.T) {
+			text, err := kind.MarshalText()
+			if err != nil {
+				t.Fatalf("SupportKind.MarshalText() error = %v", err)
+			}
+			var decoded SupportKind
+			if err := decoded.UnmarshalText(text); err != nil {
+				t.Fatalf("SupportKind.UnmarshalText(%q) error = %v", text, err)
+			}
+			if decoded != kind {
+				t.Fatalf(
+					"SupportKind.UnmarshalText(%q) = %s, want %s", text, decoded, kind,
+				)
+			}
+		})
+	}
+
+	if _, err := SupportKind(42).MarshalText(); err == nil {
+		t.Fatal("SupportKind(42).MarshalText(): want error, got nil")
+	}
+
+	var kind SupportKind
+	if err := kind.UnmarshalText([]byte("enhance")); err != nil {
+		t.Fatalf("SupportKind.UnmarshalText(enhance) error = %v", err)
+	}
+	if kind != SupportEnhance {
+		t.Fatalf("SupportKind.UnmarshalText(enhance) = %s, want Enhance", kind)
+	}
+	if err := kind.UnmarshalText([]byte("Bless")); err == nil {
+		t.Fatal("SupportKind.UnmarshalText(Bless): want error, got nil")
+	}
+}
@@ -0,0 +1,79 @@
+package atk
+
+import (
+	"fmt"
+
+	"github.com/rozag/cabasi/internal/codec"
+)
+
+// Area represents the group of defenders a blast attack hits.
+type Area uint8
+
+const (
+	// AreaZone hits every defender in a single zone. It's the default area.
+	AreaZone Area = iota
+	// AreaAll hits every defender the attack reaches, no matter the zone.
+	AreaAll
+	// AreaIndividuals hits every individual the attack reaches, detachments are
+	// spared.
+	AreaIndividuals
+	// AreaDetachments hits every detachment the attack reaches, individuals are
+	// spared.
+	AreaDetachments
+)
+
+// String returns the string representation of the Area.
+func (a Area) String() string {
+	switch a {
+	case AreaZone:
+		return "Zone"
+	case AreaAll:
+		return "All"
+	case AreaIndividuals:
+		return "Individuals"
+	case AreaDetachments:
+		return "Detachments"
+	default:
+		panic(fmt.Errorf("unknown Area: %d", a))
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler, the Area is encoded as its
+// string representation, e.g. "All".
+func (a Area) MarshalText() ([]byte, error) {
+	return codec.MarshalText("area", a, areas()...)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, it decodes a Area
+// encoded as its string representation, case-insensitive.
+func (a *Area) UnmarshalText(text []byte) error {
+	value, err := codec.UnmarshalText("area", text, areas()...)
+	if err != nil {
+		return err
+	}
+	*a = value
+	return nil
+}
+
+// Includes checks if a defender in the zone is hit by a blast of the Area
+// centered on the provided zone. isDetachment tells if the defender is a
+// detachment.
+func (a Area) Includes(center, zone Zone, isDetachment bool) bool {
+	switch a {
+	case AreaZone:
+		return zone == center
+	case AreaAll:
+		return true
+	case AreaIndividuals:
+		return !isDetachment
+	case AreaDetachments:
+		return isDetachment
+	default:
+		panic(fmt.Errorf("unknown Area: %d", a))
+	}
+}
+
+// areas returns all the known areas.
+func areas() []Area {
+	return []Area{AreaZone, AreaAll, AreaIndividuals, AreaDetachments}
+}
@@ -0,0 +1,60 @@
+package atk
+
+import "testing"
+
+func TestAreaUnknownPanics(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Fatalf("Area.String(): want panic for unknown Area, got none")
+		}
+	}()
+	_ = Area(42).String()
+}
+
+func TestAreaIncludes(t *testing.T) {
+	tests := []struct {
+		name         string
+		area         Area
+		center, zone Zone
+		isDetachment bool
+		want         bool
+	}{
+		{
+			name: "ZoneSameZone", area: AreaZone,
+			center: ZoneNear, zone: ZoneNear, isDetachment: false, want: true,
+		},
+		{
+			name: "ZoneOtherZone", area: AreaZone,
+			center: ZoneNear, zone: ZoneFar, isDetachment: true, want: false,
+		},
+		{
+			name: "AllOtherZone", area: AreaAll,
+			center: ZoneEngaged, zone: ZoneFar, isDetachment: true, want: true,
+		},
+		{
+			name: "IndividualsIndividual", area: AreaIndividuals,
+			center: ZoneEngaged, zone: ZoneFar, isDetachment: false, want: true,
+		},
+		{
+			name: "IndividualsDetachment", area: AreaIndividuals,
+			center: ZoneEngaged, zone: ZoneEngaged, isDetachment: true, want: false,
+		},
+		{
+			name: "DetachmentsDetachment", area: AreaDetachments,
+			center: ZoneEngaged, zone: ZoneNear, isDetachment: true, want: true,
+		},
+		{
+			name: "DetachmentsIndividual", area: AreaDetachments,
+			center: ZoneEngaged, zone: ZoneEngaged, isDetachment: false,
+			want: false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := test.area.Includes(test.center, test.zone, test.isDetachment)
+			if got != test.want {
+				t.Fatalf("Area.Includes() = %t, want %t", got, test.want)
+			}
+		})
+	}
+}
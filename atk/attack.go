@@ -3,8 +3,10 @@ package atk
 import (
 	"errors"
 	"fmt"
+	"math"
 
 	"github.com/rozag/cabasi/dice"
+	"github.com/rozag/cabasi/internal/codec"
 )
 
 // Characteristic represents one of the core creature characteristics.
@@ -33,15 +35,69 @@ func (c Characteristic) String() string {
 	}
 }
 
+// MarshalText implements encoding.TextMarshaler, the Characteristic is
+// encoded as its string representation, e.g. "STR".
+func (c Characteristic) MarshalText() ([]byte, error) {
+	return codec.MarshalText("characteristic", c, characteristics()...)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, it decodes a
+// Characteristic encoded as its string representation, case-insensitive.
+func (c *Characteristic) UnmarshalText(text []byte) error {
+	value, err := codec.UnmarshalText(
+		"characteristic", text, characteristics()...,
+	)
+	if err != nil {
+		return err
+	}
+	*c = value
+	return nil
+}
+
 // Attack represents a single attack on a characteristic of a creature - a blunt
 // attack, a special ability, a spell, etc.
+//
+// An attack can be paired with another attack of the same creature to model
+// attacking with two weapons: both attacks' dice are rolled, the highest
+// result is kept, and both attacks' charges are consumed. PairedWith is the
+// 1-based index of the paired attack in the creature's attacks, so the zero
+// value leaves the attack unpaired.
+//
+// An attack can inflict a condition on the defender it damages, the defender
+// can avoid it with a save. Inflicts is ConditionNone for attacks without any
+// side effects.
+//
+// Charges of an attack can be regained according to its Recharge rule, which
+// is nil for attacks that never recharge.
+//
+// DmgMod is a flat modifier added to the resolved dice ("d6+1"), MinDmg is
+// the minimum damage of the attack before armor ("d8, min 2"), 0 means no
+// minimum. See ModifyDmg for the order they are applied in.
+//
+// Usage restricts when the attack can be made, e.g. only in the first round,
+// see Usage.Allows. It's UsageAlways for attacks without restrictions.
+//
+// Whether the attack hits a defender depends on the zones of both, see
+// Reaches and IsImpairedFrom. Blast attacks hit every defender of their Area,
+// but no more than MaxTargets of them, 0 means no limit. Area and MaxTargets
+// apply to blast attacks only.
 type Attack struct {
 	Name                 string
+	Recharge             *Recharge
 	TargetCharacteristic Characteristic
 	Dice                 dice.Dice
 	DiceCnt              uint8
+	DmgMod               int8
+	MinDmg               uint8
 	Charges              int8 // <0 means infinite
 	IsBlast              bool
+	Area                 Area
+	MaxTargets           uint8 // 0 means no limit
+	Pool                 Pool
+	Traits               Traits
+	Usage                Usage
+	PairedWith           uint8 // 0 means not paired
+	Inflicts             Condition
 }
 
 // String returns the string representation of the Attack.
@@ -52,47 +108,137 @@ func (a *Attack) String() string {
 			", TargetCharacteristic: %s"+
 			", Dice: %s"+
 			", DiceCnt: %d"+
+			", DmgMod: %d"+
+			", MinDmg: %d"+
 			", Charges: %d"+
 			", IsBlast: %t"+
+			", Area: %s"+
+			", MaxTargets: %d"+
+			", Pool: %s"+
+			", Traits: %s"+
+			", Usage: %s"+
+			", PairedWith: %d"+
+			", Inflicts: %s"+
+			", Recharge: %v"+
 			"}",
 		a.Name,
 		a.TargetCharacteristic,
 		a.Dice,
 		a.DiceCnt,
+		a.DmgMod,
+		a.MinDmg,
 		a.Charges,
 		a.IsBlast,
+		a.Area,
+		a.MaxTargets,
+		a.Pool,
+		a.Traits,
+		a.Usage,
+		a.PairedWith,
+		a.Inflicts,
+		a.Recharge,
 	)
 }
 
 // Validate checks if the freshly created attack is valid. It returns an error
 // with `Unwrap() []error` method to get all the errors or `nil` if the attack
-// is valid.
+// is valid. The errors are reported as *codec.FieldError with the path to the
+// invalid field as encoded in JSON, e.g. "diceCnt".
 func (a *Attack) Validate() error {
 	var errs []error
 
 	if len(a.Name) == 0 {
-		errs = append(errs, errors.New("attack must have a name"))
+		errs = append(
+			errs, codec.WrapField("name", errors.New("attack must have a name")),
+		)
 	}
 
 	switch a.TargetCharacteristic {
 	case STR, DEX, WIL:
 		// OK
 	default:
-		errs = append(
-			errs,
-			fmt.Errorf("invalid target characteristic: %d", a.TargetCharacteristic),
-		)
+		errs = append(errs, codec.WrapField("target", fmt.Errorf(
+			"invalid target characteristic: %d", a.TargetCharacteristic,
+		)))
 	}
 
 	switch a.Dice {
 	case dice.D4, dice.D6, dice.D8, dice.D10, dice.D12, dice.D20:
 		// OK
 	default:
-		errs = append(errs, fmt.Errorf("invalid dice: %d", a.Dice))
+		errs = append(
+			errs, codec.WrapField("dice", fmt.Errorf("invalid dice: %d", a.Dice)),
+		)
 	}
 
 	if a.DiceCnt == 0 {
-		errs = append(errs, errors.New("dice count must be at least 1"))
+		errs = append(errs, codec.WrapField(
+			"diceCnt", errors.New("dice count must be at least 1"),
+		))
+	}
+
+	switch a.Pool {
+	case PoolHighest, PoolSum, PoolLowest, PoolExploding:
+		// The minimum damage only makes sense below the maximum roll, otherwise
+		// the attack always deals the same damage.
+		if a.DiceCnt > 0 && a.MinDmg > 0 && uint(a.MinDmg) >= a.MaxDmg() {
+			errs = append(errs, codec.WrapField("minDmg", fmt.Errorf(
+				"minimum damage %d must be less than maximum damage", a.MinDmg,
+			)))
+		}
+		// The damage modifier must leave some damage on the maximum roll,
+		// otherwise the attack can never deal damage.
+		if a.DiceCnt > 0 && a.MaxDmg() == 0 {
+			errs = append(errs, codec.WrapField("dmgMod", fmt.Errorf(
+				"damage modifier %d leaves no damage to deal", a.DmgMod,
+			)))
+		}
+	default:
+		errs = append(
+			errs, codec.WrapField("pool", fmt.Errorf("invalid pool: %d", a.Pool)),
+		)
+	}
+
+	switch a.Area {
+	case AreaZone, AreaAll, AreaIndividuals, AreaDetachments:
+		if !a.IsBlast && a.Area != AreaZone {
+			errs = append(errs, codec.WrapField("area", fmt.Errorf(
+				"only blast attacks can have area, got %s", a.Area,
+			)))
+		}
+	default:
+		errs = append(
+			errs, codec.WrapField("area", fmt.Errorf("invalid area: %d", a.Area)),
+		)
+	}
+
+	if !a.IsBlast && a.MaxTargets != 0 {
+		errs = append(errs, codec.WrapField(
+			"maxTargets", errors.New("only blast attacks can have max targets"),
+		))
+	} else if a.MaxTargets == 1 {
+		errs = append(errs, codec.WrapField(
+			"maxTargets", errors.New("blast max targets must be at least 2"),
+		))
+	}
+
+	for _, err := range a.Traits.validate() {
+		errs = append(errs, codec.WrapField("traits", err))
+	}
+	for _, err := range a.Usage.validate() {
+		errs = append(errs, codec.WrapField("usage", err))
+	}
+
+	if err := a.Inflicts.Validate(); err != nil {
+		errs = append(errs, codec.WrapField("inflicts", err))
+	} else if a.Inflicts.Kind.IsBeneficial() {
+		errs = append(errs, codec.WrapField("inflicts.kind", fmt.Errorf(
+			"attack cannot inflict beneficial condition %s", a.Inflicts.Kind,
+		)))
+	}
+
+	if a.Recharge != nil {
+		errs = append(errs, a.Recharge.validate(a.Charges)...)
 	}
 
 	return errors.Join(errs...)
@@ -104,18 +250,92 @@ func (a *Attack) Equals(other *Attack) bool {
 		a.TargetCharacteristic == other.TargetCharacteristic &&
 		a.Dice == other.Dice &&
 		a.DiceCnt == other.DiceCnt &&
+		a.DmgMod == other.DmgMod &&
+		a.MinDmg == other.MinDmg &&
 		a.Charges == other.Charges &&
-		a.IsBlast == other.IsBlast
+		a.IsBlast == other.IsBlast &&
+		a.Area == other.Area &&
+		a.MaxTargets == other.MaxTargets &&
+		a.Pool == other.Pool &&
+		a.Traits == other.Traits &&
+		a.Usage == other.Usage &&
+		a.PairedWith == other.PairedWith &&
+		a.Inflicts == other.Inflicts &&
+		(a.Recharge == other.Recharge ||
+			(a.Recharge != nil && other.Recharge != nil &&
+				*a.Recharge == *other.Recharge))
 }
 
 // DeepCopy creates a deep copy of the Attack.
 func (a *Attack) DeepCopy() Attack {
+	var recharge *Recharge
+	if a.Recharge != nil {
+		copied := *a.Recharge
+		recharge = &copied
+	}
 	return Attack{
 		Name:                 a.Name,
 		TargetCharacteristic: a.TargetCharacteristic,
 		Dice:                 a.Dice,
 		DiceCnt:              a.DiceCnt,
+		DmgMod:               a.DmgMod,
+		MinDmg:               a.MinDmg,
 		Charges:              a.Charges,
 		IsBlast:              a.IsBlast,
+		Area:                 a.Area,
+		MaxTargets:           a.MaxTargets,
+		Pool:                 a.Pool,
+		Traits:               a.Traits,
+		Usage:                a.Usage,
+		PairedWith:           a.PairedWith,
+		Inflicts:             a.Inflicts,
+		Recharge:             recharge,
+	}
+}
+
+// ModifyDmg applies the flat modifier and the minimum damage of the Attack to
+// the resolved dice roll. The modifier goes first and the result is clamped to
+// [0, math.MaxUint8], then the minimum is applied. Armor is subtracted from the
+// result afterwards by the caller.
+func (a *Attack) ModifyDmg(roll uint8) uint8 {
+	dmg := min(max(int(roll)+int(a.DmgMod), 0), math.MaxUint8)
+	// Suppressing gosec "G115: integer overflow conversion int -> uint8"
+	// because the value is clamped to [0, math.MaxUint8] right here.
+	return max(uint8(dmg), a.MinDmg) //nolint:gosec
+}
+
+// MaxDmg returns the maximum damage the Attack can deal before armor, taking
+// the flat modifier and the minimum damage into account. Exploding dice are
+// estimated without explosions, see Pool.Max.
+func (a *Attack) MaxDmg() uint {
+	dmg := max(int(a.Pool.Max(a.Dice, a.DiceCnt))+int(a.DmgMod), 0)
+	// Suppressing gosec "G115: integer overflow conversion int -> uint"
+	// because the value is clamped to be non-negative right here.
+	return max(uint(dmg), uint(a.MinDmg)) //nolint:gosec
+}
+
+// Reaches checks if the Attack made from the attacker's zone reaches a
+// defender in the defender's zone. Ranged attacks reach any zone, reach
+// attacks hit from a step away, and other melee attacks hit engaged defenders
+// only.
+func (a *Attack) Reaches(from, to Zone) bool {
+	switch {
+	case a.Traits.Has(TraitRanged):
+		return true
+	case a.Traits.Has(TraitReach):
+		return from.Distance(to) <= 1
+	default:
+		return from.Distance(to) == 0
 	}
 }
+
+// IsImpairedFrom checks if the Attack made from the attacker's zone is
+// impaired. Ranged attacks are impaired when the attacker is engaged.
+func (a *Attack) IsImpairedFrom(from Zone) bool {
+	return a.Traits.Has(TraitRanged) && from == ZoneEngaged
+}
+
+// characteristics returns all the known characteristics.
+func characteristics() []Characteristic {
+	return []Characteristic{STR, DEX, WIL}
+}
@@ -17,7 +17,9 @@ func TestAttackValidate(t *testing.T) {
 			attack: Attack{
 				Name: "Knife", TargetCharacteristic: STR,
 				Dice: dice.D6, DiceCnt: 1, Charges: -1,
-				IsBlast: false,
+				IsBlast: false, Pool: PoolHighest, Traits: TraitNone, PairedWith: 0,
+				Inflicts: Condition{Kind: ConditionNone, Rounds: 0}, Recharge: nil,
+				DmgMod: 0, MinDmg: 0, Usage: UsageAlways, Area: AreaZone, MaxTargets: 0,
 			},
 			wantErrCnt: 0,
 		},
@@ -26,7 +28,9 @@ func TestAttackValidate(t *testing.T) {
 			attack: Attack{
 				Name: "", TargetCharacteristic: STR,
 				Dice: dice.D6, DiceCnt: 1, Charges: -1,
-				IsBlast: false,
+				IsBlast: false, Pool: PoolHighest, Traits: TraitNone, PairedWith: 0,
+				Inflicts: Condition{Kind: ConditionNone, Rounds: 0}, Recharge: nil,
+				DmgMod: 0, MinDmg: 0, Usage: UsageAlways, Area: AreaZone, MaxTargets: 0,
 			},
 			wantErrCnt: 1,
 		},
@@ -35,7 +39,9 @@ func TestAttackValidate(t *testing.T) {
 			attack: Attack{
 				Name: "Knife", TargetCharacteristic: Characteristic(42),
 				Dice: dice.D6, DiceCnt: 1, Charges: -1,
-				IsBlast: false,
+				IsBlast: false, Pool: PoolHighest, Traits: TraitNone, PairedWith: 0,
+				Inflicts: Condition{Kind: ConditionNone, Rounds: 0}, Recharge: nil,
+				DmgMod: 0, MinDmg: 0, Usage: UsageAlways, Area: AreaZone, MaxTargets: 0,
 			},
 			wantErrCnt: 1,
 		},
@@ -44,7 +50,9 @@ func TestAttackValidate(t *testing.T) {
 			attack: Attack{
 				Name: "Knife", TargetCharacteristic: STR,
 				Dice: dice.Dice(42), DiceCnt: 1, Charges: -1,
-				IsBlast: false,
+				IsBlast: false, Pool: PoolHighest, Traits: TraitNone, PairedWith: 0,
+				Inflicts: Condition{Kind: ConditionNone, Rounds: 0}, Recharge: nil,
+				DmgMod: 0, MinDmg: 0, Usage: UsageAlways, Area: AreaZone, MaxTargets: 0,
 			},
 			wantErrCnt: 1,
 		},
@@ -53,7 +61,269 @@ func TestAttackValidate(t *testing.T) {
 			attack: Attack{
 				Name: "Knife", TargetCharacteristic: STR,
 				Dice: dice.D6, DiceCnt: 0, Charges: -1,
-				IsBlast: false,
+				IsBlast: false, Pool: PoolHighest, Traits: TraitNone, PairedWith: 0,
+				Inflicts: Condition{Kind: ConditionNone, Rounds: 0}, Recharge: nil,
+				DmgMod: 0, MinDmg: 0, Usage: UsageAlways, Area: AreaZone, MaxTargets: 0,
+			},
+			wantErrCnt: 1,
+		},
+		{
+			name: "UnknownPool",
+			attack: Attack{
+				Name: "Knife", TargetCharacteristic: STR,
+				Dice: dice.D6, DiceCnt: 1, Charges: -1,
+				IsBlast: false, Pool: Pool(42), Traits: TraitNone, PairedWith: 0,
+				Inflicts: Condition{Kind: ConditionNone, Rounds: 0}, Recharge: nil,
+				DmgMod: 0, MinDmg: 0, Usage: UsageAlways, Area: AreaZone, MaxTargets: 0,
+			},
+			wantErrCnt: 1,
+		},
+		{
+			name: "UnknownTraits",
+			attack: Attack{
+				Name: "Knife", TargetCharacteristic: STR,
+				Dice: dice.D6, DiceCnt: 1, Charges: -1,
+				IsBlast: false, Pool: PoolHighest, Traits: Traits(0x80), PairedWith: 0,
+				Inflicts: Condition{Kind: ConditionNone, Rounds: 0}, Recharge: nil,
+				DmgMod: 0, MinDmg: 0, Usage: UsageAlways, Area: AreaZone, MaxTargets: 0,
+			},
+			wantErrCnt: 1,
+		},
+		{
+			name: "RangedAndReachTraits",
+			attack: Attack{
+				Name: "Spear", TargetCharacteristic: STR,
+				Dice: dice.D6, DiceCnt: 1, Charges: -1,
+				IsBlast: false, Pool: PoolHighest, Traits: TraitRanged | TraitReach,
+				PairedWith: 0, Inflicts: Condition{Kind: ConditionNone, Rounds: 0},
+				Recharge: nil, DmgMod: 0, MinDmg: 0, Usage: UsageAlways,
+				Area: AreaZone, MaxTargets: 0,
+			},
+			wantErrCnt: 1,
+		},
+		{
+			name: "InflictsCondition",
+			attack: Attack{
+				Name: "Knife", TargetCharacteristic: STR,
+				Dice: dice.D6, DiceCnt: 1, Charges: -1,
+				IsBlast: false, Pool: PoolHighest, Traits: TraitNone, PairedWith: 0,
+				Inflicts: Condition{Kind: ConditionPoisoned, Rounds: 3}, Recharge: nil,
+				DmgMod: 0, MinDmg: 0, Usage: UsageAlways, Area: AreaZone, MaxTargets: 0,
+			},
+			wantErrCnt: 0,
+		},
+		{
+			name: "InvalidInflictedCondition",
+			attack: Attack{
+				Name: "Knife", TargetCharacteristic: STR,
+				Dice: dice.D6, DiceCnt: 1, Charges: -1,
+				IsBlast: false, Pool: PoolHighest, Traits: TraitNone, PairedWith: 0,
+				Inflicts: Condition{Kind: ConditionBlinded, Rounds: 0}, Recharge: nil,
+				DmgMod: 0, MinDmg: 0, Usage: UsageAlways, Area: AreaZone, MaxTargets: 0,
+			},
+			wantErrCnt: 1,
+		},
+		{
+			name: "InflictsBeneficialCondition",
+			attack: Attack{
+				Name: "Knife", TargetCharacteristic: STR,
+				Dice: dice.D6, DiceCnt: 1, Charges: -1,
+				IsBlast: false, Pool: PoolHighest, Traits: TraitNone, PairedWith: 0,
+				Inflicts: Condition{Kind: ConditionEnhanced, Rounds: 1}, Recharge: nil,
+				DmgMod: 0, MinDmg: 0, Usage: UsageAlways, Area: AreaZone, MaxTargets: 0,
+			},
+			wantErrCnt: 1,
+		},
+		{
+			name: "RechargeEveryNRounds",
+			attack: Attack{
+				Name: "Fire Breath", TargetCharacteristic: STR,
+				Dice: dice.D12, DiceCnt: 1, Charges: 1,
+				IsBlast: true, Pool: PoolHighest, Traits: TraitNone, PairedWith: 0,
+				Inflicts: Condition{Kind: ConditionNone, Rounds: 0},
+				Recharge: &Recharge{
+					Kind: RechargeEveryNRounds, Rounds: 2, Dice: 0, Threshold: 0,
+					MaxCharges: 1, Elapsed: 0,
+				},
+				DmgMod: 0, MinDmg: 0, Usage: UsageAlways, Area: AreaZone, MaxTargets: 0,
+			},
+			wantErrCnt: 0,
+		},
+		{
+			name: "RechargeOnRoll",
+			attack: Attack{
+				Name: "Fire Breath", TargetCharacteristic: STR,
+				Dice: dice.D12, DiceCnt: 1, Charges: 0,
+				IsBlast: true, Pool: PoolHighest, Traits: TraitNone, PairedWith: 0,
+				Inflicts: Condition{Kind: ConditionNone, Rounds: 0},
+				Recharge: &Recharge{
+					Kind: RechargeOnRoll, Rounds: 0, Dice: dice.D6, Threshold: 5,
+					MaxCharges: 1, Elapsed: 0,
+				},
+				DmgMod: 0, MinDmg: 0, Usage: UsageAlways, Area: AreaZone, MaxTargets: 0,
+			},
+			wantErrCnt: 0,
+		},
+		{
+			name: "RechargeThresholdOutOfRange",
+			attack: Attack{
+				Name: "Fire Breath", TargetCharacteristic: STR,
+				Dice: dice.D12, DiceCnt: 1, Charges: 1,
+				IsBlast: true, Pool: PoolHighest, Traits: TraitNone, PairedWith: 0,
+				Inflicts: Condition{Kind: ConditionNone, Rounds: 0},
+				Recharge: &Recharge{
+					Kind: RechargeOnRoll, Rounds: 0, Dice: dice.D6, Threshold: 7,
+					MaxCharges: 1, Elapsed: 0,
+				},
+				DmgMod: 0, MinDmg: 0, Usage: UsageAlways, Area: AreaZone, MaxTargets: 0,
+			},
+			wantErrCnt: 1,
+		},
+		{
+			name: "RechargeWithInfiniteCharges",
+			attack: Attack{
+				Name: "Fire Breath", TargetCharacteristic: STR,
+				Dice: dice.D12, DiceCnt: 1, Charges: -1,
+				IsBlast: true, Pool: PoolHighest, Traits: TraitNone, PairedWith: 0,
+				Inflicts: Condition{Kind: ConditionNone, Rounds: 0},
+				Recharge: &Recharge{
+					Kind: RechargeEveryNRounds, Rounds: 2, Dice: 0, Threshold: 0,
+					MaxCharges: 1, Elapsed: 0,
+				},
+				DmgMod: 0, MinDmg: 0, Usage: UsageAlways, Area: AreaZone, MaxTargets: 0,
+			},
+			wantErrCnt: 1,
+		},
+		{
+			name: "RechargeWithNoRoundsAndMaxCharges",
+			attack: Attack{
+				Name: "Fire Breath", TargetCharacteristic: STR,
+				Dice: dice.D12, DiceCnt: 1, Charges: 1,
+				IsBlast: true, Pool: PoolHighest, Traits: TraitNone, PairedWith: 0,
+				Inflicts: Condition{Kind: ConditionNone, Rounds: 0},
+				Recharge: &Recharge{
+					Kind: RechargeEveryNRounds, Rounds: 0, Dice: 0, Threshold: 0,
+					MaxCharges: 0, Elapsed: 0,
+				},
+				DmgMod: 0, MinDmg: 0, Usage: UsageAlways, Area: AreaZone, MaxTargets: 0,
+			},
+			wantErrCnt: 2,
+		},
+		{
+			name: "DmgModAndMinDmg",
+			attack: Attack{
+				Name: "Spear", TargetCharacteristic: STR,
+				Dice: dice.D6, DiceCnt: 1, Charges: -1,
+				IsBlast: false, Pool: PoolHighest, Traits: TraitNone, PairedWith: 0,
+				Inflicts: Condition{Kind: ConditionNone, Rounds: 0}, Recharge: nil,
+				DmgMod: 1, MinDmg: 2, Usage: UsageAlways, Area: AreaZone, MaxTargets: 0,
+			},
+			wantErrCnt: 0,
+		},
+		{
+			name: "NegativeDmgMod",
+			attack: Attack{
+				Name: "Club", TargetCharacteristic: STR,
+				Dice: dice.D6, DiceCnt: 1, Charges: -1,
+				IsBlast: false, Pool: PoolHighest, Traits: TraitNone, PairedWith: 0,
+				Inflicts: Condition{Kind: ConditionNone, Rounds: 0}, Recharge: nil,
+				DmgMod: -2, MinDmg: 0, Usage: UsageAlways,
+				Area: AreaZone, MaxTargets: 0,
+			},
+			wantErrCnt: 0,
+		},
+		{
+			name: "DmgModLeavesNoDmg",
+			attack: Attack{
+				Name: "Club", TargetCharacteristic: STR,
+				Dice: dice.D4, DiceCnt: 1, Charges: -1,
+				IsBlast: false, Pool: PoolHighest, Traits: TraitNone, PairedWith: 0,
+				Inflicts: Condition{Kind: ConditionNone, Rounds: 0}, Recharge: nil,
+				DmgMod: -4, MinDmg: 0, Usage: UsageAlways,
+				Area: AreaZone, MaxTargets: 0,
+			},
+			wantErrCnt: 1,
+		},
+		{
+			name: "MinDmgNotBelowMaxDmg",
+			attack: Attack{
+				Name: "Spear", TargetCharacteristic: STR,
+				Dice: dice.D6, DiceCnt: 1, Charges: -1,
+				IsBlast: false, Pool: PoolHighest, Traits: TraitNone, PairedWith: 0,
+				Inflicts: Condition{Kind: ConditionNone, Rounds: 0}, Recharge: nil,
+				DmgMod: 0, MinDmg: 6, Usage: UsageAlways, Area: AreaZone, MaxTargets: 0,
+			},
+			wantErrCnt: 1,
+		},
+		{
+			name: "UnknownUsage",
+			attack: Attack{
+				Name: "Spear", TargetCharacteristic: STR,
+				Dice: dice.D6, DiceCnt: 1, Charges: -1,
+				IsBlast: false, Pool: PoolHighest, Traits: TraitNone, PairedWith: 0,
+				Inflicts: Condition{Kind: ConditionNone, Rounds: 0}, Recharge: nil,
+				DmgMod: 0, MinDmg: 0, Usage: UsageBloodied | Usage(0x80),
+				Area: AreaZone, MaxTargets: 0,
+			},
+			wantErrCnt: 1,
+		},
+		{
+			name: "BlastWithAreaAndMaxTargets",
+			attack: Attack{
+				Name: "Fireball", TargetCharacteristic: STR,
+				Dice: dice.D6, DiceCnt: 1, Charges: -1,
+				IsBlast: true, Pool: PoolHighest, Traits: TraitNone, PairedWith: 0,
+				Inflicts: Condition{Kind: ConditionNone, Rounds: 0}, Recharge: nil,
+				DmgMod: 0, MinDmg: 0, Usage: UsageAlways,
+				Area: AreaIndividuals, MaxTargets: 3,
+			},
+			wantErrCnt: 0,
+		},
+		{
+			name: "NonBlastWithArea",
+			attack: Attack{
+				Name: "Fireball", TargetCharacteristic: STR,
+				Dice: dice.D6, DiceCnt: 1, Charges: -1,
+				IsBlast: false, Pool: PoolHighest, Traits: TraitNone, PairedWith: 0,
+				Inflicts: Condition{Kind: ConditionNone, Rounds: 0}, Recharge: nil,
+				DmgMod: 0, MinDmg: 0, Usage: UsageAlways,
+				Area: AreaAll, MaxTargets: 0,
+			},
+			wantErrCnt: 1,
+		},
+		{
+			name: "NonBlastWithMaxTargets",
+			attack: Attack{
+				Name: "Fireball", TargetCharacteristic: STR,
+				Dice: dice.D6, DiceCnt: 1, Charges: -1,
+				IsBlast: false, Pool: PoolHighest, Traits: TraitNone, PairedWith: 0,
+				Inflicts: Condition{Kind: ConditionNone, Rounds: 0}, Recharge: nil,
+				DmgMod: 0, MinDmg: 0, Usage: UsageAlways,
+				Area: AreaZone, MaxTargets: 2,
+			},
+			wantErrCnt: 1,
+		},
+		{
+			name: "BlastWithSingleMaxTarget",
+			attack: Attack{
+				Name: "Fireball", TargetCharacteristic: STR,
+				Dice: dice.D6, DiceCnt: 1, Charges: -1,
+				IsBlast: true, Pool: PoolHighest, Traits: TraitNone, PairedWith: 0,
+				Inflicts: Condition{Kind: ConditionNone, Rounds: 0}, Recharge: nil,
+				DmgMod: 0, MinDmg: 0, Usage: UsageAlways,
+				Area: AreaZone, MaxTargets: 1,
+			},
+			wantErrCnt: 1,
+		},
+		{
+			name: "UnknownArea",
+			attack: Attack{
+				Name: "Fireball", TargetCharacteristic: STR,
+				Dice: dice.D6, DiceCnt: 1, Charges: -1,
+				IsBlast: true, Pool: PoolHighest, Traits: TraitNone, PairedWith: 0,
+				Inflicts: Condition{Kind: ConditionNone, Rounds: 0}, Recharge: nil,
+				DmgMod: 0, MinDmg: 0, Usage: UsageAlways,
+				Area: Area(42), MaxTargets: 0,
 			},
 			wantErrCnt: 1,
 		},
@@ -62,9 +332,11 @@ func TestAttackValidate(t *testing.T) {
 			attack: Attack{
 				Name: "", TargetCharacteristic: Characteristic(42),
 				Dice: dice.Dice(42), DiceCnt: 0, Charges: -1,
-				IsBlast: true,
+				IsBlast: true, Pool: Pool(42), Traits: Traits(0x80), PairedWith: 0,
+				Inflicts: Condition{Kind: ConditionNone, Rounds: 0}, Recharge: nil,
+				DmgMod: 0, MinDmg: 0, Usage: UsageAlways, Area: AreaZone, MaxTargets: 0,
 			},
-			wantErrCnt: 4,
+			wantErrCnt: 6,
 		},
 	}
 	for _, test := range tests {
@@ -110,12 +382,16 @@ func TestAttackEquals(t *testing.T) {
 			this: Attack{
 				Name: "Knife", TargetCharacteristic: STR,
 				Dice: dice.D6, DiceCnt: 1, Charges: -1,
-				IsBlast: false,
+				IsBlast: false, Pool: PoolHighest, Traits: TraitNone, PairedWith: 0,
+				Inflicts: Condition{Kind: ConditionNone, Rounds: 0}, Recharge: nil,
+				DmgMod: 0, MinDmg: 0, Usage: UsageAlways, Area: AreaZone, MaxTargets: 0,
 			},
 			other: Attack{
 				Name: "Knife", TargetCharacteristic: STR,
 				Dice: dice.D6, DiceCnt: 1, Charges: -1,
-				IsBlast: false,
+				IsBlast: false, Pool: PoolHighest, Traits: TraitNone, PairedWith: 0,
+				Inflicts: Condition{Kind: ConditionNone, Rounds: 0}, Recharge: nil,
+				DmgMod: 0, MinDmg: 0, Usage: UsageAlways, Area: AreaZone, MaxTargets: 0,
 			},
 			want: true,
 		},
@@ -124,12 +400,16 @@ func TestAttackEquals(t *testing.T) {
 			this: Attack{
 				Name: "Knife", TargetCharacteristic: STR,
 				Dice: dice.D6, DiceCnt: 1, Charges: -1,
-				IsBlast: false,
+				IsBlast: false, Pool: PoolHighest, Traits: TraitNone, PairedWith: 0,
+				Inflicts: Condition{Kind: ConditionNone, Rounds: 0}, Recharge: nil,
+				DmgMod: 0, MinDmg: 0, Usage: UsageAlways, Area: AreaZone, MaxTargets: 0,
 			},
 			other: Attack{
 				Name: "Sword", TargetCharacteristic: STR,
 				Dice: dice.D6, DiceCnt: 1, Charges: -1,
-				IsBlast: false,
+				IsBlast: false, Pool: PoolHighest, Traits: TraitNone, PairedWith: 0,
+				Inflicts: Condition{Kind: ConditionNone, Rounds: 0}, Recharge: nil,
+				DmgMod: 0, MinDmg: 0, Usage: UsageAlways, Area: AreaZone, MaxTargets: 0,
 			},
 			want: false,
 		},
@@ -138,12 +418,16 @@ func TestAttackEquals(t *testing.T) {
 			this: Attack{
 				Name: "Knife", TargetCharacteristic: STR,
 				Dice: dice.D6, DiceCnt: 1, Charges: -1,
-				IsBlast: false,
+				IsBlast: false, Pool: PoolHighest, Traits: TraitNone, PairedWith: 0,
+				Inflicts: Condition{Kind: ConditionNone, Rounds: 0}, Recharge: nil,
+				DmgMod: 0, MinDmg: 0, Usage: UsageAlways, Area: AreaZone, MaxTargets: 0,
 			},
 			other: Attack{
 				Name: "Knife", TargetCharacteristic: DEX,
 				Dice: dice.D6, DiceCnt: 1, Charges: -1,
-				IsBlast: false,
+				IsBlast: false, Pool: PoolHighest, Traits: TraitNone, PairedWith: 0,
+				Inflicts: Condition{Kind: ConditionNone, Rounds: 0}, Recharge: nil,
+				DmgMod: 0, MinDmg: 0, Usage: UsageAlways, Area: AreaZone, MaxTargets: 0,
 			},
 			want: false,
 		},
@@ -152,12 +436,16 @@ func TestAttackEquals(t *testing.T) {
 			this: Attack{
 				Name: "Knife", TargetCharacteristic: STR,
 				Dice: dice.D6, DiceCnt: 1, Charges: -1,
-				IsBlast: false,
+				IsBlast: false, Pool: PoolHighest, Traits: TraitNone, PairedWith: 0,
+				Inflicts: Condition{Kind: ConditionNone, Rounds: 0}, Recharge: nil,
+				DmgMod: 0, MinDmg: 0, Usage: UsageAlways, Area: AreaZone, MaxTargets: 0,
 			},
 			other: Attack{
 				Name: "Knife", TargetCharacteristic: STR,
 				Dice: dice.D8, DiceCnt: 1, Charges: -1,
-				IsBlast: false,
+				IsBlast: false, Pool: PoolHighest, Traits: TraitNone, PairedWith: 0,
+				Inflicts: Condition{Kind: ConditionNone, Rounds: 0}, Recharge: nil,
+				DmgMod: 0, MinDmg: 0, Usage: UsageAlways, Area: AreaZone, MaxTargets: 0,
 			},
 			want: false,
 		},
@@ -166,12 +454,16 @@ func TestAttackEquals(t *testing.T) {
 			this: Attack{
 				Name: "Knife", TargetCharacteristic: STR,
 				Dice: dice.D6, DiceCnt: 1, Charges: -1,
-				IsBlast: false,
+				IsBlast: false, Pool: PoolHighest, Traits: TraitNone, PairedWith: 0,
+				Inflicts: Condition{Kind: ConditionNone, Rounds: 0}, Recharge: nil,
+				DmgMod: 0, MinDmg: 0, Usage: UsageAlways, Area: AreaZone, MaxTargets: 0,
 			},
 			other: Attack{
 				Name: "Knife", TargetCharacteristic: STR,
 				Dice: dice.D6, DiceCnt: 2, Charges: -1,
-				IsBlast: false,
+				IsBlast: false, Pool: PoolHighest, Traits: TraitNone, PairedWith: 0,
+				Inflicts: Condition{Kind: ConditionNone, Rounds: 0}, Recharge: nil,
+				DmgMod: 0, MinDmg: 0, Usage: UsageAlways, Area: AreaZone, MaxTargets: 0,
 			},
 			want: false,
 		},
@@ -180,12 +472,16 @@ func TestAttackEquals(t *testing.T) {
 			this: Attack{
 				Name: "Knife", TargetCharacteristic: STR,
 				Dice: dice.D6, DiceCnt: 1, Charges: -1,
-				IsBlast: false,
+				IsBlast: false, Pool: PoolHighest, Traits: TraitNone, PairedWith: 0,
+				Inflicts: Condition{Kind: ConditionNone, Rounds: 0}, Recharge: nil,
+				DmgMod: 0, MinDmg: 0, Usage: UsageAlways, Area: AreaZone, MaxTargets: 0,
 			},
 			other: Attack{
 				Name: "Knife", TargetCharacteristic: STR,
 				Dice: dice.D6, DiceCnt: 1, Charges: 1,
-				IsBlast: false,
+				IsBlast: false, Pool: PoolHighest, Traits: TraitNone, PairedWith: 0,
+				Inflicts: Condition{Kind: ConditionNone, Rounds: 0}, Recharge: nil,
+				DmgMod: 0, MinDmg: 0, Usage: UsageAlways, Area: AreaZone, MaxTargets: 0,
 			},
 			want: false,
 		},
@@ -194,12 +490,257 @@ func TestAttackEquals(t *testing.T) {
 			this: Attack{
 				Name: "Knife", TargetCharacteristic: STR,
 				Dice: dice.D6, DiceCnt: 1, Charges: -1,
-				IsBlast: false,
+				IsBlast: false, Pool: PoolHighest, Traits: TraitNone, PairedWith: 0,
+				Inflicts: Condition{Kind: ConditionNone, Rounds: 0}, Recharge: nil,
+				DmgMod: 0, MinDmg: 0, Usage: UsageAlways, Area: AreaZone, MaxTargets: 0,
+			},
+			other: Attack{
+				Name: "Knife", TargetCharacteristic: STR,
+				Dice: dice.D6, DiceCnt: 1, Charges: -1,
+				IsBlast: true, Pool: PoolHighest, Traits: TraitNone, PairedWith: 0,
+				Inflicts: Condition{Kind: ConditionNone, Rounds: 0}, Recharge: nil,
+				DmgMod: 0, MinDmg: 0, Usage: UsageAlways, Area: AreaZone, MaxTargets: 0,
+			},
+			want: false,
+		},
+		{
+			name: "DifferentPool",
+			this: Attack{
+				Name: "Knife", TargetCharacteristic: STR,
+				Dice: dice.D6, DiceCnt: 2, Charges: -1,
+				IsBlast: false, Pool: PoolHighest, Traits: TraitNone, PairedWith: 0,
+				Inflicts: Condition{Kind: ConditionNone, Rounds: 0}, Recharge: nil,
+				DmgMod: 0, MinDmg: 0, Usage: UsageAlways, Area: AreaZone, MaxTargets: 0,
+			},
+			other: Attack{
+				Name: "Knife", TargetCharacteristic: STR,
+				Dice: dice.D6, DiceCnt: 2, Charges: -1,
+				IsBlast: false, Pool: PoolSum, Traits: TraitNone, PairedWith: 0,
+				Inflicts: Condition{Kind: ConditionNone, Rounds: 0}, Recharge: nil,
+				DmgMod: 0, MinDmg: 0, Usage: UsageAlways, Area: AreaZone, MaxTargets: 0,
+			},
+			want: false,
+		},
+		{
+			name: "DifferentTraits",
+			this: Attack{
+				Name: "Knife", TargetCharacteristic: STR,
+				Dice: dice.D6, DiceCnt: 1, Charges: -1,
+				IsBlast: false, Pool: PoolHighest, Traits: TraitSilvered, PairedWith: 0,
+				Inflicts: Condition{Kind: ConditionNone, Rounds: 0}, Recharge: nil,
+				DmgMod: 0, MinDmg: 0, Usage: UsageAlways, Area: AreaZone, MaxTargets: 0,
+			},
+			other: Attack{
+				Name: "Knife", TargetCharacteristic: STR,
+				Dice: dice.D6, DiceCnt: 1, Charges: -1,
+				IsBlast: false, Pool: PoolHighest, Traits: TraitNone, PairedWith: 0,
+				Inflicts: Condition{Kind: ConditionNone, Rounds: 0}, Recharge: nil,
+				DmgMod: 0, MinDmg: 0, Usage: UsageAlways, Area: AreaZone, MaxTargets: 0,
+			},
+			want: false,
+		},
+		{
+			name: "DifferentPairedWith",
+			this: Attack{
+				Name: "Knife", TargetCharacteristic: STR,
+				Dice: dice.D6, DiceCnt: 1, Charges: -1,
+				IsBlast: false, Pool: PoolHighest, Traits: TraitNone, PairedWith: 2,
+				Inflicts: Condition{Kind: ConditionNone, Rounds: 0}, Recharge: nil,
+				DmgMod: 0, MinDmg: 0, Usage: UsageAlways, Area: AreaZone, MaxTargets: 0,
+			},
+			other: Attack{
+				Name: "Knife", TargetCharacteristic: STR,
+				Dice: dice.D6, DiceCnt: 1, Charges: -1,
+				IsBlast: false, Pool: PoolHighest, Traits: TraitNone, PairedWith: 0,
+				Inflicts: Condition{Kind: ConditionNone, Rounds: 0}, Recharge: nil,
+				DmgMod: 0, MinDmg: 0, Usage: UsageAlways, Area: AreaZone, MaxTargets: 0,
+			},
+			want: false,
+		},
+		{
+			name: "DifferentDmgMod",
+			this: Attack{
+				Name: "Knife", TargetCharacteristic: STR,
+				Dice: dice.D6, DiceCnt: 1, Charges: -1,
+				IsBlast: false, Pool: PoolHighest, Traits: TraitNone, PairedWith: 0,
+				Inflicts: Condition{Kind: ConditionNone, Rounds: 0}, Recharge: nil,
+				DmgMod: 1, MinDmg: 0, Usage: UsageAlways, Area: AreaZone, MaxTargets: 0,
+			},
+			other: Attack{
+				Name: "Knife", TargetCharacteristic: STR,
+				Dice: dice.D6, DiceCnt: 1, Charges: -1,
+				IsBlast: false, Pool: PoolHighest, Traits: TraitNone, PairedWith: 0,
+				Inflicts: Condition{Kind: ConditionNone, Rounds: 0}, Recharge: nil,
+				DmgMod: 0, MinDmg: 0, Usage: UsageAlways, Area: AreaZone, MaxTargets: 0,
+			},
+			want: false,
+		},
+		{
+			name: "DifferentMinDmg",
+			this: Attack{
+				Name: "Knife", TargetCharacteristic: STR,
+				Dice: dice.D6, DiceCnt: 1, Charges: -1,
+				IsBlast: false, Pool: PoolHighest, Traits: TraitNone, PairedWith: 0,
+				Inflicts: Condition{Kind: ConditionNone, Rounds: 0}, Recharge: nil,
+				DmgMod: 0, MinDmg: 2, Usage: UsageAlways, Area: AreaZone, MaxTargets: 0,
+			},
+			other: Attack{
+				Name: "Knife", TargetCharacteristic: STR,
+				Dice: dice.D6, DiceCnt: 1, Charges: -1,
+				IsBlast: false, Pool: PoolHighest, Traits: TraitNone, PairedWith: 0,
+				Inflicts: Condition{Kind: ConditionNone, Rounds: 0}, Recharge: nil,
+				DmgMod: 0, MinDmg: 0, Usage: UsageAlways, Area: AreaZone, MaxTargets: 0,
+			},
+			want: false,
+		},
+		{
+			name: "DifferentUsage",
+			this: Attack{
+				Name: "Knife", TargetCharacteristic: STR,
+				Dice: dice.D6, DiceCnt: 1, Charges: -1,
+				IsBlast: false, Pool: PoolHighest, Traits: TraitNone, PairedWith: 0,
+				Inflicts: Condition{Kind: ConditionNone, Rounds: 0}, Recharge: nil,
+				DmgMod: 0, MinDmg: 0, Usage: UsageFirstRound,
+				Area: AreaZone, MaxTargets: 0,
+			},
+			other: Attack{
+				Name: "Knife", TargetCharacteristic: STR,
+				Dice: dice.D6, DiceCnt: 1, Charges: -1,
+				IsBlast: false, Pool: PoolHighest, Traits: TraitNone, PairedWith: 0,
+				Inflicts: Condition{Kind: ConditionNone, Rounds: 0}, Recharge: nil,
+				DmgMod: 0, MinDmg: 0, Usage: UsageAlways, Area: AreaZone, MaxTargets: 0,
+			},
+			want: false,
+		},
+		{
+			name: "DifferentArea",
+			this: Attack{
+				Name: "Fireball", TargetCharacteristic: STR,
+				Dice: dice.D6, DiceCnt: 1, Charges: -1,
+				IsBlast: true, Pool: PoolHighest, Traits: TraitNone, PairedWith: 0,
+				Inflicts: Condition{Kind: ConditionNone, Rounds: 0}, Recharge: nil,
+				DmgMod: 0, MinDmg: 0, Usage: UsageAlways,
+				Area: AreaAll, MaxTargets: 0,
+			},
+			other: Attack{
+				Name: "Fireball", TargetCharacteristic: STR,
+				Dice: dice.D6, DiceCnt: 1, Charges: -1,
+				IsBlast: true, Pool: PoolHighest, Traits: TraitNone, PairedWith: 0,
+				Inflicts: Condition{Kind: ConditionNone, Rounds: 0}, Recharge: nil,
+				DmgMod: 0, MinDmg: 0, Usage: UsageAlways,
+				Area: AreaZone, MaxTargets: 0,
+			},
+			want: false,
+		},
+		{
+			name: "DifferentMaxTargets",
+			this: Attack{
+				Name: "Fireball", TargetCharacteristic: STR,
+				Dice: dice.D6, DiceCnt: 1, Charges: -1,
+				IsBlast: true, Pool: PoolHighest, Traits: TraitNone, PairedWith: 0,
+				Inflicts: Condition{Kind: ConditionNone, Rounds: 0}, Recharge: nil,
+				DmgMod: 0, MinDmg: 0, Usage: UsageAlways,
+				Area: AreaZone, MaxTargets: 2,
+			},
+			other: Attack{
+				Name: "Fireball", TargetCharacteristic: STR,
+				Dice: dice.D6, DiceCnt: 1, Charges: -1,
+				IsBlast: true, Pool: PoolHighest, Traits: TraitNone, PairedWith: 0,
+				Inflicts: Condition{Kind: ConditionNone, Rounds: 0}, Recharge: nil,
+				DmgMod: 0, MinDmg: 0, Usage: UsageAlways,
+				Area: AreaZone, MaxTargets: 3,
+			},
+			want: false,
+		},
+		{
+			name: "DifferentInflicts",
+			this: Attack{
+				Name: "Knife", TargetCharacteristic: STR,
+				Dice: dice.D6, DiceCnt: 1, Charges: -1,
+				IsBlast: false, Pool: PoolHighest, Traits: TraitNone, PairedWith: 0,
+				Inflicts: Condition{Kind: ConditionPoisoned, Rounds: 3}, Recharge: nil,
+				DmgMod: 0, MinDmg: 0, Usage: UsageAlways, Area: AreaZone, MaxTargets: 0,
 			},
 			other: Attack{
 				Name: "Knife", TargetCharacteristic: STR,
 				Dice: dice.D6, DiceCnt: 1, Charges: -1,
-				IsBlast: true,
+				IsBlast: false, Pool: PoolHighest, Traits: TraitNone, PairedWith: 0,
+				Inflicts: Condition{Kind: ConditionPoisoned, Rounds: 2}, Recharge: nil,
+				DmgMod: 0, MinDmg: 0, Usage: UsageAlways, Area: AreaZone, MaxTargets: 0,
+			},
+			want: false,
+		},
+		{
+			name: "EqualRecharges",
+			this: Attack{
+				Name: "Fire Breath", TargetCharacteristic: STR,
+				Dice: dice.D12, DiceCnt: 1, Charges: 0,
+				IsBlast: true, Pool: PoolHighest, Traits: TraitNone, PairedWith: 0,
+				Inflicts: Condition{Kind: ConditionNone, Rounds: 0},
+				Recharge: &Recharge{
+					Kind: RechargeEveryNRounds, Rounds: 2, Dice: 0, Threshold: 0,
+					MaxCharges: 1, Elapsed: 0,
+				},
+				DmgMod: 0, MinDmg: 0, Usage: UsageAlways, Area: AreaZone, MaxTargets: 0,
+			},
+			other: Attack{
+				Name: "Fire Breath", TargetCharacteristic: STR,
+				Dice: dice.D12, DiceCnt: 1, Charges: 0,
+				IsBlast: true, Pool: PoolHighest, Traits: TraitNone, PairedWith: 0,
+				Inflicts: Condition{Kind: ConditionNone, Rounds: 0},
+				Recharge: &Recharge{
+					Kind: RechargeEveryNRounds, Rounds: 2, Dice: 0, Threshold: 0,
+					MaxCharges: 1, Elapsed: 0,
+				},
+				DmgMod: 0, MinDmg: 0, Usage: UsageAlways, Area: AreaZone, MaxTargets: 0,
+			},
+			want: true,
+		},
+		{
+			name: "DifferentRecharges",
+			this: Attack{
+				Name: "Fire Breath", TargetCharacteristic: STR,
+				Dice: dice.D12, DiceCnt: 1, Charges: 0,
+				IsBlast: true, Pool: PoolHighest, Traits: TraitNone, PairedWith: 0,
+				Inflicts: Condition{Kind: ConditionNone, Rounds: 0},
+				Recharge: &Recharge{
+					Kind: RechargeEveryNRounds, Rounds: 2, Dice: 0, Threshold: 0,
+					MaxCharges: 1, Elapsed: 0,
+				},
+				DmgMod: 0, MinDmg: 0, Usage: UsageAlways, Area: AreaZone, MaxTargets: 0,
+			},
+			other: Attack{
+				Name: "Fire Breath", TargetCharacteristic: STR,
+				Dice: dice.D12, DiceCnt: 1, Charges: 0,
+				IsBlast: true, Pool: PoolHighest, Traits: TraitNone, PairedWith: 0,
+				Inflicts: Condition{Kind: ConditionNone, Rounds: 0},
+				Recharge: &Recharge{
+					Kind: RechargeEveryNRounds, Rounds: 2, Dice: 0, Threshold: 0,
+					MaxCharges: 1, Elapsed: 1,
+				},
+				DmgMod: 0, MinDmg: 0, Usage: UsageAlways, Area: AreaZone, MaxTargets: 0,
+			},
+			want: false,
+		},
+		{
+			name: "NilAndNonNilRecharges",
+			this: Attack{
+				Name: "Fire Breath", TargetCharacteristic: STR,
+				Dice: dice.D12, DiceCnt: 1, Charges: 0,
+				IsBlast: true, Pool: PoolHighest, Traits: TraitNone, PairedWith: 0,
+				Inflicts: Condition{Kind: ConditionNone, Rounds: 0},
+				Recharge: &Recharge{
+					Kind: RechargeEveryNRounds, Rounds: 2, Dice: 0, Threshold: 0,
+					MaxCharges: 1, Elapsed: 0,
+				},
+				DmgMod: 0, MinDmg: 0, Usage: UsageAlways, Area: AreaZone, MaxTargets: 0,
+			},
+			other: Attack{
+				Name: "Fire Breath", TargetCharacteristic: STR,
+				Dice: dice.D12, DiceCnt: 1, Charges: 0,
+				IsBlast: true, Pool: PoolHighest, Traits: TraitNone, PairedWith: 0,
+				Inflicts: Condition{Kind: ConditionNone, Rounds: 0}, Recharge: nil,
+				DmgMod: 0, MinDmg: 0, Usage: UsageAlways, Area: AreaZone, MaxTargets: 0,
 			},
 			want: false,
 		},
@@ -217,7 +758,13 @@ func TestAttackDeepCopy(t *testing.T) {
 	original := Attack{
 		Name: "Knife", TargetCharacteristic: STR,
 		Dice: dice.D6, DiceCnt: 1, Charges: -1,
-		IsBlast: false,
+		IsBlast: false, Pool: PoolHighest, Traits: TraitNone, PairedWith: 0,
+		Inflicts: Condition{Kind: ConditionNone, Rounds: 0},
+		Recharge: &Recharge{
+			Kind: RechargeEveryNRounds, Rounds: 2, Dice: 0, Threshold: 0,
+			MaxCharges: 1, Elapsed: 0,
+		},
+		DmgMod: 0, MinDmg: 0, Usage: UsageAlways, Area: AreaZone, MaxTargets: 0,
 	}
 	copied := original.DeepCopy()
 
@@ -229,8 +776,18 @@ func TestAttackDeepCopy(t *testing.T) {
 	copied.TargetCharacteristic = DEX
 	copied.Dice = dice.D8
 	copied.DiceCnt = 2
+	copied.DmgMod = 1
+	copied.MinDmg = 2
 	copied.Charges = 1
 	copied.IsBlast = true
+	copied.Pool = PoolSum
+	copied.Traits = TraitBulky
+	copied.Area = AreaAll
+	copied.MaxTargets = 2
+	copied.Usage = UsageBloodied
+	copied.PairedWith = 2
+	copied.Inflicts = Condition{Kind: ConditionFrightened, Rounds: 1}
+	copied.Recharge.Elapsed = 1
 
 	if original.Equals(&copied) {
 		t.Errorf("modifying the copy affected the original: %v", original)
@@ -248,10 +805,189 @@ func TestAttackDeepCopy(t *testing.T) {
 	if original.DiceCnt == copied.DiceCnt {
 		t.Errorf("original.DiceCnt == copied.DiceCnt")
 	}
+	if original.DmgMod == copied.DmgMod {
+		t.Errorf("original.DmgMod == copied.DmgMod")
+	}
+	if original.MinDmg == copied.MinDmg {
+		t.Errorf("original.MinDmg == copied.MinDmg")
+	}
 	if original.Charges == copied.Charges {
 		t.Errorf("original.Charges == copied.Charges")
 	}
 	if original.IsBlast == copied.IsBlast {
 		t.Errorf("original.IsBlast == copied.IsBlast")
 	}
+	if original.Pool == copied.Pool {
+		t.Errorf("original.Pool == copied.Pool")
+	}
+	if original.Traits == copied.Traits {
+		t.Errorf("original.Traits == copied.Traits")
+	}
+	if original.Area == copied.Area {
+		t.Errorf("original.Area == copied.Area")
+	}
+	if original.MaxTargets == copied.MaxTargets {
+		t.Errorf("original.MaxTargets == copied.MaxTargets")
+	}
+	if original.Usage == copied.Usage {
+		t.Errorf("original.Usage == copied.Usage")
+	}
+	if original.PairedWith == copied.PairedWith {
+		t.Errorf("original.PairedWith == copied.PairedWith")
+	}
+	if original.Inflicts == copied.Inflicts {
+		t.Errorf("original.Inflicts == copied.Inflicts")
+	}
+	if *original.Recharge == *copied.Recharge {
+		t.Errorf("*original.Recharge == *copied.Recharge")
+	}
+}
+
+func TestAttackModifyDmg(t *testing.T) {
+	tests := []struct {
+		name         string
+		dmgMod       int8
+		minDmg, roll uint8
+		want         uint8
+	}{
+		{name: "NoModifiers", dmgMod: 0, minDmg: 0, roll: 3, want: 3},
+		{name: "PositiveDmgMod", dmgMod: 1, minDmg: 0, roll: 3, want: 4},
+		{name: "NegativeDmgMod", dmgMod: -2, minDmg: 0, roll: 3, want: 1},
+		{name: "NegativeDmgModFloor", dmgMod: -2, minDmg: 0, roll: 1, want: 0},
+		{name: "MinDmgApplied", dmgMod: 0, minDmg: 2, roll: 1, want: 2},
+		{name: "MinDmgNotApplied", dmgMod: 0, minDmg: 2, roll: 5, want: 5},
+		{name: "MinDmgAfterDmgMod", dmgMod: -3, minDmg: 2, roll: 4, want: 2},
+		{name: "DmgModCeiling", dmgMod: 10, minDmg: 0, roll: 250, want: 255},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			attack := Attack{
+				Name: "Spear", TargetCharacteristic: STR,
+				Dice: dice.D6, DiceCnt: 1, Charges: -1,
+				IsBlast: false, Pool: PoolHighest, Traits: TraitNone, PairedWith: 0,
+				Inflicts: Condition{Kind: ConditionNone, Rounds: 0}, Recharge: nil,
+				DmgMod: test.dmgMod, MinDmg: test.minDmg, Usage: UsageAlways,
+				Area: AreaZone, MaxTargets: 0,
+			}
+			if got := attack.ModifyDmg(test.roll); got != test.want {
+				t.Fatalf(
+					"Attack.ModifyDmg(%d) = %d, want %d", test.roll, got, test.want,
+				)
+			}
+		})
+	}
+}
+
+func TestAttackMaxDmg(t *testing.T) {
+	tests := []struct {
+		name    string
+		pool    Pool
+		dmgMod  int8
+		diceCnt uint8
+		minDmg  uint8
+		want    uint
+	}{
+		{
+			name: "NoModifiers",
+			pool: PoolHighest, dmgMod: 0, diceCnt: 2, minDmg: 0, want: 6,
+		},
+		{
+			name: "SumWithDmgMod",
+			pool: PoolSum, dmgMod: 1, diceCnt: 2, minDmg: 0, want: 13,
+		},
+		{
+			name: "NegativeDmgModFloor",
+			pool: PoolHighest, dmgMod: -8, diceCnt: 1, minDmg: 0, want: 0,
+		},
+		{
+			name: "MinDmgAboveMax",
+			pool: PoolHighest, dmgMod: -8, diceCnt: 1, minDmg: 1, want: 1,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			attack := Attack{
+				Name: "Spear", TargetCharacteristic: STR,
+				Dice: dice.D6, DiceCnt: test.diceCnt, Charges: -1,
+				IsBlast: false, Pool: test.pool, Traits: TraitNone, PairedWith: 0,
+				Inflicts: Condition{Kind: ConditionNone, Rounds: 0}, Recharge: nil,
+				DmgMod: test.dmgMod, MinDmg: test.minDmg, Usage: UsageAlways,
+				Area: AreaZone, MaxTargets: 0,
+			}
+			if got := attack.MaxDmg(); got != test.want {
+				t.Fatalf("Attack.MaxDmg() = %d, want %d", got, test.want)
+			}
+		})
+	}
+}
+
+func TestAttackReaches(t *testing.T) {
+	tests := []struct {
+		name     string
+		traits   Traits
+		from, to Zone
+		want     bool
+	}{
+		{
+			name: "MeleeEngaged", traits: TraitNone,
+			from: ZoneEngaged, to: ZoneEngaged, want: true,
+		},
+		{
+			name: "MeleeNear", traits: TraitNone,
+			from: ZoneEngaged, to: ZoneNear, want: false,
+		},
+		{
+			name: "ReachNear", traits: TraitReach,
+			from: ZoneNear, to: ZoneEngaged, want: true,
+		},
+		{
+			name: "ReachFar", traits: TraitReach,
+			from: ZoneEngaged, to: ZoneFar, want: false,
+		},
+		{
+			name: "RangedFar", traits: TraitRanged,
+			from: ZoneFar, to: ZoneFar, want: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			attack := Attack{
+				Name: "Spear", TargetCharacteristic: STR,
+				Dice: dice.D6, DiceCnt: 1, Charges: -1,
+				IsBlast: false, Pool: PoolHighest, Traits: test.traits, PairedWith: 0,
+				Inflicts: Condition{Kind: ConditionNone, Rounds: 0}, Recharge: nil,
+				DmgMod: 0, MinDmg: 0, Usage: UsageAlways, Area: AreaZone, MaxTargets: 0,
+			}
+			if got := attack.Reaches(test.from, test.to); got != test.want {
+				t.Fatalf("Attack.Reaches() = %t, want %t", got, test.want)
+			}
+		})
+	}
+}
+
+func TestAttackIsImpairedFrom(t *testing.T) {
+	tests := []struct {
+		name   string
+		traits Traits
+		from   Zone
+		want   bool
+	}{
+		{name: "MeleeEngaged", traits: TraitNone, from: ZoneEngaged, want: false},
+		{name: "RangedEngaged", traits: TraitRanged, from: ZoneEngaged, want: true},
+		{name: "RangedNear", traits: TraitRanged, from: ZoneNear, want: false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			attack := Attack{
+				Name: "Bow", TargetCharacteristic: STR,
+				Dice: dice.D6, DiceCnt: 1, Charges: -1,
+				IsBlast: false, Pool: PoolHighest, Traits: test.traits, PairedWith: 0,
+				Inflicts: Condition{Kind: ConditionNone, Rounds: 0}, Recharge: nil,
+				DmgMod: 0, MinDmg: 0, Usage: UsageAlways, Area: AreaZone, MaxTargets: 0,
+			}
+			if got := attack.IsImpairedFrom(test.from); got != test.want {
+				t.Fatalf("Attack.IsImpairedFrom() = %t, want %t", got, test.want)
+			}
+		})
+	}
 }
@@ -0,0 +1,148 @@
+package atk
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/rozag/cabasi/internal/codec"
+)
+
+// ConditionKind represents a kind of a status effect a creature can suffer
+// from.
+type ConditionKind uint8
+
+const (
+	// ConditionNone is the absence of a condition.
+	ConditionNone ConditionKind = iota
+	// ConditionPoisoned makes the creature lose 1 STR at the end of each round.
+	ConditionPoisoned
+	// ConditionParalysed makes the creature unable to act.
+	ConditionParalysed
+	// ConditionFrightened makes the creature's attacks impaired.
+	ConditionFrightened
+	// ConditionBlinded makes the creature's attacks impaired.
+	ConditionBlinded
+	// ConditionProtected is a beneficial condition granting the creature 1
+	// extra Armor.
+	ConditionProtected
+	// ConditionEnhanced is a beneficial condition making the creature's attacks
+	// enhanced.
+	ConditionEnhanced
+)
+
+// String returns the string representation of the ConditionKind.
+func (k ConditionKind) String() string {
+	switch k {
+	case ConditionNone:
+		return "None"
+	case ConditionPoisoned:
+		return "Poisoned"
+	case ConditionParalysed:
+		return "Paralysed"
+	case ConditionFrightened:
+		return "Frightened"
+	case ConditionBlinded:
+		return "Blinded"
+	case ConditionProtected:
+		return "Protected"
+	case ConditionEnhanced:
+		return "Enhanced"
+	default:
+		panic(fmt.Errorf("unknown ConditionKind: %d", k))
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler, the ConditionKind is
+// encoded as its string representation, e.g. "Poisoned".
+func (k ConditionKind) MarshalText() ([]byte, error) {
+	return codec.MarshalText("condition kind", k, conditionKinds()...)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, it decodes a ConditionKind
+// encoded as its string representation, case-insensitive.
+func (k *ConditionKind) UnmarshalText(text []byte) error {
+	value, err := codec.UnmarshalText(
+		"condition kind", text, conditionKinds()...,
+	)
+	if err != nil {
+		return err
+	}
+	*k = value
+	return nil
+}
+
+// IsBeneficial checks if the condition helps the creature instead of harming
+// it. Beneficial conditions are granted by allies, never inflicted by attacks.
+func (k ConditionKind) IsBeneficial() bool {
+	return k == ConditionProtected || k == ConditionEnhanced
+}
+
+// SaveCharacteristic returns the characteristic a creature saves with to
+// avoid the condition: STR for poison and paralysis, WIL for fear, and DEX for
+// blindness. It panics if the kind is unknown, beneficial, or ConditionNone.
+func (k ConditionKind) SaveCharacteristic() Characteristic {
+	switch k {
+	case ConditionPoisoned, ConditionParalysed:
+		return STR
+	case ConditionFrightened:
+		return WIL
+	case ConditionBlinded:
+		return DEX
+	case ConditionNone:
+		panic(errors.New("ConditionNone has no save characteristic"))
+	case ConditionProtected, ConditionEnhanced:
+		panic(fmt.Errorf("beneficial condition %s has no save characteristic", k))
+	default:
+		panic(fmt.Errorf("unknown ConditionKind: %d", k))
+	}
+}
+
+// Condition is a status effect lasting for a number of rounds.
+type Condition struct {
+	Kind   ConditionKind
+	Rounds int8 // <0 means until the end of the battle
+}
+
+// String returns the string representation of the Condition.
+func (c Condition) String() string {
+	return fmt.Sprintf("Condition{Kind: %s, Rounds: %d}", c.Kind, c.Rounds)
+}
+
+// Validate checks if the Condition is valid. ConditionNone must last for 0
+// rounds, any other kind must last for at least 1 round or until the end of
+// the battle. It returns an error with `Unwrap() []error` method to get all
+// the errors or `nil` if the condition is valid. The errors are reported as
+// *codec.FieldError, e.g. "rounds".
+func (c Condition) Validate() error {
+	var errs []error
+
+	switch c.Kind {
+	case ConditionNone:
+		if c.Rounds != 0 {
+			errs = append(errs, codec.WrapField("rounds", fmt.Errorf(
+				"condition None must last for 0 rounds, got %d", c.Rounds,
+			)))
+		}
+	case ConditionPoisoned, ConditionParalysed, ConditionFrightened,
+		ConditionBlinded, ConditionProtected, ConditionEnhanced:
+		if c.Rounds == 0 {
+			errs = append(errs, codec.WrapField("rounds", fmt.Errorf(
+				"condition %s must last for at least 1 round", c.Kind,
+			)))
+		}
+	default:
+		errs = append(errs, codec.WrapField(
+			"kind", fmt.Errorf("invalid condition kind: %d", c.Kind),
+		))
+	}
+
+	return errors.Join(errs...)
+}
+
+// conditionKinds returns all the known condition kinds.
+func conditionKinds() []ConditionKind {
+	return []ConditionKind{
+		ConditionNone, ConditionPoisoned, ConditionParalysed, ConditionFrightened,
+		ConditionBlinded, ConditionProtected, ConditionEnhanced,
+	}
+}
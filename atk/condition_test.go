@@ -0,0 +1,99 @@
+package atk
+
+import "testing"
+
+func TestConditionKindSaveCharacteristic(t *testing.T) {
+	tests := []struct {
+		kind ConditionKind
+		want Characteristic
+	}{
+		{kind: ConditionPoisoned, want: STR},
+		{kind: ConditionParalysed, want: STR},
+		{kind: ConditionFrightened, want: WIL},
+		{kind: ConditionBlinded, want: DEX},
+	}
+	for _, test := range tests {
+		t.Run(test.kind.String(), func(t *testing.T) {
+			if got := test.kind.SaveCharacteristic(); got != test.want {
+				t.Fatalf(
+					"ConditionKind.SaveCharacteristic() = %s, want %s",
+					got, test.want,
+				)
+			}
+		})
+	}
+}
+
+func TestConditionKindIsBeneficial(t *testing.T) {
+	tests := []struct {
+		kind ConditionKind
+		want bool
+	}{
+		{kind: ConditionNone, want: false},
+		{kind: ConditionPoisoned, want: false},
+		{kind: ConditionBlinded, want: false},
+		{kind: ConditionProtected, want: true},
+		{kind: ConditionEnhanced, want: true},
+	}
+	for _, test := range tests {
+		t.Run(test.kind.String(), func(t *testing.T) {
+			if got := test.kind.IsBeneficial(); got != test.want {
+				t.Fatalf("ConditionKind.IsBeneficial() = %t, want %t", got, test.want)
+			}
+		})
+	}
+}
+
+func TestConditionValidate(t *testing.T) {
+	tests := []struct {
+		name      string
+		condition Condition
+		wantErr   bool
+	}{
+		{
+			name:      "None",
+			condition: Condition{Kind: ConditionNone, Rounds: 0},
+			wantErr:   false,
+		},
+		{
+			name:      "NoneWithRounds",
+			condition: Condition{Kind: ConditionNone, Rounds: 2},
+			wantErr:   true,
+		},
+		{
+			name:      "Timed",
+			condition: Condition{Kind: ConditionParalysed, Rounds: 2},
+			wantErr:   false,
+		},
+		{
+			name:      "UntilTheEnd",
+			condition: Condition{Kind: ConditionPoisoned, Rounds: -1},
+			wantErr:   false,
+		},
+		{
+			name:      "Beneficial",
+			condition: Condition{Kind: ConditionProtected, Rounds: 1},
+			wantErr:   false,
+		},
+		{
+			name:      "ZeroRounds",
+			condition: Condition{Kind: ConditionFrightened, Rounds: 0},
+			wantErr:   true,
+		},
+		{
+			name:      "UnknownKind",
+			condition: Condition{Kind: ConditionKind(42), Rounds: 1},
+			wantErr:   true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.condition.Validate()
+			if (err != nil) != test.wantErr {
+				t.Fatalf(
+					"Condition.Validate(): want error %t, got %v", test.wantErr, err,
+				)
+			}
+		})
+	}
+}
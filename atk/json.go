@@ -0,0 +1,306 @@
+package atk
+
+import (
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/rozag/cabasi/internal/codec"
+)
+
+// UnlimitedCharges is the encoding of unlimited charges.
+const UnlimitedCharges = "unlimited"
+
+// Charges is the number of charges of an attack or a support as encoded in
+// JSON - a number or "unlimited" for negative numbers.
+type Charges int8
+
+// MarshalJSON implements json.Marshaler.
+func (c Charges) MarshalJSON() ([]byte, error) {
+	if c < 0 {
+		return []byte(strconv.Quote(UnlimitedCharges)), nil
+	}
+	return []byte(strconv.Itoa(int(c))), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, it decodes a non-negative number
+// or "unlimited" decoded as -1.
+func (c *Charges) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err == nil {
+		if text != UnlimitedCharges {
+			return fmt.Errorf("want a number or %q, got %q", UnlimitedCharges, text)
+		}
+		*c = -1
+		return nil
+	}
+
+	var cnt int8
+	if err := json.Unmarshal(data, &cnt); err != nil || cnt < 0 {
+		return fmt.Errorf(
+			"want a non-negative number or %q, got %s", UnlimitedCharges, data,
+		)
+	}
+	*c = Charges(cnt)
+	return nil
+}
+
+// conditionJSON is the JSON encoding of a Condition.
+type conditionJSON struct {
+	Kind   string `json:"kind"`
+	Rounds int8   `json:"rounds"`
+}
+
+// MarshalJSON implements json.Marshaler, e.g.
+// {"kind": "Poisoned", "rounds": 2}.
+func (c Condition) MarshalJSON() ([]byte, error) {
+	var errs []error
+	raw := conditionJSON{
+		Kind: encodeText("kind", c.Kind, &errs), Rounds: c.Rounds,
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return marshalJSON("condition", raw)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Errors of the fields are
+// reported as *codec.FieldError.
+func (c *Condition) UnmarshalJSON(data []byte) error {
+	var raw conditionJSON
+	if err := codec.DecodeJSON(data, &raw); err != nil {
+		return err
+	}
+
+	var errs []error
+	condition := Condition{Kind: ConditionNone, Rounds: raw.Rounds}
+	decodeText("kind", raw.Kind, &condition.Kind, &errs)
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	*c = condition
+	return nil
+}
+
+// rechargeJSON is the JSON encoding of a Recharge. Dice is omitted if it's
+// not set, e.g. for RechargeEveryNRounds.
+type rechargeJSON struct {
+	Kind       string `json:"kind"`
+	Dice       string `json:"dice,omitempty"`
+	Rounds     uint8  `json:"rounds"`
+	Threshold  uint8  `json:"threshold"`
+	MaxCharges int8   `json:"maxCharges"`
+	Elapsed    uint8  `json:"elapsed"`
+}
+
+// MarshalJSON implements json.Marshaler, e.g.
+// {"kind": "OnRoll", "dice": "d6", "threshold": 5, "maxCharges": 1, ...}.
+func (r Recharge) MarshalJSON() ([]byte, error) {
+	var errs []error
+	raw := rechargeJSON{
+		Kind: encodeText("kind", r.Kind, &errs), Dice: "",
+		Rounds: r.Rounds, Threshold: r.Threshold,
+		MaxCharges: r.MaxCharges, Elapsed: r.Elapsed,
+	}
+	if r.Dice != 0 {
+		raw.Dice = encodeText("dice", r.Dice, &errs)
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return marshalJSON("recharge", raw)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Errors of the fields are
+// reported as *codec.FieldError.
+func (r *Recharge) UnmarshalJSON(data []byte) error {
+	var raw rechargeJSON
+	if err := codec.DecodeJSON(data, &raw); err != nil {
+		return err
+	}
+
+	var errs []error
+	recharge := Recharge{
+		Kind: RechargeEveryNRounds, Rounds: raw.Rounds, Dice: 0,
+		Threshold: raw.Threshold, MaxCharges: raw.MaxCharges,
+		Elapsed: raw.Elapsed,
+	}
+	decodeText("kind", raw.Kind, &recharge.Kind, &errs)
+	if len(raw.Dice) > 0 {
+		decodeText("dice", raw.Dice, &recharge.Dice, &errs)
+	}
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	*r = recharge
+	return nil
+}
+
+// attackJSON is the JSON encoding of an Attack. PairedWith, Inflicts and
+// Recharge are omitted if they aren't set.
+type attackJSON struct {
+	Name       string          `json:"name"`
+	Target     string          `json:"target"`
+	Dice       string          `json:"dice"`
+	Area       string          `json:"area"`
+	Pool       string          `json:"pool"`
+	Traits     string          `json:"traits"`
+	Usage      string          `json:"usage"`
+	Inflicts   json.RawMessage `json:"inflicts,omitempty"`
+	Recharge   json.RawMessage `json:"recharge,omitempty"`
+	Charges    json.RawMessage `json:"charges"`
+	PairedWith uint8           `json:"pairedWith,omitempty"`
+	DiceCnt    uint8           `json:"diceCnt"`
+	DmgMod     int8            `json:"dmgMod"`
+	MinDmg     uint8           `json:"minDmg"`
+	MaxTargets uint8           `json:"maxTargets"`
+	IsBlast    bool            `json:"isBlast"`
+}
+
+// MarshalJSON implements json.Marshaler. Enums are encoded as their string
+// representations, e.g. "STR" and "d6", and charges as a number or
+// "unlimited". Like the other methods of Attack it has a pointer receiver, so
+// encode a *Attack.
+func (a *Attack) MarshalJSON() ([]byte, error) {
+	var errs []error
+	raw := attackJSON{
+		Name:       a.Name,
+		Target:     encodeText("target", a.TargetCharacteristic, &errs),
+		Dice:       encodeText("dice", a.Dice, &errs),
+		Area:       encodeText("area", a.Area, &errs),
+		Pool:       encodeText("pool", a.Pool, &errs),
+		Traits:     encodeText("traits", a.Traits, &errs),
+		Usage:      encodeText("usage", a.Usage, &errs),
+		Inflicts:   nil,
+		Recharge:   nil,
+		Charges:    nil,
+		PairedWith: a.PairedWith,
+		DiceCnt:    a.DiceCnt,
+		DmgMod:     a.DmgMod,
+		MinDmg:     a.MinDmg,
+		MaxTargets: a.MaxTargets,
+		IsBlast:    a.IsBlast,
+	}
+	var err error
+	if a.Inflicts.Kind != ConditionNone || a.Inflicts.Rounds != 0 {
+		raw.Inflicts, err = a.Inflicts.MarshalJSON()
+		if err != nil {
+			errs = append(errs, codec.WrapField("inflicts", err))
+		}
+	}
+	if a.Recharge != nil {
+		raw.Recharge, err = a.Recharge.MarshalJSON()
+		if err != nil {
+			errs = append(errs, codec.WrapField("recharge", err))
+		}
+	}
+	// Charges are always encoded successfully.
+	raw.Charges, _ = Charges(a.Charges).MarshalJSON()
+
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return marshalJSON("attack", raw)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Missing fields default to a
+// single die against STR with unlimited charges, the default Pool and Area,
+// no traits, no usage conditions, no pairing, no inflicted condition, and no
+// recharge. Errors of the fields are reported as *codec.FieldError. The decoded
+// attack isn't validated, see Validate.
+func (a *Attack) UnmarshalJSON(data []byte) error {
+	raw := attackJSON{
+		Name: "", Target: STR.String(), Dice: "",
+		Area: AreaZone.String(), Pool: PoolHighest.String(),
+		Traits: TraitNone.String(), Usage: UsageAlways.String(),
+		Inflicts: nil, Recharge: nil, Charges: nil, PairedWith: 0,
+		DiceCnt: 1, DmgMod: 0, MinDmg: 0, MaxTargets: 0, IsBlast: false,
+	}
+	if err := codec.DecodeJSON(data, &raw); err != nil {
+		return err
+	}
+
+	var errs []error
+	attack := Attack{
+		Name: raw.Name, Recharge: nil, TargetCharacteristic: STR,
+		Dice: 0, DiceCnt: raw.DiceCnt, DmgMod: raw.DmgMod,
+		MinDmg: raw.MinDmg, Charges: -1, IsBlast: raw.IsBlast,
+		Area: AreaZone, MaxTargets: raw.MaxTargets, Pool: PoolHighest,
+		Traits: TraitNone, Usage: UsageAlways, PairedWith: raw.PairedWith,
+		Inflicts: Condition{Kind: ConditionNone, Rounds: 0},
+	}
+	decodeText("target", raw.Target, &attack.TargetCharacteristic, &errs)
+	decodeText("dice", raw.Dice, &attack.Dice, &errs)
+	decodeText("area", raw.Area, &attack.Area, &errs)
+	decodeText("pool", raw.Pool, &attack.Pool, &errs)
+	decodeText("traits", raw.Traits, &attack.Traits, &errs)
+	decodeText("usage", raw.Usage, &attack.Usage, &errs)
+
+	if !isNullJSON(raw.Inflicts) {
+		if err := attack.Inflicts.UnmarshalJSON(raw.Inflicts); err != nil {
+			errs = append(errs, codec.WrapField("inflicts", err))
+		}
+	}
+	if !isNullJSON(raw.Recharge) {
+		var recharge Recharge
+		if err := recharge.UnmarshalJSON(raw.Recharge); err != nil {
+			errs = append(errs, codec.WrapField("recharge", err))
+		}
+		attack.Recharge = &recharge
+	}
+	if !isNullJSON(raw.Charges) {
+		var charges Charges
+		if err := charges.UnmarshalJSON(raw.Charges); err != nil {
+			errs = append(errs, codec.WrapField("charges", err))
+		}
+		attack.Charges = int8(charges)
+	}
+
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	*a = attack
+	return nil
+}
+
+// marshalJSON encodes the raw JSON representation of a value, what names the
+// value in the error.
+func marshalJSON(what string, raw any) ([]byte, error) {
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode %s: %w", what, err)
+	}
+	return data, nil
+}
+
+// isNullJSON checks if the raw JSON value is missing or null.
+func isNullJSON(raw json.RawMessage) bool {
+	return len(raw) == 0 || string(raw) == "null"
+}
+
+// encodeText encodes the value of the named field as text. The error, if any,
+// is appended to errs as a *codec.FieldError.
+func encodeText(
+	name string, value encoding.TextMarshaler, errs *[]error,
+) string {
+	text, err := value.MarshalText()
+	if err != nil {
+		*errs = append(*errs, codec.WrapField(name, err))
+	}
+	return string(text)
+}
+
+// decodeText decodes the text of the named field into the value. The error, if
+// any, is appended to errs as a *codec.FieldError.
+func decodeText(
+	name, text string, value encoding.TextUnmarshaler, errs *[]error,
+) {
+	if err := value.UnmarshalText([]byte(text)); err != nil {
+		*errs = append(*errs, codec.WrapField(name, err))
+	}
+}
@@ -0,0 +1,318 @@
+package atk
+
+import (
+	"encoding/json"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/rozag/cabasi/dice"
+	"github.com/rozag/cabasi/internal/codec"
+)
+
+func TestChargesMarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		want    string
+		charges Charges
+	}{
+		{name: "Unlimited", charges: -1, want: `"unlimited"`},
+		{name: "AnyNegative", charges: -5, want: `"unlimited"`},
+		{name: "None", charges: 0, want: `0`},
+		{name: "Some", charges: 3, want: `3`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.charges.MarshalJSON()
+			if err != nil {
+				t.Fatalf("Charges.MarshalJSON() error = %v", err)
+			}
+			if string(got) != test.want {
+				t.Fatalf("Charges.MarshalJSON() = %s, want %s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestChargesUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    Charges
+		wantErr bool
+	}{
+		{name: "Unlimited", data: `"unlimited"`, want: -1, wantErr: false},
+		{name: "None", data: `0`, want: 0, wantErr: false},
+		{name: "Some", data: `3`, want: 3, wantErr: false},
+		{name: "UnknownText", data: `"infinite"`, want: 0, wantErr: true},
+		{name: "Negative", data: `-1`, want: 0, wantErr: true},
+		{name: "OutOfRange", data: `128`, want: 0, wantErr: true},
+		{name: "Fraction", data: `1.5`, want: 0, wantErr: true},
+		{name: "Bool", data: `true`, want: 0, wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var got Charges
+			err := got.UnmarshalJSON([]byte(test.data))
+			if (err != nil) != test.wantErr {
+				t.Fatalf(
+					"Charges.UnmarshalJSON(%s) error = %v, wantErr %t",
+					test.data, err, test.wantErr,
+				)
+			}
+			if got != test.want {
+				t.Fatalf(
+					"Charges.UnmarshalJSON(%s) = %d, want %d", test.data, got, test.want,
+				)
+			}
+		})
+	}
+}
+
+func TestConditionJSON(t *testing.T) {
+	condition := Condition{Kind: ConditionPoisoned, Rounds: 2}
+	data, err := json.Marshal(condition)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	if want := `{"kind":"Poisoned","rounds":2}`; string(data) != want {
+		t.Fatalf("json.Marshal() = %s, want %s", data, want)
+	}
+
+	var decoded Condition
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if decoded != condition {
+		t.Fatalf("json.Unmarshal() = %s, want %s", decoded, condition)
+	}
+
+	err = decoded.UnmarshalJSON([]byte(`{"kind": "Cursed", "rounds": 1}`))
+	if got := paths(t, err); !slices.Equal(got, []string{"kind"}) {
+		t.Fatalf("Condition.UnmarshalJSON() error = %v, want kind error", err)
+	}
+}
+
+func TestRechargeJSON(t *testing.T) {
+	tests := []struct {
+		recharge *Recharge
+		name     string
+		want     string
+	}{
+		{
+			name: "EveryNRounds", recharge: everyNRounds(2, 1),
+			want: `{"kind":"EveryNRounds","rounds":2,"threshold":0,` +
+				`"maxCharges":2,"elapsed":1}`,
+		},
+		{
+			name: "OnRoll", recharge: onRoll(5),
+			want: `{"kind":"OnRoll","dice":"d6","rounds":0,"threshold":5,` +
+				`"maxCharges":2,"elapsed":0}`,
+		},
+		{
+			name: "OnRest", recharge: onRest(),
+			want: `{"kind":"OnRest","rounds":0,"threshold":0,` +
+				`"maxCharges":2,"elapsed":0}`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data, err := json.Marshal(test.recharge)
+			if err != nil {
+				t.Fatalf("json.Marshal() error = %v", err)
+			}
+			if string(data) != test.want {
+				t.Fatalf("json.Marshal() = %s, want %s", data, test.want)
+			}
+
+			var decoded Recharge
+			if err := json.Unmarshal(data, &decoded); err != nil {
+				t.Fatalf("json.Unmarshal() error = %v", err)
+			}
+			if decoded != *test.recharge {
+				t.Fatalf("json.Unmarshal() = %s, want %s", decoded, test.recharge)
+			}
+		})
+	}
+}
+
+func TestAttackMarshalJSON(t *testing.T) {
+	attack := Attack{
+		Name: "Longbow", TargetCharacteristic: STR,
+		Dice: dice.D8, DiceCnt: 1, Charges: -1,
+		IsBlast: false, Pool: PoolHighest, Traits: TraitRanged | TraitBulky,
+		PairedWith: 0, Inflicts: Condition{Kind: ConditionNone, Rounds: 0},
+		Recharge: nil, DmgMod: 0, MinDmg: 0, Usage: UsageAlways,
+		Area: AreaZone, MaxTargets: 0,
+	}
+	data, err := json.Marshal(&attack)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+
+	want := `{"name":"Longbow","target":"STR","dice":"d8","area":"Zone",` +
+		`"pool":"Highest","traits":"Bulky|Ranged","usage":"Always",` +
+		`"charges":"unlimited","diceCnt":1,"dmgMod":0,"minDmg":0,` +
+		`"maxTargets":0,"isBlast":false}`
+	if string(data) != want {
+		t.Fatalf("json.Marshal() = %s, want %s", data, want)
+	}
+}
+
+func TestAttackMarshalJSONErrors(t *testing.T) {
+	attack := breath(1, &Recharge{
+		Kind: RechargeKind(42), Rounds: 0, Dice: dice.Dice(7), Threshold: 0,
+		MaxCharges: 1, Elapsed: 0,
+	})
+	attack.TargetCharacteristic = Characteristic(42)
+
+	_, err := attack.MarshalJSON()
+	want := []string{"target", "recharge.kind", "recharge.dice"}
+	if got := paths(t, err); !slices.Equal(got, want) {
+		t.Fatalf("Attack.MarshalJSON() error = %v, want paths %v", err, want)
+	}
+}
+
+func TestAttackJSONRoundTrip(t *testing.T) {
+	tests := []struct {
+		name   string
+		attack Attack
+	}{
+		{name: "OnRollRecharge", attack: breath(1, onRoll(5))},
+		{name: "EveryNRoundsRecharge", attack: breath(0, everyNRounds(3, 2))},
+		{
+			name: "Everything",
+			attack: Attack{
+				Name: "Venomous Bite", TargetCharacteristic: DEX,
+				Dice: dice.D4, DiceCnt: 2, Charges: 3,
+				IsBlast: true, Pool: PoolSum,
+				Traits: TraitArmorPiercing | TraitMagical, PairedWith: 2,
+				Inflicts: Condition{Kind: ConditionPoisoned, Rounds: -1},
+				Recharge: onRest(), DmgMod: -1, MinDmg: 1,
+				Area: AreaDetachments, MaxTargets: 2,
+				Usage: UsageFirstRound | UsageVsDetachments,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data, err := json.Marshal(&test.attack)
+			if err != nil {
+				t.Fatalf("json.Marshal() error = %v", err)
+			}
+
+			var decoded Attack
+			if err := json.Unmarshal(data, &decoded); err != nil {
+				t.Fatalf("json.Unmarshal(%s) error = %v", data, err)
+			}
+			if !decoded.Equals(&test.attack) {
+				t.Fatalf(
+					"json.Unmarshal(%s) = %s, want %s",
+					data, decoded.String(), test.attack.String(),
+				)
+			}
+		})
+	}
+}
+
+func TestAttackUnmarshalJSONDefaults(t *testing.T) {
+	var got Attack
+	err := json.Unmarshal([]byte(`{"name": "Claws", "dice": "d6"}`), &got)
+	if err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+
+	want := Attack{
+		Name: "Claws", TargetCharacteristic: STR,
+		Dice: dice.D6, DiceCnt: 1, Charges: -1,
+		IsBlast: false, Pool: PoolHighest, Traits: TraitNone, PairedWith: 0,
+		Inflicts: Condition{Kind: ConditionNone, Rounds: 0}, Recharge: nil,
+		DmgMod: 0, MinDmg: 0, Usage: UsageAlways, Area: AreaZone, MaxTargets: 0,
+	}
+	if !got.Equals(&want) {
+		t.Fatalf("json.Unmarshal() = %s, want %s", got.String(), want.String())
+	}
+}
+
+func TestAttackUnmarshalJSONErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		data      string
+		wantPaths []string
+	}{
+		{
+			name:      "UnknownDice",
+			data:      `{"name": "Claws", "dice": "d7"}`,
+			wantPaths: []string{"dice"},
+		},
+		{
+			name:      "MissingDice",
+			data:      `{"name": "Claws"}`,
+			wantPaths: []string{"dice"},
+		},
+		{
+			name:      "UnknownField",
+			data:      `{"name": "Claws", "dice": "d6", "damage": 3}`,
+			wantPaths: []string{"damage"},
+		},
+		{
+			name:      "WrongType",
+			data:      `{"name": "Claws", "dice": "d6", "diceCnt": "two"}`,
+			wantPaths: []string{"diceCnt"},
+		},
+		{
+			name: "SeveralFields",
+			data: `{"name": "Claws", "dice": "d6", "target": "CON", ` +
+				`"traits": "Ranged|Sharp", "charges": "many"}`,
+			wantPaths: []string{"target", "traits", "charges"},
+		},
+		{
+			name: "NestedFields",
+			data: `{"name": "Breath", "dice": "d12", ` +
+				`"inflicts": {"kind": "Burning", "rounds": 1}, ` +
+				`"recharge": {"kind": "OnRoll", "dice": "d5", "threshold": 5}}`,
+			wantPaths: []string{"inflicts.kind", "recharge.dice"},
+		},
+		{
+			name: "NestedUnknownField",
+			data: `{"name": "Breath", "dice": "d12", ` +
+				`"recharge": {"kind": "OnRest", "every": 2}}`,
+			wantPaths: []string{"recharge.every"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var got Attack
+			err := got.UnmarshalJSON([]byte(test.data))
+			if err == nil {
+				t.Fatalf("Attack.UnmarshalJSON(): want error, got %s", got.String())
+			}
+			if paths := paths(t, err); !slices.Equal(paths, test.wantPaths) {
+				t.Fatalf(
+					"Attack.UnmarshalJSON() error = %v, want paths %v",
+					err, test.wantPaths,
+				)
+			}
+		})
+	}
+}
+
+// paths returns the paths of the field errors joined in err, joined errors
+// are flattened.
+func paths(t *testing.T, err error) []string {
+	t.Helper()
+
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		var result []string
+		for _, err := range joined.Unwrap() {
+			result = append(result, paths(t, err)...)
+		}
+		return result
+	}
+
+	var fieldErr *codec.FieldError
+	if errors.As(err, &fieldErr) {
+		return []string{fieldErr.Path}
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+package atk
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/rozag/cabasi/dice"
+	"github.com/rozag/cabasi/internal/codec"
+)
+
+// Pool represents how the dice rolled for an attack are resolved into damage.
+type Pool uint8
+
+const (
+	// PoolHighest keeps the single highest die. It's the default Cairn rule.
+	PoolHighest Pool = iota
+	// PoolSum sums all the dice.
+	PoolSum
+	// PoolLowest keeps the single lowest die.
+	PoolLowest
+	// PoolExploding rolls a die again and adds the result every time it shows
+	// its maximum value, then keeps the single highest (exploded) die.
+	PoolExploding
+)
+
+// String returns the string representation of the Pool.
+func (p Pool) String() string {
+	switch p {
+	case PoolHighest:
+		return "Highest"
+	case PoolSum:
+		return "Sum"
+	case PoolLowest:
+		return "Lowest"
+	case PoolExploding:
+		return "Exploding"
+	default:
+		panic(fmt.Errorf("unknown Pool: %d", p))
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler, the Pool is encoded as its
+// string representation, e.g. "Sum".
+func (p Pool) MarshalText() ([]byte, error) {
+	return codec.MarshalText("pool", p, pools()...)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, it decodes a Pool
+// encoded as its string representation, case-insensitive.
+func (p *Pool) UnmarshalText(text []byte) error {
+	value, err := codec.UnmarshalText("pool", text, pools()...)
+	if err != nil {
+		return err
+	}
+	*p = value
+	return nil
+}
+
+// Roll rolls cnt dice and resolves them according to the Pool. The result is
+// capped at math.MaxUint8. Roll returns 0 if cnt is 0.
+func (p Pool) Roll(d dice.Dice, cnt uint8, rng dice.RNG) uint8 {
+	result := uint(0)
+	for i := range cnt {
+		roll := uint(d.Roll(rng))
+		if p == PoolExploding {
+			for last := roll; last == uint(d) && roll < math.MaxUint8; {
+				last = uint(d.Roll(rng))
+				roll += last
+			}
+		}
+
+		switch p {
+		case PoolHighest, PoolExploding:
+			result = max(result, roll)
+		case PoolSum:
+			result += roll
+		case PoolLowest:
+			if i == 0 {
+				result = roll
+			} else {
+				result = min(result, roll)
+			}
+		default:
+			panic(fmt.Errorf("unknown Pool: %d", p))
+		}
+	}
+
+	// Suppressing gosec "G115: integer overflow conversion uint -> uint8"
+	// because the value is capped at math.MaxUint8 right here.
+	return uint8(min(result, math.MaxUint8)) //nolint:gosec
+}
+
+// Max returns the maximum damage cnt dice can deal when resolved according to
+// the Pool. Exploding dice are estimated without explosions because their
+// maximum is unbounded.
+func (p Pool) Max(d dice.Dice, cnt uint8) uint {
+	if cnt == 0 {
+		return 0
+	}
+
+	switch p {
+	case PoolHighest, PoolLowest, PoolExploding:
+		return uint(d)
+	case PoolSum:
+		return uint(d) * uint(cnt)
+	default:
+		panic(fmt.Errorf("unknown Pool: %d", p))
+	}
+}
+
+// pools returns all the known pools.
+func pools() []Pool {
+	return []Pool{PoolHighest, PoolSum, PoolLowest, PoolExploding}
+}
@@ -0,0 +1,129 @@
+package atk
+
+import (
+	"testing"
+
+	"github.com/rozag/cabasi/dice"
+	"github.com/rozag/cabasi/dice/dicetest"
+)
+
+func TestPoolRoll(t *testing.T) {
+	tests := []struct {
+		name  string
+		pool  Pool
+		dice  dice.Dice
+		cnt   uint8
+		faces []uint
+		want  uint8
+	}{
+		{
+			name: "HighestZeroDice", pool: PoolHighest,
+			dice: dice.D6, cnt: 0, faces: nil,
+			want: 0,
+		},
+		{
+			name: "HighestSingleDie", pool: PoolHighest,
+			dice: dice.D6, cnt: 1, faces: []uint{3},
+			want: 3,
+		},
+		{
+			name: "HighestManyDice", pool: PoolHighest,
+			dice: dice.D6, cnt: 3, faces: []uint{3, 5, 1},
+			want: 5,
+		},
+		{
+			name: "SumManyDice", pool: PoolSum,
+			dice: dice.D6, cnt: 3, faces: []uint{3, 5, 1},
+			want: 9,
+		},
+		{
+			name: "LowestManyDice", pool: PoolLowest,
+			dice: dice.D6, cnt: 3, faces: []uint{3, 5, 1},
+			want: 1,
+		},
+		{
+			name: "LowestFirstDieIsLowest", pool: PoolLowest,
+			dice: dice.D6, cnt: 2, faces: []uint{1, 5},
+			want: 1,
+		},
+		{
+			name: "ExplodingNoExplosion", pool: PoolExploding,
+			dice: dice.D6, cnt: 2, faces: []uint{3, 5},
+			want: 5,
+		},
+		{
+			name: "ExplodingSingleExplosion", pool: PoolExploding,
+			dice: dice.D6, cnt: 1, faces: []uint{6, 3},
+			want: 9,
+		},
+		{
+			name: "ExplodingChainedExplosions", pool: PoolExploding,
+			dice: dice.D4, cnt: 1, faces: []uint{4, 4, 1},
+			want: 9,
+		},
+		{
+			name: "ExplodingKeepsHighestDie", pool: PoolExploding,
+			dice: dice.D6, cnt: 2, faces: []uint{6, 1, 5},
+			want: 7,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rng := dicetest.NewSequence(t, test.faces...)
+			got := test.pool.Roll(test.dice, test.cnt, rng)
+			if got != test.want {
+				t.Errorf("Pool.Roll(): want %d, got %d", test.want, got)
+			}
+		})
+	}
+}
+
+func TestPoolRollCapped(t *testing.T) {
+	tests := []struct {
+		name string
+		pool Pool
+		cnt  uint8
+	}{
+		{name: "Sum", pool: PoolSum, cnt: 255},
+		{name: "Exploding", pool: PoolExploding, cnt: 1},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.pool.Roll(dice.D20, test.cnt, dicetest.Max{}); got != 255 {
+				t.Fatalf("Pool.Roll(): want 255, got %d", got)
+			}
+		})
+	}
+}
+
+func TestPoolMax(t *testing.T) {
+	tests := []struct {
+		name string
+		pool Pool
+		dice dice.Dice
+		cnt  uint8
+		want uint
+	}{
+		{name: "ZeroDice", pool: PoolSum, dice: dice.D6, cnt: 0, want: 0},
+		{name: "Highest", pool: PoolHighest, dice: dice.D6, cnt: 3, want: 6},
+		{name: "Sum", pool: PoolSum, dice: dice.D6, cnt: 3, want: 18},
+		{name: "Lowest", pool: PoolLowest, dice: dice.D8, cnt: 3, want: 8},
+		{name: "Exploding", pool: PoolExploding, dice: dice.D4, cnt: 2, want: 4},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.pool.Max(test.dice, test.cnt); got != test.want {
+				t.Fatalf("Pool.Max(): want %d, got %d", test.want, got)
+			}
+		})
+	}
+}
+
+func TestPoolUnknownPanics(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Fatalf("Pool.String(): want panic for unknown Pool, got none")
+		}
+	}()
+	_ = Pool(42).String()
+}
@@ -0,0 +1,204 @@
+package atk
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/rozag/cabasi/dice"
+	"github.com/rozag/cabasi/internal/codec"
+)
+
+// RechargeKind represents how an attack regains its charges.
+type RechargeKind uint8
+
+const (
+	// RechargeEveryNRounds regains a charge every N rounds.
+	RechargeEveryNRounds RechargeKind = iota
+	// RechargeOnRoll regains a charge on a die roll at the end of each round,
+	// e.g. "recharge 5-6" is a d6 with a threshold of 5.
+	RechargeOnRoll
+	// RechargeOnRest regains all the charges on rest only.
+	RechargeOnRest
+)
+
+// String returns the string representation of the RechargeKind.
+func (k RechargeKind) String() string {
+	switch k {
+	case RechargeEveryNRounds:
+		return "EveryNRounds"
+	case RechargeOnRoll:
+		return "OnRoll"
+	case RechargeOnRest:
+		return "OnRest"
+	default:
+		panic(fmt.Errorf("unknown RechargeKind: %d", k))
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler, the RechargeKind is encoded
+// as its string representation, e.g. "OnRoll".
+func (k RechargeKind) MarshalText() ([]byte, error) {
+	return codec.MarshalText("recharge kind", k, rechargeKinds()...)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, it decodes a RechargeKind
+// encoded as its string representation, case-insensitive.
+func (k *RechargeKind) UnmarshalText(text []byte) error {
+	value, err := codec.UnmarshalText(
+		"recharge kind", text, rechargeKinds()...,
+	)
+	if err != nil {
+		return err
+	}
+	*k = value
+	return nil
+}
+
+// Recharge is a rule of regaining an attack's charges. Charges are never
+// regained above MaxCharges, and all of them are regained on rest no matter
+// the kind.
+type Recharge struct {
+	Kind RechargeKind
+	// Rounds is the number of rounds it takes to regain a charge for
+	// RechargeEveryNRounds.
+	Rounds uint8
+	// Dice is rolled at the end of each round for RechargeOnRoll, a charge is
+	// regained if the roll is at least Threshold.
+	Dice      dice.Dice
+	Threshold uint8
+	// MaxCharges is the maximum number of charges the attack can have.
+	MaxCharges int8
+	// Elapsed is the number of rounds passed since the attack started
+	// regaining the next charge for RechargeEveryNRounds.
+	Elapsed uint8
+}
+
+// String returns the string representation of the Recharge.
+func (r Recharge) String() string {
+	return fmt.Sprintf(
+		"Recharge{"+
+			"Kind: %s"+
+			", Rounds: %d"+
+			", Dice: %s"+
+			", Threshold: %d"+
+			", MaxCharges: %d"+
+			", Elapsed: %d"+
+			"}",
+		r.Kind,
+		r.Rounds,
+		r.Dice,
+		r.Threshold,
+		r.MaxCharges,
+		r.Elapsed,
+	)
+}
+
+// validate returns all the reasons the Recharge is invalid for an attack with
+// the provided charges or nil if it is valid. The reasons are reported as
+// *codec.FieldError with the paths from the attack, e.g. "recharge.rounds".
+func (r Recharge) validate(charges int8) []error {
+	var errs []error
+
+	switch r.Kind {
+	case RechargeEveryNRounds:
+		if r.Rounds == 0 {
+			errs = append(errs, codec.WrapField(
+				"recharge.rounds", errors.New("recharge rounds must be at least 1"),
+			))
+		}
+	case RechargeOnRoll:
+		switch r.Dice {
+		case dice.D4, dice.D6, dice.D8, dice.D10, dice.D12, dice.D20:
+			if r.Threshold == 0 || r.Threshold > uint8(r.Dice) {
+				errs = append(errs, codec.WrapField("recharge.threshold", fmt.Errorf(
+					"recharge threshold must be between 1 and %d, got %d",
+					r.Dice, r.Threshold,
+				)))
+			}
+		default:
+			errs = append(errs, codec.WrapField(
+				"recharge.dice", fmt.Errorf("invalid recharge dice: %d", r.Dice),
+			))
+		}
+	case RechargeOnRest:
+		// OK
+	default:
+		errs = append(errs, codec.WrapField(
+			"recharge.kind", fmt.Errorf("invalid recharge kind: %d", r.Kind),
+		))
+	}
+
+	if r.MaxCharges < 1 {
+		errs = append(errs, codec.WrapField("recharge.maxCharges", fmt.Errorf(
+			"recharge max charges must be at least 1, got %d", r.MaxCharges,
+		)))
+	} else if charges < 0 || charges > r.MaxCharges {
+		errs = append(errs, codec.WrapField("charges", fmt.Errorf(
+			"charges of a recharging attack must be between 0 and %d, got %d",
+			r.MaxCharges, charges,
+		)))
+	}
+
+	return errs
+}
+
+// IsRecharging checks if the Attack regains charges during the battle and
+// some of its charges are spent.
+func (a *Attack) IsRecharging() bool {
+	return a.Recharge != nil &&
+		a.Recharge.Kind != RechargeOnRest &&
+		a.Charges >= 0 &&
+		a.Charges < a.Recharge.MaxCharges
+}
+
+// RoundsToRecharge returns the number of rounds left until the Attack
+// regains the next charge. It returns -1 if it's unknown - the attack isn't
+// recharging or it recharges on a die roll.
+func (a *Attack) RoundsToRecharge() int {
+	if !a.IsRecharging() || a.Recharge.Kind != RechargeEveryNRounds {
+		return -1
+	}
+	return max(int(a.Recharge.Rounds)-int(a.Recharge.Elapsed), 0)
+}
+
+// TickRecharge advances the Attack's recharge by a round, regaining a charge
+// if the recharge rule says so. RNG is used for RechargeOnRoll.
+func (a *Attack) TickRecharge(rng dice.RNG) {
+	if !a.IsRecharging() {
+		if a.Recharge != nil {
+			a.Recharge.Elapsed = 0
+		}
+		return
+	}
+
+	switch a.Recharge.Kind {
+	case RechargeEveryNRounds:
+		a.Recharge.Elapsed++
+		if a.Recharge.Elapsed >= a.Recharge.Rounds {
+			a.Recharge.Elapsed = 0
+			a.Charges++
+		}
+	case RechargeOnRoll:
+		if a.Recharge.Dice.Roll(rng) >= a.Recharge.Threshold {
+			a.Charges++
+		}
+	case RechargeOnRest:
+		// Recharged on rest only.
+	default:
+		panic(fmt.Errorf("unknown RechargeKind: %d", a.Recharge.Kind))
+	}
+}
+
+// Rest regains all the charges of the Attack if it recharges.
+func (a *Attack) Rest() {
+	if a.Recharge == nil {
+		return
+	}
+	a.Charges = a.Recharge.MaxCharges
+	a.Recharge.Elapsed = 0
+}
+
+// rechargeKinds returns all the known recharge kinds.
+func rechargeKinds() []RechargeKind {
+	return []RechargeKind{RechargeEveryNRounds, RechargeOnRoll, RechargeOnRest}
+}
@@ -0,0 +1,172 @@
+package atk
+
+import (
+	"testing"
+
+	"github.com/rozag/cabasi/dice"
+	"github.com/rozag/cabasi/dice/dicetest"
+)
+
+func breath(charges int8, recharge *Recharge) Attack {
+	return Attack{
+		Name: "Fire Breath", TargetCharacteristic: STR,
+		Dice: dice.D12, DiceCnt: 1, Charges: charges,
+		IsBlast: true, Pool: PoolHighest, Traits: TraitNone, PairedWith: 0,
+		Inflicts: Condition{Kind: ConditionNone, Rounds: 0}, Recharge: recharge,
+		DmgMod: 0, MinDmg: 0, Usage: UsageAlways, Area: AreaZone, MaxTargets: 0,
+	}
+}
+
+func everyNRounds(rounds, elapsed uint8) *Recharge {
+	return &Recharge{
+		Kind: RechargeEveryNRounds, Rounds: rounds, Dice: 0, Threshold: 0,
+		MaxCharges: 2, Elapsed: elapsed,
+	}
+}
+
+func onRoll(threshold uint8) *Recharge {
+	return &Recharge{
+		Kind: RechargeOnRoll, Rounds: 0, Dice: dice.D6, Threshold: threshold,
+		MaxCharges: 2, Elapsed: 0,
+	}
+}
+
+func onRest() *Recharge {
+	return &Recharge{
+		Kind: RechargeOnRest, Rounds: 0, Dice: 0, Threshold: 0,
+		MaxCharges: 2, Elapsed: 0,
+	}
+}
+
+func TestAttackIsRecharging(t *testing.T) {
+	tests := []struct {
+		name   string
+		attack Attack
+		want   bool
+	}{
+		{name: "NoRecharge", attack: breath(0, nil), want: false},
+		{name: "EveryNRounds", attack: breath(1, everyNRounds(2, 0)), want: true},
+		{name: "OnRoll", attack: breath(0, onRoll(5)), want: true},
+		{name: "FullCharges", attack: breath(2, onRoll(5)), want: false},
+		{name: "OnRest", attack: breath(0, onRest()), want: false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.attack.IsRecharging(); got != test.want {
+				t.Fatalf("Attack.IsRecharging() = %t, want %t", got, test.want)
+			}
+		})
+	}
+}
+
+func TestAttackRoundsToRecharge(t *testing.T) {
+	tests := []struct {
+		name   string
+		attack Attack
+		want   int
+	}{
+		{name: "NoRecharge", attack: breath(0, nil), want: -1},
+		{name: "EveryNRounds", attack: breath(0, everyNRounds(3, 1)), want: 2},
+		{name: "OnRoll", attack: breath(0, onRoll(5)), want: -1},
+		{name: "FullCharges", attack: breath(2, everyNRounds(3, 0)), want: -1},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.attack.RoundsToRecharge(); got != test.want {
+				t.Fatalf("Attack.RoundsToRecharge() = %d, want %d", got, test.want)
+			}
+		})
+	}
+}
+
+func TestAttackTickRecharge(t *testing.T) {
+	tests := []struct {
+		name   string
+		attack Attack
+		faces  []uint
+		want   Attack
+	}{
+		{
+			name:   "NoRecharge",
+			attack: breath(0, nil),
+			faces:  nil,
+			want:   breath(0, nil),
+		},
+		{
+			name:   "EveryNRoundsElapses",
+			attack: breath(0, everyNRounds(2, 0)),
+			faces:  nil,
+			want:   breath(0, everyNRounds(2, 1)),
+		},
+		{
+			name:   "EveryNRoundsRegains",
+			attack: breath(0, everyNRounds(2, 1)),
+			faces:  nil,
+			want:   breath(1, everyNRounds(2, 0)),
+		},
+		{
+			name:   "EveryNRoundsWithFullCharges",
+			attack: breath(2, everyNRounds(2, 1)),
+			faces:  nil,
+			want:   breath(2, everyNRounds(2, 0)),
+		},
+		{
+			name:   "OnRollRegains",
+			attack: breath(1, onRoll(5)),
+			faces:  []uint{5},
+			want:   breath(2, onRoll(5)),
+		},
+		{
+			name:   "OnRollFails",
+			attack: breath(1, onRoll(5)),
+			faces:  []uint{4},
+			want:   breath(1, onRoll(5)),
+		},
+		{
+			name:   "OnRollWithFullCharges",
+			attack: breath(2, onRoll(5)),
+			faces:  nil,
+			want:   breath(2, onRoll(5)),
+		},
+		{
+			name:   "OnRest",
+			attack: breath(0, onRest()),
+			faces:  nil,
+			want:   breath(0, onRest()),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.attack.TickRecharge(dicetest.NewSequence(t, test.faces...))
+			if !test.attack.Equals(&test.want) {
+				t.Fatalf(
+					"Attack.TickRecharge(): want %v, got %v", &test.want, &test.attack,
+				)
+			}
+		})
+	}
+}
+
+func TestAttackRest(t *testing.T) {
+	tests := []struct {
+		name   string
+		attack Attack
+		want   Attack
+	}{
+		{name: "NoRecharge", attack: breath(0, nil), want: breath(0, nil)},
+		{
+			name:   "EveryNRounds",
+			attack: breath(0, everyNRounds(3, 2)),
+			want:   breath(2, everyNRounds(3, 0)),
+		},
+		{name: "OnRest", attack: breath(1, onRest()), want: breath(2, onRest())},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.attack.Rest()
+			if !test.attack.Equals(&test.want) {
+				t.Fatalf("Attack.Rest(): want %v, got %v", &test.want, &test.attack)
+			}
+		})
+	}
+}
@@ -10,12 +10,16 @@ func TestAttackSliceEquals(t *testing.T) {
 	knife := Attack{
 		Name: "Knife", TargetCharacteristic: STR,
 		Dice: dice.D6, DiceCnt: 1, Charges: -1,
-		IsBlast: false,
+		IsBlast: false, Pool: PoolHighest, Traits: TraitNone, PairedWith: 0,
+		Inflicts: Condition{Kind: ConditionNone, Rounds: 0}, Recharge: nil,
+		DmgMod: 0, MinDmg: 0, Usage: UsageAlways, Area: AreaZone, MaxTargets: 0,
 	}
 	spear := Attack{
 		Name: "Spear", TargetCharacteristic: STR,
 		Dice: dice.D6, DiceCnt: 1, Charges: -1,
-		IsBlast: false,
+		IsBlast: false, Pool: PoolHighest, Traits: TraitNone, PairedWith: 0,
+		Inflicts: Condition{Kind: ConditionNone, Rounds: 0}, Recharge: nil,
+		DmgMod: 0, MinDmg: 0, Usage: UsageAlways, Area: AreaZone, MaxTargets: 0,
 	}
 	tests := []struct {
 		name        string
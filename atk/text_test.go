@@ -0,0 +1,206 @@
+package atk
+
+import (
+	"encoding"
+	"testing"
+)
+
+// textCodec is a value encoded and decoded as text.
+type textCodec interface {
+	encoding.TextMarshaler
+	encoding.TextUnmarshaler
+}
+
+func TestTextRoundTrip(t *testing.T) {
+	tests := []struct {
+		decoded func() textCodec
+		name    string
+		values  []textCodec
+	}{
+		{
+			name:    "Characteristic",
+			values:  []textCodec{ptr(STR), ptr(DEX), ptr(WIL)},
+			decoded: func() textCodec { return ptr(Characteristic(0)) },
+		},
+		{
+			name: "Area",
+			values: []textCodec{
+				ptr(AreaZone), ptr(AreaAll), ptr(AreaIndividuals),
+				ptr(AreaDetachments),
+			},
+			decoded: func() textCodec { return ptr(Area(0)) },
+		},
+		{
+			name:    "Zone",
+			values:  []textCodec{ptr(ZoneEngaged), ptr(ZoneNear), ptr(ZoneFar)},
+			decoded: func() textCodec { return ptr(Zone(0)) },
+		},
+		{
+			name: "Pool",
+			values: []textCodec{
+				ptr(PoolHighest), ptr(PoolSum), ptr(PoolLowest), ptr(PoolExploding),
+			},
+			decoded: func() textCodec { return ptr(Pool(0)) },
+		},
+		{
+			name: "ConditionKind",
+			values: []textCodec{
+				ptr(ConditionNone), ptr(ConditionPoisoned), ptr(ConditionParalysed),
+				ptr(ConditionFrightened), ptr(ConditionBlinded),
+				ptr(ConditionProtected), ptr(ConditionEnhanced),
+			},
+			decoded: func() textCodec { return ptr(ConditionKind(0)) },
+		},
+		{
+			name: "RechargeKind",
+			values: []textCodec{
+				ptr(RechargeEveryNRounds), ptr(RechargeOnRoll), ptr(RechargeOnRest),
+			},
+			decoded: func() textCodec { return ptr(RechargeKind(0)) },
+		},
+		{
+			name: "Traits",
+			values: []textCodec{
+				ptr(TraitNone), ptr(TraitMagical), ptr(TraitRanged | TraitBulky),
+				ptr(traitsAll),
+			},
+			decoded: func() textCodec { return ptr(Traits(0)) },
+		},
+		{
+			name: "Usage",
+			values: []textCodec{
+				ptr(UsageAlways), ptr(UsageBloodied),
+				ptr(UsageFirstRound | UsageAllyOut), ptr(usageAll),
+			},
+			decoded: func() textCodec { return ptr(Usage(0)) },
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			for _, value := range test.values {
+				text, err := value.MarshalText()
+				if err != nil {
+					t.Fatalf("MarshalText() error = %v", err)
+				}
+				decoded := test.decoded()
+				if err := decoded.UnmarshalText(text); err != nil {
+					t.Fatalf("UnmarshalText(%q) error = %v", text, err)
+				}
+				if redone, _ := decoded.MarshalText(); string(redone) != string(text) {
+					t.Fatalf("UnmarshalText(%q) decoded %q", text, redone)
+				}
+			}
+		})
+	}
+}
+
+func TestTextMarshalUnknown(t *testing.T) {
+	tests := []struct {
+		value encoding.TextMarshaler
+		name  string
+	}{
+		{name: "Characteristic", value: Characteristic(42)},
+		{name: "Area", value: Area(42)},
+		{name: "Zone", value: Zone(42)},
+		{name: "Pool", value: Pool(42)},
+		{name: "ConditionKind", value: ConditionKind(42)},
+		{name: "RechargeKind", value: RechargeKind(42)},
+		{name: "Traits", value: TraitRanged | Traits(0x80)},
+		{name: "Usage", value: UsageBloodied | Usage(0x80)},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if text, err := test.value.MarshalText(); err == nil {
+				t.Fatalf("MarshalText() = %q, want error", text)
+			}
+		})
+	}
+}
+
+func TestTextUnmarshal(t *testing.T) {
+	tests := []struct {
+		name       string
+		text       string
+		want       string
+		wantErrCnt int
+	}{
+		{name: "Lowercase", text: "sum", want: "Sum", wantErrCnt: 0},
+		{name: "Unknown", text: "Average", want: "", wantErrCnt: 1},
+		{name: "Empty", text: "", want: "", wantErrCnt: 1},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var pool Pool
+			err := pool.UnmarshalText([]byte(test.text))
+			if cnt := errCnt(err); cnt != test.wantErrCnt {
+				t.Fatalf(
+					"Pool.UnmarshalText(%q) error = %v, want %d errors",
+					test.text, err, test.wantErrCnt,
+				)
+			}
+			if err == nil && pool.String() != test.want {
+				t.Fatalf(
+					"Pool.UnmarshalText(%q) = %s, want %s", test.text, pool, test.want,
+				)
+			}
+		})
+	}
+}
+
+func TestTextUnmarshalSet(t *testing.T) {
+	tests := []struct {
+		name       string
+		text       string
+		want       Traits
+		wantErrCnt int
+	}{
+		{name: "None", text: "None", want: TraitNone, wantErrCnt: 0},
+		{name: "Single", text: "Reach", want: TraitReach, wantErrCnt: 0},
+		{
+			name: "Several", text: "magical|ArmorPiercing",
+			want: TraitMagical | TraitArmorPiercing, wantErrCnt: 0,
+		},
+		{
+			name: "Spaces", text: "Bulky | Silvered",
+			want: TraitBulky | TraitSilvered, wantErrCnt: 0,
+		},
+		{name: "Unknown", text: "Ranged|Heavy", want: 0, wantErrCnt: 1},
+		{name: "SeveralUnknown", text: "Heavy|Light", want: 0, wantErrCnt: 2},
+		{name: "NoneInSet", text: "None|Ranged", want: 0, wantErrCnt: 1},
+		{name: "Empty", text: "", want: 0, wantErrCnt: 1},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var traits Traits
+			err := traits.UnmarshalText([]byte(test.text))
+			if cnt := errCnt(err); cnt != test.wantErrCnt {
+				t.Fatalf(
+					"Traits.UnmarshalText(%q) error = %v, want %d errors",
+					test.text, err, test.wantErrCnt,
+				)
+			}
+			if err == nil && traits != test.want {
+				t.Fatalf(
+					"Traits.UnmarshalText(%q) = %s, want %s",
+					test.text, traits, test.want,
+				)
+			}
+		})
+	}
+}
+
+// ptr returns a pointer to the value.
+func ptr[T any](value T) *T {
+	return &value
+}
+
+// errCnt returns the number of errors joined in err.
+func errCnt(err error) int {
+	if err == nil {
+		return 0
+	}
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		return len(joined.Unwrap())
+	}
+	return 1
+}
@@ -0,0 +1,113 @@
+package atk
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/rozag/cabasi/internal/codec"
+)
+
+// Traits is a set of special properties of an attack.
+type Traits uint8
+
+const (
+	// TraitNone is an empty set of traits.
+	TraitNone Traits = 0
+	// TraitArmorPiercing makes the attack ignore the defender's armor.
+	TraitArmorPiercing Traits = 1 << (iota - 1)
+	// TraitBulky marks a two-handed or otherwise bulky weapon.
+	TraitBulky
+	// TraitRanged marks an attack made from a distance.
+	TraitRanged
+	// TraitReach marks a melee attack able to hit from a step away.
+	TraitReach
+	// TraitSilvered marks a silvered weapon.
+	TraitSilvered
+	// TraitMagical marks a magical weapon, a spell, or a supernatural ability.
+	TraitMagical
+
+	// traitsAll is a set of all the known traits.
+	traitsAll = TraitArmorPiercing | TraitBulky | TraitRanged | TraitReach |
+		TraitSilvered | TraitMagical
+)
+
+// Has checks if the Traits contain all the provided traits.
+func (ts Traits) Has(traits Traits) bool {
+	return ts&traits == traits
+}
+
+// String returns the string representation of the Traits, e.g.
+// "ArmorPiercing|Ranged" or "None" for an empty set. Unknown traits are
+// represented by their bits in hex.
+func (ts Traits) String() string {
+	if ts == TraitNone {
+		return "None"
+	}
+
+	names := []struct {
+		trait Traits
+		name  string
+	}{
+		{TraitArmorPiercing, "ArmorPiercing"},
+		{TraitBulky, "Bulky"},
+		{TraitRanged, "Ranged"},
+		{TraitReach, "Reach"},
+		{TraitSilvered, "Silvered"},
+		{TraitMagical, "Magical"},
+	}
+
+	parts := make([]string, 0, len(names)+1)
+	for _, n := range names {
+		if ts.Has(n.trait) {
+			parts = append(parts, n.name)
+		}
+	}
+	if unknown := ts &^ traitsAll; unknown != 0 {
+		parts = append(parts, fmt.Sprintf("0x%02x", uint8(unknown)))
+	}
+	return strings.Join(parts, "|")
+}
+
+// MarshalText implements encoding.TextMarshaler, the Traits are encoded as
+// their string representation, e.g. "ArmorPiercing|Ranged".
+func (ts Traits) MarshalText() ([]byte, error) {
+	return codec.MarshalSet("traits", ts, traitsAll)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, it decodes Traits encoded
+// as their string representation, case-insensitive.
+func (ts *Traits) UnmarshalText(text []byte) error {
+	value, err := codec.UnmarshalSet("traits", text, TraitNone, singleTraits()...)
+	if err != nil {
+		return err
+	}
+	*ts = value
+	return nil
+}
+
+// validate returns all the reasons the Traits are not a valid combination of
+// known traits or nil if they are valid.
+func (ts Traits) validate() []error {
+	var errs []error
+
+	if unknown := ts &^ traitsAll; unknown != 0 {
+		errs = append(errs, fmt.Errorf("unknown traits: 0x%02x", uint8(unknown)))
+	}
+
+	if ts.Has(TraitRanged | TraitReach) {
+		errs = append(
+			errs, errors.New("Ranged and Reach traits are mutually exclusive"),
+		)
+	}
+
+	return errs
+}
+
+// singleTraits returns all the known single traits.
+func singleTraits() []Traits {
+	return []Traits{
+		TraitArmorPiercing, TraitBulky, TraitRanged, TraitReach, TraitSilvered,
+		TraitMagical,
+	}
+}
@@ -0,0 +1,65 @@
+package atk
+
+import "testing"
+
+func TestTraitsHas(t *testing.T) {
+	tests := []struct {
+		name   string
+		traits Traits
+		query  Traits
+		want   bool
+	}{
+		{name: "EmptyHasNone", traits: TraitNone, query: TraitNone, want: true},
+		{
+			name: "EmptyHasNoTrait", traits: TraitNone,
+			query: TraitArmorPiercing, want: false,
+		},
+		{
+			name: "HasSingle", traits: TraitArmorPiercing | TraitBulky,
+			query: TraitBulky, want: true,
+		},
+		{
+			name: "HasAll", traits: TraitArmorPiercing | TraitBulky,
+			query: TraitArmorPiercing | TraitBulky, want: true,
+		},
+		{
+			name: "HasOnlySome", traits: TraitArmorPiercing,
+			query: TraitArmorPiercing | TraitBulky, want: false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.traits.Has(test.query); got != test.want {
+				t.Fatalf("Traits.Has() = %t, want %t", got, test.want)
+			}
+		})
+	}
+}
+
+func TestTraitsString(t *testing.T) {
+	tests := []struct {
+		name   string
+		traits Traits
+		want   string
+	}{
+		{name: "None", traits: TraitNone, want: "None"},
+		{name: "Single", traits: TraitReach, want: "Reach"},
+		{
+			name:   "All",
+			traits: traitsAll,
+			want:   "ArmorPiercing|Bulky|Ranged|Reach|Silvered|Magical",
+		},
+		{
+			name:   "Unknown",
+			traits: TraitMagical | Traits(0x80),
+			want:   "Magical|0x80",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.traits.String(); got != test.want {
+				t.Fatalf("Traits.String() = %q, want %q", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package atk
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rozag/cabasi/internal/codec"
+)
+
+// Usage is a set of conditions under which an attack can be made. All the
+// conditions of the set must hold for the attack to be available.
+type Usage uint8
+
+const (
+	// UsageAlways is an empty set of conditions, the attack is always
+	// available.
+	UsageAlways Usage = 0
+	// UsageFirstRound makes the attack available only in the first round.
+	UsageFirstRound Usage = 1 << (iota - 1)
+	// UsageBloodied makes the attack available only while the attacker is
+	// bloodied (HP 0).
+	UsageBloodied
+	// UsageAllyOut makes the attack available only when an ally of the attacker
+	// is out of the battle.
+	UsageAllyOut
+	// UsageVsDetachments makes the attack available only against detachments,
+	// so it can target detachments only.
+	UsageVsDetachments
+
+	// usageAll is a set of all the known usage conditions.
+	usageAll = UsageFirstRound | UsageBloodied | UsageAllyOut |
+		UsageVsDetachments
+)
+
+// Situation is the state of a battle from the point of view of an attacker,
+// the usage conditions are evaluated against it.
+type Situation struct {
+	// Round is the number of the current round, starting at 1.
+	Round uint
+	// IsBloodied is true if the attacker has HP 0.
+	IsBloodied bool
+	// IsAllyOut is true if any ally of the attacker is out of the battle.
+	IsAllyOut bool
+	// IsDetachmentEngaged is true if any enemy still in the battle is a
+	// detachment.
+	IsDetachmentEngaged bool
+}
+
+// Has checks if the Usage contains all the provided conditions.
+func (u Usage) Has(usage Usage) bool {
+	return u&usage == usage
+}
+
+// Allows checks if all the conditions of the Usage hold in the Situation.
+func (u Usage) Allows(situation Situation) bool {
+	return (!u.Has(UsageFirstRound) || situation.Round == 1) &&
+		(!u.Has(UsageBloodied) || situation.IsBloodied) &&
+		(!u.Has(UsageAllyOut) || situation.IsAllyOut) &&
+		(!u.Has(UsageVsDetachments) || situation.IsDetachmentEngaged)
+}
+
+// String returns the string representation of the Usage, e.g.
+// "FirstRound|Bloodied" or "Always" for an empty set. Unknown conditions are
+// represented by their bits in hex.
+func (u Usage) String() string {
+	if u == UsageAlways {
+		return "Always"
+	}
+
+	names := []struct {
+		usage Usage
+		name  string
+	}{
+		{UsageFirstRound, "FirstRound"},
+		{UsageBloodied, "Bloodied"},
+		{UsageAllyOut, "AllyOut"},
+		{UsageVsDetachments, "VsDetachments"},
+	}
+
+	parts := make([]string, 0, len(names)+1)
+	for _, n := range names {
+		if u.Has(n.usage) {
+			parts = append(parts, n.name)
+		}
+	}
+	if unknown := u &^ usageAll; unknown != 0 {
+		parts = append(parts, fmt.Sprintf("0x%02x", uint8(unknown)))
+	}
+	return strings.Join(parts, "|")
+}
+
+// MarshalText implements encoding.TextMarshaler, the Usage are encoded as
+// their string representation, e.g. "FirstRound|Bloodied".
+func (u Usage) MarshalText() ([]byte, error) {
+	return codec.MarshalSet("usage", u, usageAll)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, it decodes Usage encoded
+// as their string representation, case-insensitive.
+func (u *Usage) UnmarshalText(text []byte) error {
+	value, err := codec.UnmarshalSet(
+		"usage", text, UsageAlways, singleUsages()...,
+	)
+	if err != nil {
+		return err
+	}
+	*u = value
+	return nil
+}
+
+// validate returns all the reasons the Usage is not a valid set of known
+// conditions or nil if it is valid.
+func (u Usage) validate() []error {
+	if unknown := u &^ usageAll; unknown != 0 {
+		return []error{
+			fmt.Errorf("unknown usage conditions: 0x%02x", uint8(unknown)),
+		}
+	}
+	return nil
+}
+
+// singleUsages returns all the known single usage conditions.
+func singleUsages() []Usage {
+	return []Usage{
+		UsageFirstRound, UsageBloodied, UsageAllyOut, UsageVsDetachments,
+	}
+}
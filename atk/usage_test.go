@@ -0,0 +1,114 @@
+package atk
+
+import "testing"
+
+func TestUsageHas(t *testing.T) {
+	tests := []struct {
+		name  string
+		usage Usage
+		query Usage
+		want  bool
+	}{
+		{
+			name: "AlwaysHasAlways", usage: UsageAlways,
+			query: UsageAlways, want: true,
+		},
+		{
+			name: "AlwaysHasNoCondition", usage: UsageAlways,
+			query: UsageFirstRound, want: false,
+		},
+		{
+			name: "HasSingle", usage: UsageFirstRound | UsageBloodied,
+			query: UsageBloodied, want: true,
+		},
+		{
+			name: "HasOnlySome", usage: UsageAllyOut,
+			query: UsageAllyOut | UsageVsDetachments, want: false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.usage.Has(test.query); got != test.want {
+				t.Fatalf("Usage.Has() = %t, want %t", got, test.want)
+			}
+		})
+	}
+}
+
+func TestUsageAllows(t *testing.T) {
+	calm := Situation{
+		Round: 2, IsBloodied: false, IsAllyOut: false, IsDetachmentEngaged: false,
+	}
+	dire := Situation{
+		Round: 1, IsBloodied: true, IsAllyOut: true, IsDetachmentEngaged: true,
+	}
+	tests := []struct {
+		name      string
+		situation Situation
+		usage     Usage
+		want      bool
+	}{
+		{name: "AlwaysInCalm", usage: UsageAlways, situation: calm, want: true},
+		{name: "AlwaysInDire", usage: UsageAlways, situation: dire, want: true},
+		{
+			name: "FirstRoundInCalm", usage: UsageFirstRound,
+			situation: calm, want: false,
+		},
+		{
+			name: "BloodiedInCalm", usage: UsageBloodied,
+			situation: calm, want: false,
+		},
+		{
+			name: "AllyOutInCalm", usage: UsageAllyOut,
+			situation: calm, want: false,
+		},
+		{
+			name: "VsDetachmentsInCalm", usage: UsageVsDetachments,
+			situation: calm, want: false,
+		},
+		{name: "AllInDire", usage: usageAll, situation: dire, want: true},
+		{
+			name:  "AllButFirstRound",
+			usage: usageAll,
+			situation: Situation{
+				Round: 3, IsBloodied: true, IsAllyOut: true, IsDetachmentEngaged: true,
+			},
+			want: false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.usage.Allows(test.situation); got != test.want {
+				t.Fatalf("Usage.Allows() = %t, want %t", got, test.want)
+			}
+		})
+	}
+}
+
+func TestUsageString(t *testing.T) {
+	tests := []struct {
+		name  string
+		usage Usage
+		want  string
+	}{
+		{name: "Always", usage: UsageAlways, want: "Always"},
+		{name: "Single", usage: UsageBloodied, want: "Bloodied"},
+		{
+			name:  "All",
+			usage: usageAll,
+			want:  "FirstRound|Bloodied|AllyOut|VsDetachments",
+		},
+		{
+			name:  "Unknown",
+			usage: UsageAllyOut | Usage(0x80),
+			want:  "AllyOut|0x80",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.usage.String(); got != test.want {
+				t.Fatalf("Usage.String() = %q, want %q", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package atk
+
+import (
+	"gopkg.in/yaml.v3"
+
+	"github.com/rozag/cabasi/internal/codec"
+)
+
+// MarshalYAML implements yaml.Marshaler with the JSON encoding of the Attack,
+// see MarshalJSON.
+func (a *Attack) MarshalYAML() (any, error) {
+	return codec.EncodeYAML(a)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler with the JSON decoding of the
+// Attack, see UnmarshalJSON.
+func (a *Attack) UnmarshalYAML(node *yaml.Node) error {
+	return codec.DecodeYAML(node, a)
+}
@@ -0,0 +1,100 @@
+package atk
+
+import (
+	"slices"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/rozag/cabasi/dice"
+)
+
+func TestAttackYAMLRoundTrip(t *testing.T) {
+	attack := Attack{
+		Name: "Venomous Bite", TargetCharacteristic: DEX,
+		Dice: dice.D4, DiceCnt: 2, Charges: 3,
+		IsBlast: true, Pool: PoolSum,
+		Traits: TraitArmorPiercing | TraitMagical, PairedWith: 2,
+		Inflicts: Condition{Kind: ConditionPoisoned, Rounds: -1},
+		Recharge: &Recharge{
+			Kind: RechargeOnRoll, Rounds: 0, Dice: dice.D6, Threshold: 5,
+			MaxCharges: 3, Elapsed: 0,
+		},
+		DmgMod: -1, MinDmg: 1, Area: AreaDetachments, MaxTargets: 2,
+		Usage: UsageFirstRound | UsageVsDetachments,
+	}
+
+	data, err := yaml.Marshal(&attack)
+	if err != nil {
+		t.Fatalf("yaml.Marshal() error = %v", err)
+	}
+
+	var decoded Attack
+	if err := yaml.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("yaml.Unmarshal(%s) error = %v", data, err)
+	}
+	if !decoded.Equals(&attack) {
+		t.Fatalf(
+			"yaml.Unmarshal(%s) = %s, want %s",
+			data, decoded.String(), attack.String(),
+		)
+	}
+}
+
+func TestAttackUnmarshalYAMLDefaults(t *testing.T) {
+	var got Attack
+	err := yaml.Unmarshal([]byte("name: Claws\ndice: d6\n"), &got)
+	if err != nil {
+		t.Fatalf("yaml.Unmarshal() error = %v", err)
+	}
+
+	want := Attack{
+		Name: "Claws", TargetCharacteristic: STR,
+		Dice: dice.D6, DiceCnt: 1, Charges: -1,
+		IsBlast: false, Pool: PoolHighest, Traits: TraitNone, PairedWith: 0,
+		Inflicts: Condition{Kind: ConditionNone, Rounds: 0}, Recharge: nil,
+		DmgMod: 0, MinDmg: 0, Usage: UsageAlways, Area: AreaZone, MaxTargets: 0,
+	}
+	if !got.Equals(&want) {
+		t.Fatalf("yaml.Unmarshal() = %s, want %s", got.String(), want.String())
+	}
+}
+
+func TestAttackUnmarshalYAMLErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		data      string
+		wantPaths []string
+	}{
+		{
+			name:      "UnknownValues",
+			data:      "name: Claws\ndice: d7\ntarget: CON\n",
+			wantPaths: []string{"target", "dice"},
+		},
+		{
+			name:      "UnknownField",
+			data:      "name: Claws\ndice: d6\ndamage: 3\n",
+			wantPaths: []string{"damage"},
+		},
+		{
+			name:      "NestedFields",
+			data:      "name: Claws\ndice: d6\ninflicts:\n  kind: Sleepy\n",
+			wantPaths: []string{"inflicts.kind"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var got Attack
+			err := yaml.Unmarshal([]byte(test.data), &got)
+			if err == nil {
+				t.Fatalf("yaml.Unmarshal(): want error, got %s", got.String())
+			}
+			if paths := paths(t, err); !slices.Equal(paths, test.wantPaths) {
+				t.Fatalf(
+					"yaml.Unmarshal() error = %v, want paths %v",
+					err, test.wantPaths,
+				)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package atk
+
+import (
+	"fmt"
+
+	"github.com/rozag/cabasi/internal/codec"
+)
+
+// Zone represents how far a creature stands from the melee. Two creatures of
+// opposing sides are engaged with each other when both are in ZoneEngaged.
+type Zone uint8
+
+const (
+	// ZoneEngaged means the creature fights in melee.
+	ZoneEngaged Zone = iota
+	// ZoneNear means the creature is a step away from the melee.
+	ZoneNear
+	// ZoneFar means the creature keeps its distance from the melee.
+	ZoneFar
+)
+
+// String returns the string representation of the Zone.
+func (z Zone) String() string {
+	switch z {
+	case ZoneEngaged:
+		return "Engaged"
+	case ZoneNear:
+		return "Near"
+	case ZoneFar:
+		return "Far"
+	default:
+		panic(fmt.Errorf("unknown Zone: %d", z))
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler, the Zone is encoded as its
+// string representation, e.g. "Near".
+func (z Zone) MarshalText() ([]byte, error) {
+	return codec.MarshalText("zone", z, zones()...)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, it decodes a Zone
+// encoded as its string representation, case-insensitive.
+func (z *Zone) UnmarshalText(text []byte) error {
+	value, err := codec.UnmarshalText("zone", text, zones()...)
+	if err != nil {
+		return err
+	}
+	*z = value
+	return nil
+}
+
+// Validate checks if the Zone is known.
+func (z Zone) Validate() error {
+	switch z {
+	case ZoneEngaged, ZoneNear, ZoneFar:
+		return nil
+	default:
+		return fmt.Errorf("unknown zone: %d", z)
+	}
+}
+
+// Distance returns the number of steps between creatures of opposing sides
+// standing in the Zone and the other zone, 0 means they are engaged.
+func (z Zone) Distance(other Zone) uint8 {
+	return uint8(z) + uint8(other)
+}
+
+// zones returns all the known zones.
+func zones() []Zone {
+	return []Zone{ZoneEngaged, ZoneNear, ZoneFar}
+}
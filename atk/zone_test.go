@@ -0,0 +1,43 @@
+package atk
+
+import "testing"
+
+func TestZoneUnknownPanics(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Fatalf("Zone.String(): want panic for unknown Zone, got none")
+		}
+	}()
+	_ = Zone(42).String()
+}
+
+func TestZoneValidate(t *testing.T) {
+	for _, zone := range []Zone{ZoneEngaged, ZoneNear, ZoneFar} {
+		if err := zone.Validate(); err != nil {
+			t.Errorf("Zone(%s).Validate(): want nil, got %v", zone, err)
+		}
+	}
+	if err := Zone(42).Validate(); err == nil {
+		t.Errorf("Zone(42).Validate(): want error, got nil")
+	}
+}
+
+func TestZoneDistance(t *testing.T) {
+	tests := []struct {
+		this, other Zone
+		want        uint8
+	}{
+		{this: ZoneEngaged, other: ZoneEngaged, want: 0},
+		{this: ZoneEngaged, other: ZoneNear, want: 1},
+		{this: ZoneNear, other: ZoneNear, want: 2},
+		{this: ZoneFar, other: ZoneEngaged, want: 2},
+		{this: ZoneFar, other: ZoneFar, want: 4},
+	}
+	for _, test := range tests {
+		t.Run(test.this.String()+test.other.String(), func(t *testing.T) {
+			if got := test.this.Distance(test.other); got != test.want {
+				t.Fatalf("Zone.Distance() = %d, want %d", got, test.want)
+			}
+		})
+	}
+}
@@ -5,45 +5,64 @@ import (
 	"fmt"
 	"math"
 	"slices"
+	"strings"
 
+	"github.com/rozag/cabasi/act"
 	"github.com/rozag/cabasi/atk"
 	"github.com/rozag/cabasi/creat"
 	"github.com/rozag/cabasi/dice"
 )
 
-// PickAttack is a function that picks which attack the attacker will use.
-// It receives an attacker and a slice of defenders.
-// It returns index of the attack the attacker will use.
-// It returns -1 if the attacker does not attack.
-type PickAttack func(attacker creat.Creature, defenders []creat.Creature) int
-
-// PickTargets is a function that picks targets for the selected attack.
-// It receives an attacker, a picked attack index, and a slice of defenders.
-// It returns a slice of picked defender indexes.
-// It returns nil if there are no defenders to attack.
+// PickAction is a function that picks what the actor will do on its turn -
+// attack or cast a spell against enemies, use a support on allies, or do
+// nothing.
+// It receives an actor, a slice of its allies (the actor included), a slice of
+// its enemies, and the current round starting at 1. It's never called for
+// paralysed actors. Attacks' charges and recharge rules are visible to it,
+// e.g. atk.Attack.RoundsToRecharge helps to plan around recharge timing.
+// Attacks' usage conditions are checked against creat.Creature.Situation, an
+// attack picked when its usage conditions don't hold is rejected, and the
+// actor does nothing.
+// It returns the action the actor will take.
+// It returns act.None() if the actor does nothing.
+type PickAction func(
+	actor creat.Creature,
+	allies, enemies []creat.Creature,
+	round uint,
+) act.Action
+
+// PickTargets is a function that picks targets for the picked action.
+// It receives an actor, a picked action, a slice of its allies (the actor
+// included), and a slice of its enemies. The zones of the creatures tell
+// which enemies the action reaches, see atk.Attack.Reaches. Targets out of
+// reach are ignored, and a blast attack hits only the targets of its area,
+// see creat.Creature.BlastTargets.
+// It returns a slice of picked ally indexes for supports and a slice of
+// picked enemy indexes for hostile actions.
+// It returns nil if there are no targets.
 type PickTargets func(
-	attacker creat.Creature,
-	pickedAttackIdx uint,
-	defenders []creat.Creature,
+	actor creat.Creature,
+	action act.Action,
+	allies, enemies []creat.Creature,
 ) []uint
 
 // Battle represents a battle between 2 parties.
 type Battle struct {
 	rng         dice.RNG
-	pickAttack  PickAttack
+	pickAction  PickAction
 	pickTargets PickTargets
 }
 
 // New creates a new Battle with the provided RNG and strategies.
 // The RNG is used for all the rolls.
-// The PickAttack is a function that picks which attack the attacker will use.
-// The PickTargets is a function that picks targets for an attack.
+// The PickAction is a function that picks what the actor will do.
+// The PickTargets is a function that picks targets for an action.
 // New returns an error if input is invalid in any way. The error has an
 // `Unwrap() []error` method to get all the errors or `nil` if the inputs are
 // valid.
 func New(
 	rng dice.RNG,
-	pickAttack PickAttack,
+	pickAction PickAction,
 	pickTargets PickTargets,
 ) (*Battle, error) {
 	var errs []error
@@ -52,8 +71,8 @@ func New(
 		errs = append(errs, errors.New("RNG must be provided"))
 	}
 
-	if pickAttack == nil {
-		errs = append(errs, errors.New("PickAttack must be provided"))
+	if pickAction == nil {
+		errs = append(errs, errors.New("PickAction must be provided"))
 	}
 
 	if pickTargets == nil {
@@ -64,10 +83,20 @@ func New(
 		return nil, errors.Join(errs...)
 	}
 
-	battle := Battle{rng, pickAttack, pickTargets}
+	battle := Battle{rng, pickAction, pickTargets}
 	return &battle, nil
 }
 
+// Result is the outcome of a battle.
+type Result struct {
+	// Players are the players' states at the end of the battle, including
+	// their remaining characteristics, charges, and conditions.
+	Players []creat.Creature
+	// Monsters are the monsters' states at the end of the battle.
+	Monsters   []creat.Creature
+	PlayersWon bool
+}
+
 // Run simulates a battle between 2 groups of Creatures. It returns true if the
 // players won, false otherwise.
 //
@@ -77,6 +106,18 @@ func New(
 //
 // Run doesn't modify the input creatures.
 func (b *Battle) Run(players, monsters []creat.Creature) (bool, error) {
+	result, err := b.RunDetailed(players, monsters)
+	return result.PlayersWon, err
+}
+
+// RunDetailed simulates a battle between 2 groups of Creatures just like Run,
+// but returns the detailed Result of the battle.
+//
+// RunDetailed doesn't modify the input creatures. The creatures of the Result
+// have their MaxHP set, see creat.Creature.EffectiveMaxHP.
+func (b *Battle) RunDetailed(
+	players, monsters []creat.Creature,
+) (Result, error) {
 	var errs []error
 
 	if len(players) == 0 {
@@ -120,120 +161,342 @@ func (b *Battle) Run(players, monsters []creat.Creature) (bool, error) {
 	}
 
 	if len(errs) > 0 {
-		return false, errors.Join(errs...)
+		return Result{Players: nil, Monsters: nil, PlayersWon: false},
+			errors.Join(errs...)
 	}
 
 	playersCopy := make([]creat.Creature, len(players))
 	for i, player := range players {
 		copied := player.DeepCopy()
+		copied.MaxHP = copied.EffectiveMaxHP()
 		playersCopy[i] = copied
 	}
 
 	monstersCopy := make([]creat.Creature, len(monsters))
 	for i, monster := range monsters {
 		copied := monster.DeepCopy()
+		copied.MaxHP = copied.EffectiveMaxHP()
 		monstersCopy[i] = copied
 	}
 
 	havePlayersWon := b.run(playersCopy, monstersCopy)
-	return havePlayersWon, nil
+	return Result{
+		Players:    playersCopy,
+		Monsters:   monstersCopy,
+		PlayersWon: havePlayersWon,
+	}, nil
 }
 
 // run simulates a battle between 2 groups of Creatures. It returns true if the
 // players win, false otherwise.
+//
+// A side that cannot attack or deal any damage loses unless some of its
+// creatures are paralysed for a limited number of rounds or have recharging
+// attacks - the side just skips its turn then. Supports don't count as
+// attacks, but a side that spent a charge of a support (e.g. drank a healing
+// potion) skips its turn as well. Supports with unlimited charges never save
+// a side from losing, so the battle always ends.
+//
+// The harmful conditions and recharges of a side tick at the end of the
+// side's turn, so a condition inflicted for 1 round affects exactly 1 turn of
+// the side. Beneficial conditions tick at the start of the side's turn, so a
+// protection granted for 1 round lasts through the enemies' turn.
+//
+// Moves, charges, and supports are resolved before attacks. Healing never
+// restores HP above the creature's MaxHP. A side that moved a creature closer
+// to the melee or charged an enemy into it skips its turn instead of losing,
+// moves away from the melee never save a side, so the battle always ends.
+//
+// A round is the players' turn followed by the monsters' turn, the first
+// round is 1.
 func (b *Battle) run(players, monsters []creat.Creature) bool {
-	playerAtkIdxs := make([]int, len(players))
+	playerActions := make([]act.Action, len(players))
 	playerTargets := make([][]uint, len(players))
 	playerAttackers := make([][]attacker, len(players))
-	playerUsedAttackIdxs := make([]int, len(players))
+	playerUsedActions := make([]act.Action, len(players))
 	damageToPlayers := make([]damage, len(players))
 
-	monsterAtkIdxs := make([]int, len(monsters))
+	monsterActions := make([]act.Action, len(monsters))
 	monsterTargets := make([][]uint, len(monsters))
 	monsterAttackers := make([][]attacker, len(monsters))
-	monsterUsedAttackIdxs := make([]int, len(monsters))
+	monsterUsedActions := make([]act.Action, len(monsters))
 	damageToMonsters := make([]damage, len(monsters))
 
-	for {
-		b.pickAttacksAndTargets(players, monsters, playerAtkIdxs, playerTargets)
+	for round := uint(1); ; round++ {
+		tickBenefits(players)
+		b.pickActionsAndTargets(
+			round, players, monsters, playerActions, playerTargets,
+		)
+
+		havePlayersMoved := resolveMoves(players, monsters, playerActions)
+		havePlayersSpentCharges := resolveSupports(
+			players, playerActions, playerTargets, b.rng,
+		)
+
+		arePlayersRecovering := havePlayersMoved ||
+			havePlayersSpentCharges ||
+			anyTemporarilyParalysed(players) ||
+			anyRecharging(players)
 
-		assignAttackers(monsterAttackers, playerTargets, playerAtkIdxs)
-		if noAttackersAssigned(monsterAttackers) {
+		assignAttackers(monsterAttackers, playerTargets, playerActions)
+		if noAttackersAssigned(monsterAttackers) && !arePlayersRecovering {
 			// players cannot attack anyone, hence they lose
 			return false
 		}
 
 		resolveAttacks(
 			damageToMonsters, players, monsters, monsterAttackers,
-			playerUsedAttackIdxs, b.rng,
+			playerUsedActions, b.rng,
 		)
 		if noDamageDone(damageToMonsters) {
-			// players cannot deal any damage, hence they lose
-			return false
+			if !arePlayersRecovering {
+				// players cannot deal any damage, hence they lose
+				return false
+			}
+		} else {
+			applyDamageToMonsters(monsters, damageToMonsters, b.rng)
+			inflictConditions(monsters, damageToMonsters, b.rng)
+			if allOut(monsters) {
+				// monsters are all out, hence players win
+				return true
+			}
 		}
 
-		applyDamageToMonsters(monsters, damageToMonsters, b.rng)
-		if allOut(monsters) {
-			// monsters are all out, hence players win
-			return true
+		tickConditions(players)
+		rechargeAttacks(players, b.rng)
+		if allOut(players) {
+			// players are all out, hence monsters win
+			return false
 		}
 
-		b.pickAttacksAndTargets(monsters, players, monsterAtkIdxs, monsterTargets)
+		tickBenefits(monsters)
+		b.pickActionsAndTargets(
+			round, monsters, players, monsterActions, monsterTargets,
+		)
 
-		assignAttackers(playerAttackers, monsterTargets, monsterAtkIdxs)
-		if noAttackersAssigned(monsterAttackers) {
+		haveMonstersMoved := resolveMoves(monsters, players, monsterActions)
+		haveMonstersSpentCharges := resolveSupports(
+			monsters, monsterActions, monsterTargets, b.rng,
+		)
+
+		areMonstersRecovering := haveMonstersMoved ||
+			haveMonstersSpentCharges ||
+			anyTemporarilyParalysed(monsters) ||
+			anyRecharging(monsters)
+
+		assignAttackers(playerAttackers, monsterTargets, monsterActions)
+		if noAttackersAssigned(playerAttackers) && !areMonstersRecovering {
 			// monsters cannot attack anyone, hence players win
 			return true
 		}
 
 		resolveAttacks(
 			damageToPlayers, monsters, players, playerAttackers,
-			monsterUsedAttackIdxs, b.rng,
+			monsterUsedActions, b.rng,
 		)
 		if noDamageDone(damageToPlayers) {
-			// monsters cannot deal any damage, hence players win
-			return true
+			if !areMonstersRecovering {
+				// monsters cannot deal any damage, hence players win
+				return true
+			}
+		} else {
+			applyDamageToPlayers(players, damageToPlayers, b.rng)
+			inflictConditions(players, damageToPlayers, b.rng)
+			if allOut(players) {
+				// players are all out, hence monsters win
+				return false
+			}
 		}
 
-		applyDamageToPlayers(players, damageToPlayers, b.rng)
-		if allOut(players) {
-			// players are all out, hence monsters win
-			return false
+		tickConditions(monsters)
+		rechargeAttacks(monsters, b.rng)
+		if allOut(monsters) {
+			// monsters are all out, hence players win
+			return true
 		}
 	}
 }
 
-func (b *Battle) pickAttacksAndTargets(
-	attackers, defenders []creat.Creature,
-	attackIndexes []int,
+// pickActionsAndTargets picks the actions and targets of the actors for the
+// round. Hostile actions whose attacks aren't available in the actor's
+// situation are rejected and replaced with act.None(). Targets outside the
+// area of a blast attack or beyond its max targets are dropped.
+func (b *Battle) pickActionsAndTargets(
+	round uint,
+	actors, enemies []creat.Creature,
+	actions []act.Action,
 	targets [][]uint,
 ) {
-	for i, attacker := range attackers {
-		attackIdx := b.pickAttack(attacker, defenders)
-		attackIndexes[i] = attackIdx
-		if attackIdx < 0 {
+	for i, actor := range actors {
+		if actor.HasCondition(atk.ConditionParalysed) {
+			actions[i] = act.None()
 			targets[i] = nil
-		} else {
-			targets[i] = b.pickTargets(attacker, uint(attackIdx), defenders)
+			continue
+		}
+
+		action := b.pickAction(actor, actors, enemies, round)
+		if action.Kind.IsHostile() &&
+			!actor.CanAttack(action, actor.Situation(round, actors, enemies)) {
+			action = act.None()
+		}
+
+		actions[i] = action
+		switch action.Kind {
+		case act.KindNone, act.KindMove, act.KindCharge:
+			targets[i] = nil
+		case act.KindSupport:
+			targets[i] = b.pickTargets(actor, action, actors, enemies)
+		case act.KindAttack, act.KindSpell:
+			targets[i] = blastTargets(
+				&actor, action, b.pickTargets(actor, action, actors, enemies), enemies,
+			)
+		default:
+			panic(fmt.Errorf("unknown act.Kind: %d", action.Kind))
 		}
 	}
 }
 
-type attacker struct{ attackerIdx, attackIdx uint }
+// blastTargets drops the targets of a blast attack outside its area or beyond
+// its max targets, see creat.Creature.BlastTargets. Targets of other attacks
+// are returned as is.
+func blastTargets(
+	actor *creat.Creature,
+	action act.Action,
+	targets []uint,
+	enemies []creat.Creature,
+) []uint {
+	attack, ok := actor.Attack(action)
+	if !ok || !attack.IsBlast {
+		return targets
+	}
 
-// assignAttackers assigns attackers to targets.
-// It receives attackers, targets, and attack indexes. It modifies attackers in
+	return actor.BlastTargets(&attack, targets, enemies)
+}
+
+// resolveMoves moves the actors to the zones of their move actions and pulls
+// the enemies charged by the actors into the melee. It returns true if any
+// actor moved closer to the melee or pulled an enemy into it.
+// It receives actors, enemies, and actions. It modifies actors and enemies in
 // place.
+// actors is a slice of all the creatures of a side.
+// enemies is a slice of all the creatures of the opposing side.
+// actions is a slice of size of actors, each element is the action the actor
+// takes, only moves and charges are resolved.
+func resolveMoves(
+	actors, enemies []creat.Creature,
+	actions []act.Action,
+) bool {
+	if len(actors) != len(actions) {
+		return false
+	}
+
+	isCloser := false
+	for actorIdx, action := range actions {
+		if actors[actorIdx].IsOut() {
+			continue
+		}
+
+		switch action.Kind {
+		case act.KindMove:
+			if action.Zone.Validate() != nil {
+				continue
+			}
+
+			if action.Zone < actors[actorIdx].Zone {
+				isCloser = true
+			}
+			actors[actorIdx].Zone = action.Zone
+		case act.KindCharge:
+			if action.Idx >= uint(len(enemies)) ||
+				enemies[action.Idx].IsOut() ||
+				enemies[action.Idx].Zone == atk.ZoneEngaged {
+				continue
+			}
+
+			isCloser = true
+			enemies[action.Idx].Zone = atk.ZoneEngaged
+		case act.KindNone, act.KindAttack, act.KindSpell, act.KindSupport:
+			continue
+		default:
+			panic(fmt.Errorf("unknown act.Kind: %d", action.Kind))
+		}
+	}
+
+	return isCloser
+}
+
+// resolveSupports applies the supports used by the actors to their allies and
+// decreases supports' charges if they're not unlimited (-1). A support
+// affects only the first targeted ally, the rest are ignored. It returns true
+// if any limited charge was spent.
+// It receives actors, actions, targets, and RNG. It modifies actors in place.
+// actors is a slice of all the creatures of a side, they are allies to each
+// other.
+// actions is a slice of size of actors, each element is the action the actor
+// takes, only supports are resolved.
+// targets is a slice of size of actors, each element is a slice of ally
+// indexes targeted by the actor.
+// RNG is used for all the rolls.
+func resolveSupports(
+	actors []creat.Creature,
+	actions []act.Action,
+	targets [][]uint,
+	rng dice.RNG,
+) bool {
+	if len(actors) == 0 ||
+		len(actors) != len(actions) ||
+		len(actors) != len(targets) ||
+		rng == nil {
+		return false
+	}
+
+	isChargeSpent := false
+	for actorIdx, action := range actions {
+		if action.Kind != act.KindSupport ||
+			actors[actorIdx].IsOut() ||
+			!actors[actorIdx].CanUse(action.Idx) ||
+			len(targets[actorIdx]) == 0 {
+			continue
+		}
+
+		allyIdx := targets[actorIdx][0]
+		if allyIdx >= uint(len(actors)) || actors[allyIdx].IsOut() {
+			continue
+		}
+
+		support := &actors[actorIdx].Supports[action.Idx]
+		dice.Announcef(
+			rng, "%s's %s on %s",
+			actors[actorIdx].Name, support.Name, actors[allyIdx].Name,
+		)
+		actors[allyIdx].Receive(support, rng)
+		dice.Unannounce(rng)
+		if support.Charges > 0 {
+			support.Charges--
+			isChargeSpent = true
+		}
+	}
+
+	return isChargeSpent
+}
+
+type attacker struct {
+	attackerIdx uint
+	action      act.Action
+}
+
+// assignAttackers assigns attackers to targets.
+// It receives attackers, targets, and actions. It modifies attackers in place.
 // attackers is a slice of size of defenders, each element is a slice of
-// attackers that target the defender with a particular attack.
+// attackers that target the defender with a particular action.
 // targets is a slice of size of attackers, each element is a slice of defender
 // indexes that are targeted by the attacker.
-// attackIdxs is a slice of size of attackers, each element is an index of the
-// attack the attacker will use.
+// actions is a slice of size of attackers, each element is the action the
+// attacker takes, only hostile actions are assigned.
 func assignAttackers(
 	attackers [][]attacker,
 	targets [][]uint,
-	attackIdxs []int,
+	actions []act.Action,
 ) {
 	if len(attackers) == 0 {
 		return
@@ -244,8 +507,8 @@ func assignAttackers(
 	}
 
 	if len(targets) == 0 ||
-		len(attackIdxs) == 0 ||
-		len(targets) != len(attackIdxs) {
+		len(actions) == 0 ||
+		len(targets) != len(actions) {
 		return
 	}
 
@@ -254,8 +517,8 @@ func assignAttackers(
 			continue
 		}
 
-		attackIdx := attackIdxs[attackerIdx]
-		if attackIdx < 0 {
+		action := actions[attackerIdx]
+		if !action.Kind.IsHostile() {
 			continue
 		}
 
@@ -270,7 +533,7 @@ func assignAttackers(
 					// Suppressing gosec "G115 integer overflow conversion int -> uint"
 					// because int index will never overflow a uint variable.
 					attackerIdx: uint(attackerIdx), //nolint:gosec
-					attackIdx:   uint(attackIdx),
+					action:      action,
 				},
 			)
 		}
@@ -294,26 +557,37 @@ func noAttackersAssigned(attackers [][]attacker) bool {
 type damage struct {
 	characteristic atk.Characteristic
 	value          uint8
+	inflicts       atk.Condition
 }
 
 // resolveAttacks computes the damage dealt to the defenders by the attackers
 // (armor is taken into account) and decreases attacks' charges if they're not
-// unlimited (-1).
+// unlimited (-1). Each attack's dice are resolved according to its Pool, and if
+// several attackers target the same defender, their damage is combined
+// according to the attacks' Pool, see combineRolls.
+// Armor piercing attacks ignore the defender's armor, protected defenders get
+// 1 extra Armor. Attacks usable only against detachments don't affect
+// individuals, and attacks don't affect defenders out of their reach. Paired
+// attacks roll the dice of both weapons and combine them just like the rolls of
+// several attackers, consuming both charges. The condition inflicted by the
+// attack picked by combineRolls is kept along with the damage. Spells are
+// cast before any damage is rolled, and a spell that can't be cast or ends in
+// a mishap deals no damage.
 // It receives damageToDefenders, attackers, defenders, assignedAttackers, and
 // RNG. It modifies damageToDefenders in place.
 // damageToDefenders is a slice of damage dealt to each defender.
 // attackers is a slice of all attackers.
 // defenders is a slice of all defenders.
 // assignedAttackers is a slice of size of defenders, each element is a slice of
-// attackers that target the defender with a particular attack.
-// usedAttackIdxs is a slice of size of attackers, it doesn't matter what's
+// attackers that target the defender with a particular action.
+// usedActions is a slice of size of attackers, it doesn't matter what's
 // inside because it's cleared before being used and acts as a reusable buffer.
 // RNG is used for all the rolls.
 func resolveAttacks(
 	damageToDefenders []damage,
 	attackers, defenders []creat.Creature,
 	assignedAttackers [][]attacker,
-	usedAttackIdxs []int,
+	usedActions []act.Action,
 	rng dice.RNG,
 ) {
 	if len(damageToDefenders) == 0 {
@@ -323,27 +597,62 @@ func resolveAttacks(
 	for i := range damageToDefenders {
 		damageToDefenders[i].characteristic = atk.STR
 		damageToDefenders[i].value = 0
+		damageToDefenders[i].inflicts = atk.Condition{
+			Kind: atk.ConditionNone, Rounds: 0,
+		}
 	}
 
 	if len(attackers) == 0 ||
 		len(defenders) == 0 ||
 		len(assignedAttackers) == 0 ||
-		len(usedAttackIdxs) == 0 ||
+		len(usedActions) == 0 ||
 		len(defenders) != len(damageToDefenders) ||
 		len(defenders) != len(assignedAttackers) ||
-		len(attackers) != len(usedAttackIdxs) ||
+		len(attackers) != len(usedActions) ||
 		rng == nil {
 		return
 	}
 
+	for attackerIdx := range usedActions {
+		usedActions[attackerIdx] = act.None()
+	}
+
+	for _, allAssigned := range assignedAttackers {
+		for _, assigned := range allAssigned {
+			attackerIdx := assigned.attackerIdx
+			if attackerIdx >= uint(len(attackers)) {
+				continue
+			}
+
+			if _, ok := attackers[attackerIdx].Attack(assigned.action); !ok {
+				continue
+			}
+
+			usedActions[attackerIdx] = assigned.action
+		}
+	}
+
+	for attackerIdx, usedAction := range usedActions {
+		if usedAction.Kind != act.KindSpell || attackers[attackerIdx].IsOut() {
+			continue
+		}
+
+		outcome := attackers[attackerIdx].Cast(
+			usedAction.Idx, usedAction.IsEnhanced, rng,
+		)
+		if outcome != creat.CastSuccess {
+			usedActions[attackerIdx] = act.None()
+		}
+	}
+
+	var rolls []rolledAttack
 	for defenderIdx := range damageToDefenders {
 		if defenders[defenderIdx].IsOut() ||
 			len(assignedAttackers[defenderIdx]) == 0 {
 			continue
 		}
 
-		maxDamageCharacteristic := atk.STR
-		maxDamageValue := uint8(0)
+		rolls = rolls[:0]
 		for _, assigned := range assignedAttackers[defenderIdx] {
 			attackerIdx := assigned.attackerIdx
 			if attackerIdx >= uint(len(attackers)) {
@@ -355,86 +664,178 @@ func resolveAttacks(
 				continue
 			}
 
-			attackIdx := assigned.attackIdx
-			if attackIdx >= uint(len(attacker.Attacks)) {
+			if usedActions[attackerIdx].Kind == act.KindNone {
 				continue
 			}
 
-			attack := attacker.Attacks[attackIdx]
-			if attack.Charges == 0 {
+			attack, ok := attacker.Attack(assigned.action)
+			if !ok || attack.Charges == 0 {
 				continue
 			}
 
-			attackDice := attack.Dice
-			isAttackerDetachment := attacker.IsDetachment
-			isDefenderDetachment := defenders[defenderIdx].IsDetachment
-			if isAttackerDetachment != isDefenderDetachment {
-				if isAttackerDetachment && !isDefenderDetachment {
-					attackDice = dice.D12
-				} else if !attack.IsBlast {
-					attackDice = dice.D4
-				}
+			if attack.Usage.Has(atk.UsageVsDetachments) &&
+				!defenders[defenderIdx].IsDetachment {
+				continue
 			}
 
-			maxDmg := uint8(0)
-			for range attack.DiceCnt {
-				dmg := attackDice.Roll(rng)
-				if dmg > maxDmg {
-					maxDmg = dmg
+			roll := func(attack atk.Attack) (rolledAttack, bool) {
+				if !attack.Reaches(attacker.Zone, defenders[defenderIdx].Zone) {
+					return rolledAttack{attack: attack, dmg: 0}, false
 				}
+
+				return rolledAttack{
+					attack: attack,
+					dmg:    rollAttack(&attacker, &defenders[defenderIdx], attack, rng),
+				}, true
 			}
 
-			if maxDmg > maxDamageValue {
-				maxDamageCharacteristic = attack.TargetCharacteristic
-				maxDamageValue = maxDmg
+			rolled, isRolled := roll(attack)
+			if assigned.action.Kind == act.KindAttack {
+				pairedIdx, ok := attacker.PairedAttackIdx(assigned.action.Idx)
+				if ok {
+					// The paired weapons keep the higher roll before it's combined
+					// with the other attackers, no matter the Pool.
+					paired, isPairedRolled := roll(attacker.Attacks[pairedIdx])
+					if isPairedRolled && (!isRolled || paired.dmg > rolled.dmg) {
+						rolled, isRolled = paired, true
+					}
+				}
+			}
+			if isRolled {
+				rolls = append(rolls, rolled)
 			}
 		}
+		if len(rolls) == 0 {
+			continue
+		}
 
-		if maxDamageValue > 0 {
-			if maxDamageCharacteristic == atk.STR &&
-				defenders[defenderIdx].Armor > 0 {
-				if maxDamageValue >= defenders[defenderIdx].Armor {
-					maxDamageValue -= defenders[defenderIdx].Armor
+		combined := combineRolls(rolls)
+		if dmg := combined.dmg; dmg > 0 {
+			armor := defenders[defenderIdx].EffectiveArmor()
+			if combined.attack.TargetCharacteristic == atk.STR &&
+				!combined.attack.Traits.Has(atk.TraitArmorPiercing) &&
+				armor > 0 {
+				if dmg >= armor {
+					dmg -= armor
 				}
 			}
-			damageToDefenders[defenderIdx].characteristic = maxDamageCharacteristic
-			damageToDefenders[defenderIdx].value = maxDamageValue
+			damageToDefenders[defenderIdx].characteristic =
+				combined.attack.TargetCharacteristic
+			damageToDefenders[defenderIdx].value = dmg
+			damageToDefenders[defenderIdx].inflicts = combined.attack.Inflicts
 		}
 	}
 
-	for attackerIdx := range usedAttackIdxs {
-		usedAttackIdxs[attackerIdx] = -1
-	}
+	for attackerIdx, usedAction := range usedActions {
+		if usedAction.Kind != act.KindAttack {
+			continue
+		}
 
-	for _, allAssigned := range assignedAttackers {
-		for _, assigned := range allAssigned {
-			attackerIdx := assigned.attackerIdx
-			if attackerIdx >= uint(len(attackers)) {
-				continue
-			}
+		attacks := attackers[attackerIdx].Attacks
+		attackIdx := usedAction.Idx
+		pairedIdx, isPaired := attackers[attackerIdx].PairedAttackIdx(attackIdx)
 
-			attackIdx := assigned.attackIdx
-			if attackIdx >= uint(len(attackers[attackerIdx].Attacks)) {
-				continue
-			}
+		if attacks[attackIdx].Charges > 0 {
+			attacks[attackIdx].Charges--
+		}
 
-			if attackIdx > uint(math.MaxInt) {
-				continue
-			}
+		if isPaired && attacks[pairedIdx].Charges > 0 {
+			attacks[pairedIdx].Charges--
+		}
+	}
+}
 
-			usedAttackIdxs[attackerIdx] = int(attackIdx)
+type rolledAttack struct {
+	attack atk.Attack
+	dmg    uint8
+}
+
+// combineRolls combines the damage rolled by all the attacks against one
+// defender. It returns the attack whose characteristic, armor piercing, and
+// inflicted condition count along with the combined damage. If all the attacks
+// share a Pool, the rolls are combined the same way their dice are: PoolSum
+// adds up the damage to the characteristic of the highest roll, PoolLowest
+// keeps the lowest roll. Otherwise the highest roll is kept. rolls must not be
+// empty.
+func combineRolls(rolls []rolledAttack) rolledAttack {
+	pool := rolls[0].attack.Pool
+	for _, roll := range rolls[1:] {
+		if roll.attack.Pool != pool {
+			pool = atk.PoolHighest
+			break
 		}
 	}
 
-	for attackerIdx, usedAttackIdx := range usedAttackIdxs {
-		if usedAttackIdx < 0 {
-			continue
+	highest, lowest := rolls[0], rolls[0]
+	for _, roll := range rolls[1:] {
+		if roll.dmg > highest.dmg {
+			highest = roll
+		}
+		if roll.dmg < lowest.dmg {
+			lowest = roll
+		}
+	}
+
+	switch pool {
+	case atk.PoolHighest, atk.PoolExploding:
+		return highest
+	case atk.PoolSum:
+		sum := uint(0)
+		for _, roll := range rolls {
+			if roll.attack.TargetCharacteristic ==
+				highest.attack.TargetCharacteristic {
+				sum += uint(roll.dmg)
+			}
 		}
+		// Suppressing gosec "G115: integer overflow conversion uint -> uint8"
+		// because the sum is capped at math.MaxUint8 right here.
+		highest.dmg = uint8(min(sum, math.MaxUint8)) //nolint:gosec
+		return highest
+	case atk.PoolLowest:
+		return lowest
+	default:
+		panic(fmt.Errorf("unknown Pool: %d", pool))
+	}
+}
 
-		if attackers[attackerIdx].Attacks[usedAttackIdx].Charges > 0 {
-			attackers[attackerIdx].Attacks[usedAttackIdx].Charges--
+// rollAttack rolls the attack's dice against the defender and returns the
+// damage before armor is taken into account. Attacks of detachments against
+// individuals are enhanced (d12), attacks of individuals against detachments
+// are impaired (d4) unless they are blast attacks. Attacks of frightened or
+// blinded attackers are always impaired, attacks of enhanced attackers are
+// always enhanced, and an attacker both enhanced and impaired by conditions
+// rolls as usual. Ranged attacks of engaged attackers are impaired just like
+// the ones of frightened attackers. The attack's flat modifier and minimum
+// damage are applied to the roll of the substituted dice too.
+func rollAttack(
+	attacker, defender *creat.Creature,
+	attack atk.Attack,
+	rng dice.RNG,
+) uint8 {
+	attackDice := attack.Dice
+	if attacker.IsDetachment != defender.IsDetachment {
+		if attacker.IsDetachment && !defender.IsDetachment {
+			attackDice = dice.D12
+		} else if !attack.IsBlast {
+			attackDice = dice.D4
 		}
 	}
+	isImpaired := attacker.HasCondition(atk.ConditionFrightened) ||
+		attacker.HasCondition(atk.ConditionBlinded) ||
+		attack.IsImpairedFrom(attacker.Zone)
+	isEnhanced := attacker.HasCondition(atk.ConditionEnhanced)
+	if isImpaired && !isEnhanced {
+		attackDice = dice.D4
+	} else if isEnhanced && !isImpaired {
+		attackDice = dice.D12
+	}
+
+	dice.Announcef(
+		rng, "%s's %s against %s", attacker.Name, attack.Name, defender.Name,
+	)
+	dmg := attack.Pool.Roll(attackDice, attack.DiceCnt, rng)
+	dice.Unannounce(rng)
+	return attack.ModifyDmg(dmg)
 }
 
 // noDamageDone returns true if no damage is done after resolving the attacks.
@@ -448,8 +849,9 @@ func noDamageDone(damage []damage) bool {
 }
 
 // applyDamageToPlayers decreases player's characteristics according to damage
-// received (armor is NOT taken into account) and handles critical damage (as
-// reducing STR to 0).
+// received (armor is NOT taken into account), gives a scar to a player whose
+// HP hits exactly 0, see creat.Creature.GainScar, and handles critical damage
+// (as reducing STR to 0).
 // It receives players, damageToPlayers, and RNG. It modifies players in place.
 // players is a slice of all players.
 // damageToPlayers is a slice of damage dealt to each player.
@@ -480,6 +882,9 @@ func applyDamageToPlayers(
 		case atk.STR:
 			if value <= players[playerIdx].HP {
 				players[playerIdx].HP -= value
+				if players[playerIdx].HP == 0 {
+					players[playerIdx].GainScar(rng)
+				}
 				continue
 			}
 
@@ -492,7 +897,7 @@ func applyDamageToPlayers(
 			}
 
 			players[playerIdx].STR -= value
-			if dice.D20.Roll(rng) > players[playerIdx].STR {
+			if !players[playerIdx].CriticalSave(rng).Success {
 				players[playerIdx].STR = 0
 			}
 
@@ -538,6 +943,10 @@ func applyDamageToMonsters(
 		return
 	}
 
+	moraleSave := creat.SaveOpts{
+		Reason: "morale", Mode: creat.SaveNormal, Modifier: 0,
+	}
+
 	for monsterIdx := range monsters {
 		if monsters[monsterIdx].IsOut() {
 			continue
@@ -555,7 +964,7 @@ func applyDamageToMonsters(
 
 				if monsters[monsterIdx].HP == 0 &&
 					totalCnt == 1 &&
-					dice.D20.Roll(rng) > monsters[monsterIdx].WIL {
+					!monsters[monsterIdx].SaveWith(atk.WIL, rng, moraleSave).Success {
 					// lone foe fleeing rules as HP is reduced to exactly 0
 					monsters[monsterIdx].STR = 0
 				}
@@ -572,12 +981,13 @@ func applyDamageToMonsters(
 			}
 
 			monsters[monsterIdx].STR -= value
-			if dice.D20.Roll(rng) > monsters[monsterIdx].STR {
+			if !monsters[monsterIdx].CriticalSave(rng).Success {
 				monsters[monsterIdx].STR = 0
 				continue
 			}
 
-			if totalCnt == 1 && dice.D20.Roll(rng) > monsters[monsterIdx].WIL {
+			if totalCnt == 1 &&
+				!monsters[monsterIdx].SaveWith(atk.WIL, rng, moraleSave).Success {
 				// lone foe fleeing rules as HP is reduced below 0
 				monsters[monsterIdx].STR = 0
 				continue
@@ -621,7 +1031,7 @@ func applyDamageToMonsters(
 				continue
 			}
 
-			if dice.D20.Roll(rng) > monsters[monsterIdx].WIL {
+			if !monsters[monsterIdx].SaveWith(atk.WIL, rng, moraleSave).Success {
 				monsters[monsterIdx].HP = 0
 				monsters[monsterIdx].STR = 0
 			}
@@ -629,6 +1039,103 @@ func applyDamageToMonsters(
 	}
 }
 
+// inflictConditions makes the defenders suffer from the conditions inflicted
+// by the attacks that damaged them. A defender avoids the condition with a
+// successful save against the characteristic the condition is saved with.
+// It receives defenders, damageToDefenders, and RNG. It modifies defenders in
+// place.
+func inflictConditions(
+	defenders []creat.Creature,
+	damageToDefenders []damage,
+	rng dice.RNG,
+) {
+	if len(defenders) == 0 ||
+		len(damageToDefenders) == 0 ||
+		len(defenders) != len(damageToDefenders) ||
+		rng == nil {
+		return
+	}
+
+	for defenderIdx := range defenders {
+		if defenders[defenderIdx].IsOut() {
+			continue
+		}
+
+		dmg := damageToDefenders[defenderIdx]
+		if dmg.value == 0 || dmg.inflicts.Kind == atk.ConditionNone {
+			continue
+		}
+
+		save := creat.SaveOpts{
+			Reason:   strings.ToLower(dmg.inflicts.Kind.String()),
+			Mode:     creat.SaveNormal,
+			Modifier: 0,
+		}
+		characteristic := dmg.inflicts.Kind.SaveCharacteristic()
+		if !defenders[defenderIdx].SaveWith(characteristic, rng, save).Success {
+			defenders[defenderIdx].Inflict(dmg.inflicts)
+		}
+	}
+}
+
+// tickBenefits decreases the durations of the beneficial conditions of the
+// creatures that are not out yet.
+func tickBenefits(creatures []creat.Creature) {
+	for i := range creatures {
+		if !creatures[i].IsOut() {
+			creatures[i].TickBenefits()
+		}
+	}
+}
+
+// tickConditions applies the end of the round effects of the conditions of
+// the creatures that are not out yet.
+func tickConditions(creatures []creat.Creature) {
+	for i := range creatures {
+		if !creatures[i].IsOut() {
+			creatures[i].TickConditions()
+		}
+	}
+}
+
+// anyTemporarilyParalysed returns true if any creature that is not out is
+// paralysed for a limited number of rounds.
+func anyTemporarilyParalysed(creatures []creat.Creature) bool {
+	for _, c := range creatures {
+		if c.IsOut() {
+			continue
+		}
+
+		for _, condition := range c.Conditions {
+			if condition.Kind == atk.ConditionParalysed && condition.Rounds > 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// rechargeAttacks advances the recharges of the attacks of the creatures that
+// are not out yet.
+func rechargeAttacks(creatures []creat.Creature, rng dice.RNG) {
+	for i := range creatures {
+		if !creatures[i].IsOut() {
+			creatures[i].TickRecharges(rng)
+		}
+	}
+}
+
+// anyRecharging returns true if any creature that is not out has an attack
+// regaining charges during the battle.
+func anyRecharging(creatures []creat.Creature) bool {
+	for _, c := range creatures {
+		if !c.IsOut() && c.IsRecharging() {
+			return true
+		}
+	}
+	return false
+}
+
 // allOut returns true if all creatures are out.
 func allOut(creatures []creat.Creature) bool {
 	for _, c := range creatures {
@@ -1,70 +1,42 @@
 package battle
 
 import (
-	"errors"
-	"fmt"
 	"math/rand/v2"
 	"slices"
 	"testing"
 
+	"github.com/rozag/cabasi/act"
 	"github.com/rozag/cabasi/atk"
 	"github.com/rozag/cabasi/creat"
 	"github.com/rozag/cabasi/dice"
+	"github.com/rozag/cabasi/dice/dicetest"
 	"github.com/rozag/cabasi/pickatk"
 	"github.com/rozag/cabasi/picktargets"
+	"github.com/rozag/cabasi/spell"
 )
 
-type minRNG struct{}
-
-func (minRNG) UintN(uint) uint { return 0 }
-
-type maxRNG struct{}
-
-func (maxRNG) UintN(n uint) uint { return n - 1 }
-
-type sequenceRNG struct {
-	seq []uint
-	idx uint
-}
-
-func (s *sequenceRNG) UintN(n uint) uint {
-	if n == 0 {
-		panic(errors.New("sequenceRNG: n must be greater than 0"))
-	}
-	if len(s.seq) == 0 {
-		panic(errors.New("sequenceRNG: no values"))
-	}
-	if s.idx >= uint(len(s.seq)) {
-		panic(fmt.Errorf(
-			"sequenceRNG: no more values: idx=%d, len(seq)=%d", s.idx, len(s.seq),
-		))
-	}
-	value := s.seq[s.idx]
-	if value >= n {
-		panic(
-			fmt.Errorf("sequenceRNG: value must be from [0, n=%d), got %d", n, value),
-		)
-	}
-	s.idx++
-	return value
-}
-
 func newDeterministicRNG() *rand.Rand {
 	// Suppressing gosec "G404 Use of weak random number generator" in tests.
 	return rand.New(rand.NewPCG(0x436169726E, 0x525047)) //nolint:gosec
 }
 
-func dummyPickAttack(creat.Creature, []creat.Creature) int { return -1 }
+func dummyPickAction(
+	creat.Creature, []creat.Creature, []creat.Creature, uint,
+) act.Action {
+	return act.None()
+}
 
-func dummyPickTargets(creat.Creature, uint, []creat.Creature) []uint {
+func dummyPickTargets(
+	creat.Creature, act.Action, []creat.Creature, []creat.Creature,
+) []uint {
 	return nil
 }
 
 func TestNewValidation(t *testing.T) {
-	rng := minRNG{}
+	rng := dicetest.Min{}
 	tests := []struct {
 		rng         dice.RNG
-		pickAttack  PickAttack
+		pickAction  PickAction
 		pickTargets PickTargets
 		name        string
 		wantErrCnt  uint
@@ -72,37 +44,37 @@ func TestNewValidation(t *testing.T) {
 		{
 			name:       "ValidNew",
 			rng:        rng,
-			pickAttack: dummyPickAttack, pickTargets: dummyPickTargets,
+			pickAction: dummyPickAction, pickTargets: dummyPickTargets,
 			wantErrCnt: 0,
 		},
 		{
 			name:       "NoRNG",
 			rng:        nil,
-			pickAttack: dummyPickAttack, pickTargets: dummyPickTargets,
+			pickAction: dummyPickAction, pickTargets: dummyPickTargets,
 			wantErrCnt: 1,
 		},
 		{
-			name:       "NoPickAttack",
+			name:       "NoPickAction",
 			rng:        rng,
-			pickAttack: nil, pickTargets: dummyPickTargets,
+			pickAction: nil, pickTargets: dummyPickTargets,
 			wantErrCnt: 1,
 		},
 		{
 			name:       "NoPickTargets",
 			rng:        rng,
-			pickAttack: dummyPickAttack, pickTargets: nil,
+			pickAction: dummyPickAction, pickTargets: nil,
 			wantErrCnt: 1,
 		},
 		{
 			name:       "MultipleErrors",
 			rng:        nil,
-			pickAttack: nil, pickTargets: nil,
+			pickAction: nil, pickTargets: nil,
 			wantErrCnt: 3,
 		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			_, err := New(test.rng, test.pickAttack, test.pickTargets)
+			_, err := New(test.rng, test.pickAction, test.pickTargets)
 
 			if test.wantErrCnt == 0 {
 				if err != nil {
@@ -133,17 +105,24 @@ func TestRunValidation(t *testing.T) {
 	spear := atk.Attack{
 		Name: "Spear", TargetCharacteristic: atk.STR,
 		Dice: dice.D6, DiceCnt: 1, Charges: -1,
-		IsBlast: false,
+		IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone, PairedWith: 0,
+		Inflicts: atk.Condition{Kind: atk.ConditionNone, Rounds: 0}, Recharge: nil,
+		DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+		Area: atk.AreaZone, MaxTargets: 0,
 	}
 	player := creat.Creature{
 		ID: "player-0", Name: "John Appleseed", Attacks: []atk.Attack{spear},
 		STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-		IsDetachment: false,
+		IsDetachment: false, Conditions: nil,
+		Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil, Items: nil,
+		Zone: atk.ZoneEngaged,
 	}
 	monster := creat.Creature{
 		ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 		STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-		IsDetachment: false,
+		IsDetachment: false, Conditions: nil,
+		Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil, Items: nil,
+		Zone: atk.ZoneEngaged,
 	}
 	tests := []struct {
 		name              string
@@ -181,7 +160,9 @@ func TestRunValidation(t *testing.T) {
 				{
 					ID: "", Name: "John Appleseed", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 			monsters:   []creat.Creature{monster},
@@ -194,7 +175,9 @@ func TestRunValidation(t *testing.T) {
 				{
 					ID: "", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 			wantErrCnt: 1,
@@ -205,14 +188,18 @@ func TestRunValidation(t *testing.T) {
 				{
 					ID: "creature", Name: "John Appleseed", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 			monsters: []creat.Creature{
 				{
 					ID: "creature", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 			wantErrCnt: 1,
@@ -225,7 +212,7 @@ func TestRunValidation(t *testing.T) {
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			b, err := New(minRNG{}, dummyPickAttack, dummyPickTargets)
+			b, err := New(dicetest.Min{}, dummyPickAction, dummyPickTargets)
 			if err != nil {
 				t.Fatalf("New(): want nil error, got %v", err)
 			}
@@ -266,20 +253,27 @@ func TestRunDoesNotMutateCreatures(t *testing.T) {
 	spear := atk.Attack{
 		Name: "Spear", TargetCharacteristic: atk.STR,
 		Dice: dice.D6, DiceCnt: 1, Charges: -1,
-		IsBlast: false,
+		IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone, PairedWith: 0,
+		Inflicts: atk.Condition{Kind: atk.ConditionNone, Rounds: 0}, Recharge: nil,
+		DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+		Area: atk.AreaZone, MaxTargets: 0,
 	}
 	originalPlayers := []creat.Creature{
 		{
 			ID: "player-0", Name: "John Appleseed", Attacks: []atk.Attack{spear},
 			STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-			IsDetachment: false,
+			IsDetachment: false, Conditions: nil,
+			Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil, Items: nil,
+			Zone: atk.ZoneEngaged,
 		},
 	}
 	originalMonsters := []creat.Creature{
 		{
 			ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 			STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-			IsDetachment: false,
+			IsDetachment: false, Conditions: nil,
+			Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil, Items: nil,
+			Zone: atk.ZoneEngaged,
 		},
 	}
 
@@ -316,12 +310,18 @@ func TestRunSmoke(t *testing.T) {
 	spear := atk.Attack{
 		Name: "Spear", TargetCharacteristic: atk.STR,
 		Dice: dice.D6, DiceCnt: 1, Charges: -1,
-		IsBlast: false,
+		IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone, PairedWith: 0,
+		Inflicts: atk.Condition{Kind: atk.ConditionNone, Rounds: 0}, Recharge: nil,
+		DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+		Area: atk.AreaZone, MaxTargets: 0,
 	}
 	lsword := atk.Attack{
 		Name: "Long Sword", TargetCharacteristic: atk.STR,
 		Dice: dice.D10, DiceCnt: 1, Charges: -1,
-		IsBlast: false,
+		IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone, PairedWith: 0,
+		Inflicts: atk.Condition{Kind: atk.ConditionNone, Rounds: 0}, Recharge: nil,
+		DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+		Area: atk.AreaZone, MaxTargets: 0,
 	}
 	tests := []struct {
 		name              string
@@ -331,234 +331,306 @@ func TestRunSmoke(t *testing.T) {
 	}{
 		{
 			name: "MightierPlayersAlwaysWinHighRolls",
-			rng:  maxRNG{},
+			rng:  dicetest.Max{},
 			players: []creat.Creature{
 				{
 					ID: "player-0", Name: "John Appleseed", Attacks: []atk.Attack{lsword},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "player-1", Name: "Jane Appleseed", Attacks: []atk.Attack{lsword},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "player-2", Name: "John Doe", Attacks: []atk.Attack{lsword},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 			monsters: []creat.Creature{
 				{
 					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-1", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-2", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 			want: true,
 		},
 		{
 			name: "MightierPlayersAlwaysWinLowRolls",
-			rng:  minRNG{},
+			rng:  dicetest.Min{},
 			players: []creat.Creature{
 				{
 					ID: "player-0", Name: "John Appleseed", Attacks: []atk.Attack{lsword},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "player-1", Name: "Jane Appleseed", Attacks: []atk.Attack{lsword},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "player-2", Name: "John Doe", Attacks: []atk.Attack{lsword},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 			monsters: []creat.Creature{
 				{
 					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-1", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-2", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 			want: true,
 		},
 		{
 			name: "MightierMonstersAlwaysWinHighRolls",
-			rng:  maxRNG{},
+			rng:  dicetest.Max{},
 			players: []creat.Creature{
 				{
 					ID: "player-0", Name: "John Appleseed", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "player-1", Name: "Jane Appleseed", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "player-2", Name: "John Doe", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 			monsters: []creat.Creature{
 				{
 					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{lsword},
 					STR: 8, DEX: 14, WIL: 8, HP: 18, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-1", Name: "Root Goblin", Attacks: []atk.Attack{lsword},
 					STR: 8, DEX: 14, WIL: 8, HP: 18, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-2", Name: "Root Goblin", Attacks: []atk.Attack{lsword},
 					STR: 8, DEX: 14, WIL: 8, HP: 18, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 			want: false,
 		},
 		{
 			name: "MightierMonstersAlwaysWinLowRolls",
-			rng:  minRNG{},
+			rng:  dicetest.Min{},
 			players: []creat.Creature{
 				{
 					ID: "player-0", Name: "John Appleseed", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "player-1", Name: "Jane Appleseed", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "player-2", Name: "John Doe", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 			monsters: []creat.Creature{
 				{
 					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{lsword},
 					STR: 8, DEX: 14, WIL: 8, HP: 18, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-1", Name: "Root Goblin", Attacks: []atk.Attack{lsword},
 					STR: 8, DEX: 14, WIL: 8, HP: 18, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-2", Name: "Root Goblin", Attacks: []atk.Attack{lsword},
 					STR: 8, DEX: 14, WIL: 8, HP: 18, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 			want: false,
 		},
 		{
 			name: "PlayersEqualToMonstersAlwaysWinHighRolls",
-			rng:  maxRNG{},
+			rng:  dicetest.Max{},
 			players: []creat.Creature{
 				{
 					ID: "player-0", Name: "John Appleseed", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "player-1", Name: "Jane Appleseed", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "player-2", Name: "John Doe", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 			monsters: []creat.Creature{
 				{
 					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-1", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-2", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 			want: true,
 		},
 		{
 			name: "PlayersEqualToMonstersAlwaysWinLowRolls",
-			rng:  minRNG{},
+			rng:  dicetest.Min{},
 			players: []creat.Creature{
 				{
 					ID: "player-0", Name: "John Appleseed", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "player-1", Name: "Jane Appleseed", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "player-2", Name: "John Doe", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 			monsters: []creat.Creature{
 				{
 					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-1", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-2", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 			want: true,
@@ -583,780 +655,2720 @@ func TestRunSmoke(t *testing.T) {
 	}
 }
 
-func TestAssignAttackers(t *testing.T) {
+func TestRunDetailed(t *testing.T) {
+	noCondition := atk.Condition{Kind: atk.ConditionNone, Rounds: 0}
+	spear := atk.Attack{
+		Name: "Spear", TargetCharacteristic: atk.STR,
+		Dice: dice.D6, DiceCnt: 1, Charges: -1,
+		IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone, PairedWith: 0,
+		Inflicts: noCondition, Recharge: nil, DmgMod: 0, MinDmg: 0,
+		Usage:      atk.UsageAlways,
+		Area:       atk.AreaZone,
+		MaxTargets: 0,
+	}
+	fang := atk.Attack{
+		Name: "Venom Fang", TargetCharacteristic: atk.STR,
+		Dice: dice.D4, DiceCnt: 1, Charges: -1,
+		IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone, PairedWith: 0,
+		Inflicts:   atk.Condition{Kind: atk.ConditionPoisoned, Rounds: -1},
+		Recharge:   nil,
+		DmgMod:     0,
+		MinDmg:     0,
+		Usage:      atk.UsageAlways,
+		Area:       atk.AreaZone,
+		MaxTargets: 0,
+	}
+	fireball := spell.Book{
+		Name: "Fireball",
+		Effect: atk.Attack{
+			Name: "Fireball", TargetCharacteristic: atk.STR,
+			Dice: dice.D8, DiceCnt: 1, Charges: -1,
+			IsBlast: true, Pool: atk.PoolHighest, Traits: atk.TraitMagical,
+			PairedWith: 0, Inflicts: noCondition, Recharge: nil,
+			DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+			Area: atk.AreaZone, MaxTargets: 0,
+		},
+	}
 	tests := []struct {
-		name       string
-		attackers  [][]attacker
-		targets    [][]uint
-		attackIdxs []int
-		want       [][]attacker
+		name                      string
+		faces                     []uint
+		players, monsters         []creat.Creature
+		wantPlayers, wantMonsters []creat.Creature
+		wantPlayersWon            bool
 	}{
 		{
-			name:       "EmptyAttackers",
-			attackers:  [][]attacker{},
-			targets:    [][]uint{{0, 1}, {1, 2}},
-			attackIdxs: []int{0, 1},
-			want:       [][]attacker{},
-		},
-		{
-			name:       "NilAttackers",
-			attackers:  nil,
-			targets:    [][]uint{{0}, {1, 2}},
-			attackIdxs: []int{0, 1},
-			want:       nil,
-		},
-		{
-			name:       "EmptyTargets",
-			attackers:  [][]attacker{nil, nil, nil},
-			targets:    [][]uint{},
-			attackIdxs: []int{0, 1},
-			want:       [][]attacker{nil, nil, nil},
-		},
-		{
-			name:       "NilTargets",
-			attackers:  [][]attacker{nil, nil, nil},
-			targets:    nil,
-			attackIdxs: []int{0, 1},
-			want:       [][]attacker{nil, nil, nil},
-		},
-		{
-			name:       "AllTargetsEmpty",
-			attackers:  [][]attacker{nil, nil, nil},
-			targets:    [][]uint{{}, {}},
-			attackIdxs: []int{0, 1},
-			want:       [][]attacker{nil, nil, nil},
-		},
-		{
-			name:       "AllTargetsNil",
-			attackers:  [][]attacker{nil, nil, nil},
-			targets:    [][]uint{nil, nil},
-			attackIdxs: []int{0, 1},
-			want:       [][]attacker{nil, nil, nil},
-		},
-		{
-			name:       "InvalidIdxsInTargets",
-			attackers:  [][]attacker{nil, nil, nil},
-			targets:    [][]uint{{2, 3}, {4}},
-			attackIdxs: []int{1, 0},
-			want:       [][]attacker{nil, nil, {{attackerIdx: 0, attackIdx: 1}}},
-		},
-		{
-			name:       "EmptyAttackIdxs",
-			attackers:  [][]attacker{nil, nil, nil},
-			targets:    [][]uint{{0, 1}, {1, 2}},
-			attackIdxs: []int{},
-			want:       [][]attacker{nil, nil, nil},
-		},
-		{
-			name:       "NilAttackIdxs",
-			attackers:  [][]attacker{nil, nil, nil},
-			targets:    [][]uint{{0, 1}, {1, 2}},
-			attackIdxs: nil,
-			want:       [][]attacker{nil, nil, nil},
-		},
-		{
-			name:       "NegativeAttackIdxs",
-			attackers:  [][]attacker{nil, nil, nil},
-			targets:    [][]uint{{0, 1}, {1, 2}},
-			attackIdxs: []int{1, -1},
-			want: [][]attacker{
-				{{attackerIdx: 0, attackIdx: 1}},
-				{{attackerIdx: 0, attackIdx: 1}},
-				nil,
+			// Fang deals 1 damage reducing HP to exactly 0, the lone goblin passes
+			// the morale save with a 1 but fails the poison save with a 20. Spear
+			// deals 1 damage back, then the poison reduces the goblin's STR to 1,
+			// and the next Fang's hit takes it out.
+			name:  "Poison",
+			faces: []uint{1, 1, 20, 1, 1},
+			players: []creat.Creature{
+				{
+					ID: "player-0", Name: "John Appleseed", Attacks: []atk.Attack{fang},
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
+				},
 			},
-		},
-		{
-			name: "DirtyAttackersReset",
-			attackers: [][]attacker{
-				{{attackerIdx: 0, attackIdx: 0}},
-				{{attackerIdx: 1, attackIdx: 0}},
-				{{attackerIdx: 2, attackIdx: 0}},
+			monsters: []creat.Creature{
+				{
+					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
+					STR: 2, DEX: 14, WIL: 8, HP: 1, Armor: 0,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
+				},
 			},
-			targets:    [][]uint{nil, {1}, nil},
-			attackIdxs: []int{-1, 0, -1},
-			want:       [][]attacker{nil, {{attackerIdx: 1, attackIdx: 0}}, nil},
-		},
-		{
-			name: "DirtyAttackersResetWithInvalidInputs",
-			attackers: [][]attacker{
-				{{attackerIdx: 0, attackIdx: 0}},
-				{{attackerIdx: 1, attackIdx: 0}},
-				{{attackerIdx: 2, attackIdx: 0}},
+			wantPlayers: []creat.Creature{
+				{
+					ID: "player-0", Name: "John Appleseed", Attacks: []atk.Attack{fang},
+					STR: 8, DEX: 14, WIL: 8, HP: 3, MaxHP: 4, Armor: 0,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
+				},
 			},
-			targets:    nil,
-			attackIdxs: nil,
-			want:       [][]attacker{nil, nil, nil},
-		},
-		{
-			name:       "TargetsAndAttackIdxsOfDifferentLength",
-			attackers:  [][]attacker{nil, nil, nil},
-			targets:    [][]uint{{0, 1}, {1, 2}},
-			attackIdxs: []int{0},
-			want:       [][]attacker{nil, nil, nil},
+			wantMonsters: []creat.Creature{
+				{
+					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
+					STR: 0, DEX: 14, WIL: 8, HP: 0, MaxHP: 1, Armor: 0,
+					IsDetachment: false, Spellbooks: nil, Fatigue: 0, IsDeprived: false,
+					Supports: nil, Items: nil,
+					Zone: atk.ZoneEngaged,
+					Conditions: []atk.Condition{
+						{Kind: atk.ConditionPoisoned, Rounds: -1},
+					},
+				},
+			},
+			wantPlayersWon: true,
 		},
 		{
-			name:       "AllAttackersAttack",
-			attackers:  [][]attacker{nil, nil, nil},
-			targets:    [][]uint{{0}, {1}, {2}, {0}},
-			attackIdxs: []int{0, 1, 2, 3},
-			want: [][]attacker{
-				{{attackerIdx: 0, attackIdx: 0}, {attackerIdx: 3, attackIdx: 3}},
-				{{attackerIdx: 1, attackIdx: 1}},
-				{{attackerIdx: 2, attackIdx: 2}},
+			// The paralysed player skips the first turn instead of losing the
+			// battle, Spear deals 1 damage, then the recovered player deals 6
+			// damage and the goblin fails the critical damage save with a 20.
+			name:  "TemporaryParalysis",
+			faces: []uint{1, 6, 20},
+			players: []creat.Creature{
+				{
+					ID: "player-0", Name: "John Appleseed", Attacks: []atk.Attack{spear},
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+					IsDetachment: false, Spellbooks: nil, Fatigue: 0, IsDeprived: false,
+					Supports: nil, Items: nil,
+					Zone: atk.ZoneEngaged,
+					Conditions: []atk.Condition{
+						{Kind: atk.ConditionParalysed, Rounds: 1},
+					},
+				},
+			},
+			monsters: []creat.Creature{
+				{
+					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
+				},
 			},
+			wantPlayers: []creat.Creature{
+				{
+					ID: "player-0", Name: "John Appleseed", Attacks: []atk.Attack{spear},
+					STR: 8, DEX: 14, WIL: 8, HP: 3, MaxHP: 4, Armor: 0,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
+				},
+			},
+			wantMonsters: []creat.Creature{
+				{
+					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
+					STR: 0, DEX: 14, WIL: 8, HP: 0, MaxHP: 4, Armor: 0,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
+				},
+			},
+			wantPlayersWon: true,
 		},
 		{
-			name:       "SomeAttackersAttack",
-			attackers:  [][]attacker{nil, nil, nil},
-			targets:    [][]uint{{0}, nil, nil, {0}},
-			attackIdxs: []int{0, -1, -1, 3},
-			want: [][]attacker{
-				{{attackerIdx: 0, attackIdx: 0}, {attackerIdx: 3, attackIdx: 3}},
-				nil,
-				nil,
+			// Magic Missile deals 2 damage and recharges in 2 rounds, so the player
+			// skips the second turn instead of losing the battle. Spear deals 1
+			// damage twice, then the recharged Magic Missile deals 4 damage and the
+			// goblin fails the critical damage save with a 20.
+			name:  "RechargingAttack",
+			faces: []uint{2, 1, 1, 4, 20},
+			players: []creat.Creature{
+				{
+					ID: "player-0", Name: "John Appleseed",
+					Attacks: []atk.Attack{
+						{
+							Name: "Magic Missile", TargetCharacteristic: atk.STR,
+							Dice: dice.D4, DiceCnt: 1, Charges: 1,
+							IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitMagical,
+							PairedWith: 0, Inflicts: noCondition,
+							Recharge: &atk.Recharge{
+								Kind: atk.RechargeEveryNRounds, Rounds: 2, Dice: 0,
+								Threshold: 0, MaxCharges: 1, Elapsed: 0,
+							},
+							DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+							Area: atk.AreaZone, MaxTargets: 0,
+						},
+					},
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
+				},
+			},
+			monsters: []creat.Creature{
+				{
+					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
+				},
+			},
+			wantPlayers: []creat.Creature{
+				{
+					ID: "player-0", Name: "John Appleseed",
+					Attacks: []atk.Attack{
+						{
+							Name: "Magic Missile", TargetCharacteristic: atk.STR,
+							Dice: dice.D4, DiceCnt: 1, Charges: 0,
+							IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitMagical,
+							PairedWith: 0, Inflicts: noCondition,
+							Recharge: &atk.Recharge{
+								Kind: atk.RechargeEveryNRounds, Rounds: 2, Dice: 0,
+								Threshold: 0, MaxCharges: 1, Elapsed: 0,
+							},
+							DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+							Area: atk.AreaZone, MaxTargets: 0,
+						},
+					},
+					STR: 8, DEX: 14, WIL: 8, HP: 2, MaxHP: 4, Armor: 0,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
+				},
+			},
+			wantMonsters: []creat.Creature{
+				{
+					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
+					STR: 0, DEX: 14, WIL: 8, HP: 0, MaxHP: 4, Armor: 0,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
+				},
 			},
+			wantPlayersWon: true,
 		},
 		{
-			name:       "SomeAttackersAttackMultipleTargets",
-			attackers:  [][]attacker{nil, nil, nil},
-			targets:    [][]uint{{0, 1}, {2}, {1, 2}, {0}},
-			attackIdxs: []int{0, 1, 2, 3},
-			want: [][]attacker{
-				{{attackerIdx: 0, attackIdx: 0}, {attackerIdx: 3, attackIdx: 3}},
-				{{attackerIdx: 0, attackIdx: 0}, {attackerIdx: 2, attackIdx: 2}},
-				{{attackerIdx: 1, attackIdx: 1}, {attackerIdx: 2, attackIdx: 2}},
+			name:  "PermanentParalysis",
+			faces: nil,
+			players: []creat.Creature{
+				{
+					ID: "player-0", Name: "John Appleseed", Attacks: []atk.Attack{spear},
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+					IsDetachment: false, Spellbooks: nil, Fatigue: 0, IsDeprived: false,
+					Supports: nil, Items: nil,
+					Zone: atk.ZoneEngaged,
+					Conditions: []atk.Condition{
+						{Kind: atk.ConditionParalysed, Rounds: -1},
+					},
+				},
+			},
+			monsters: []creat.Creature{
+				{
+					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
+				},
+			},
+			wantPlayers: []creat.Creature{
+				{
+					ID: "player-0", Name: "John Appleseed", Attacks: []atk.Attack{spear},
+					STR: 8, DEX: 14, WIL: 8, HP: 4, MaxHP: 4, Armor: 0,
+					IsDetachment: false, Spellbooks: nil, Fatigue: 0, IsDeprived: false,
+					Supports: nil, Items: nil,
+					Zone: atk.ZoneEngaged,
+					Conditions: []atk.Condition{
+						{Kind: atk.ConditionParalysed, Rounds: -1},
+					},
+				},
+			},
+			wantMonsters: []creat.Creature{
+				{
+					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
+					STR: 8, DEX: 14, WIL: 8, HP: 4, MaxHP: 4, Armor: 0,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
+				},
 			},
+			wantPlayersWon: false,
 		},
 		{
-			name:       "AllAttackersAttackMultipleTargets",
-			attackers:  [][]attacker{nil, nil, nil},
-			targets:    [][]uint{{0, 1, 2}, {0, 1, 2}, {0, 1, 2}, {0, 1, 2}},
-			attackIdxs: []int{0, 1, 2, 3},
-			want: [][]attacker{
+			// Fireball beats Spear, so the wizard casts it taking 1 Fatigue. It
+			// deals 8 damage and the goblin fails the critical damage save with a
+			// 20.
+			name:  "Spellcasting",
+			faces: []uint{8, 20},
+			players: []creat.Creature{
 				{
-					{attackerIdx: 0, attackIdx: 0},
-					{attackerIdx: 1, attackIdx: 1},
-					{attackerIdx: 2, attackIdx: 2},
-					{attackerIdx: 3, attackIdx: 3},
+					ID: "player-0", Name: "Merlin", Attacks: []atk.Attack{spear},
+					Spellbooks: []spell.Book{fireball}, Supports: nil, Items: nil,
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+					IsDetachment: false, Conditions: nil,
+					Fatigue: 0, IsDeprived: false, Zone: atk.ZoneEngaged,
+				},
+			},
+			monsters: []creat.Creature{
+				{
+					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
+			},
+			wantPlayers: []creat.Creature{
 				{
-					{attackerIdx: 0, attackIdx: 0},
-					{attackerIdx: 1, attackIdx: 1},
-					{attackerIdx: 2, attackIdx: 2},
-					{attackerIdx: 3, attackIdx: 3},
+					ID: "player-0", Name: "Merlin", Attacks: []atk.Attack{spear},
+					Spellbooks: []spell.Book{fireball}, Supports: nil, Items: nil,
+					STR: 8, DEX: 14, WIL: 8, HP: 4, MaxHP: 4, Armor: 0,
+					IsDetachment: false, Conditions: nil,
+					Fatigue: 1, IsDeprived: false, Zone: atk.ZoneEngaged,
 				},
+			},
+			wantMonsters: []creat.Creature{
 				{
-					{attackerIdx: 0, attackIdx: 0},
-					{attackerIdx: 1, attackIdx: 1},
-					{attackerIdx: 2, attackIdx: 2},
-					{attackerIdx: 3, attackIdx: 3},
+					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
+					STR: 0, DEX: 14, WIL: 8, HP: 0, MaxHP: 4, Armor: 0,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
+			wantPlayersWon: true,
 		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			assignAttackers(test.attackers, test.targets, test.attackIdxs)
-			if !slices.EqualFunc(test.attackers, test.want, slices.Equal) {
-				t.Fatalf(
-					"assignAttackers(): want %v, got %v", test.want, test.attackers,
+			rng := dicetest.NewSequence(t, test.faces...)
+			b, err := New(rng, pickatk.MaxDmg, picktargets.FirstAlive)
+			if err != nil {
+				t.Fatalf("New(): want nil error, got %v", err)
+			}
+
+			got, err := b.RunDetailed(test.players, test.monsters)
+			if err != nil {
+				t.Fatalf("RunDetailed(): want nil error, got %v", err)
+			}
+
+			if got.PlayersWon != test.wantPlayersWon {
+				t.Errorf(
+					"RunDetailed(): want PlayersWon %t, got %t",
+					test.wantPlayersWon, got.PlayersWon,
+				)
+			}
+			if !creat.CreatureSlice(got.Players).Equals(test.wantPlayers) {
+				t.Errorf(
+					"RunDetailed(): want players %v, got %v",
+					test.wantPlayers, got.Players,
+				)
+			}
+			if !creat.CreatureSlice(got.Monsters).Equals(test.wantMonsters) {
+				t.Errorf(
+					"RunDetailed(): want monsters %v, got %v",
+					test.wantMonsters, got.Monsters,
 				)
 			}
 		})
 	}
 }
 
-func TestNoAttackersAssigned(t *testing.T) {
-	tests := []struct {
-		name      string
-		attackers [][]attacker
-		want      bool
-	}{
-		{
-			name:      "EmptyAttackers",
-			attackers: [][]attacker{},
-			want:      true,
-		},
-		{
-			name:      "NilAttackers",
-			attackers: nil,
-			want:      true,
-		},
-		{
-			name:      "AllAttackersEmpty",
-			attackers: [][]attacker{{}, {}, {}},
-			want:      true,
-		},
-		{
-			name:      "AllAttackersNil",
-			attackers: [][]attacker{nil, nil, nil},
-			want:      true,
-		},
-		{
-			name:      "SomeAttackersAssigned",
-			attackers: [][]attacker{{{attackerIdx: 0, attackIdx: 0}}, nil, {}},
-			want:      false,
-		},
-		{
-			name: "AllAttackersAssigned",
-			attackers: [][]attacker{
-				{{attackerIdx: 0, attackIdx: 0}, {attackerIdx: 1, attackIdx: 1}},
-				{{attackerIdx: 2, attackIdx: 2}},
-				{{attackerIdx: 3, attackIdx: 3}},
+// drinkPotionOrMaxDmg drinks the healing potion (the first support) when
+// wounded, otherwise it picks the attack with the maximum damage.
+func drinkPotionOrMaxDmg(
+	actor creat.Creature,
+	allies, enemies []creat.Creature,
+	round uint,
+) act.Action {
+	if actor.HP < 4 && actor.CanUse(0) {
+		return act.Use(0)
+	}
+	return pickatk.MaxDmg(actor, allies, enemies, round)
+}
+
+func TestRunDetailedSupports(t *testing.T) {
+	cleric := creat.Creature{
+		ID: "player-0", Name: "Tuck",
+		Attacks: []atk.Attack{
+			{
+				Name: "Spear", TargetCharacteristic: atk.STR,
+				Dice: dice.D6, DiceCnt: 1, Charges: -1,
+				IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+				PairedWith: 0,
+				Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+				Recharge:   nil, DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+				Area: atk.AreaZone, MaxTargets: 0,
+			},
+		},
+		Supports: []act.Support{
+			{
+				Name: "Healing Potion", Kind: act.SupportHeal,
+				Dice: dice.D6, DiceCnt: 1, Rounds: 0, Charges: 1,
+			},
+			{
+				Name: "Shield of Faith", Kind: act.SupportProtect,
+				Dice: 0, DiceCnt: 0, Rounds: 1, Charges: -1,
 			},
-			want: false,
 		},
+		STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+		IsDetachment: false, Conditions: nil,
+		Spellbooks: nil, Fatigue: 0, IsDeprived: false, Zone: atk.ZoneEngaged,
+		Items: nil,
+	}
+	goblin := creat.Creature{
+		ID: "monster-0", Name: "Root Goblin",
+		Attacks: slices.Clone(cleric.Attacks), Spellbooks: nil, Supports: nil,
+		Items: nil, Zone: atk.ZoneEngaged,
+		STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+		IsDetachment: false, Conditions: nil, Fatigue: 0, IsDeprived: false,
 	}
-	for _, test := range tests {
-		t.Run(test.name, func(t *testing.T) {
-			initial := make([][]attacker, len(test.attackers))
-			for i, assigned := range test.attackers {
-				initial[i] = make([]attacker, len(assigned))
-				copy(initial[i], assigned)
-			}
 
-			if got := noAttackersAssigned(test.attackers); got != test.want {
-				t.Fatalf("noAttackersAssigned(): want %t, got %t", test.want, got)
-			}
+	// Spear deals 1 damage, the goblin deals 3 back. The wounded cleric drinks
+	// the potion healing 6 HP, but not above the starting 4 HP, and skips
+	// attacking instead of losing the battle. The goblin deals 1 damage, then
+	// Spear deals 6 damage and the goblin fails the critical damage save with
+	// a 20.
+	rng := dicetest.NewSequence(t, 1, 3, 6, 1, 6, 20)
+	b, err := New(rng, drinkPotionOrMaxDmg, picktargets.FirstAlive)
+	if err != nil {
+		t.Fatalf("New(): want nil error, got %v", err)
+	}
 
-			if !slices.EqualFunc(test.attackers, initial, slices.Equal) {
-				t.Fatalf(
-					"noAttackersAssigned(): attackers mutated, want %v, got %v",
-					initial, test.attackers,
-				)
-			}
-		})
+	got, err := b.RunDetailed(
+		[]creat.Creature{cleric}, []creat.Creature{goblin},
+	)
+	if err != nil {
+		t.Fatalf("RunDetailed(): want nil error, got %v", err)
+	}
+
+	if !got.PlayersWon {
+		t.Errorf("RunDetailed(): want PlayersWon true, got false")
+	}
+	player := got.Players[0]
+	if player.HP != 3 || player.Supports[0].Charges != 0 {
+		t.Errorf(
+			"RunDetailed(): want HP 3 and no potion charges, got %d and %d",
+			player.HP, player.Supports[0].Charges,
+		)
 	}
 }
 
-func TestResolveAttacks(t *testing.T) {
+// pickLastAttack always picks the last attack of the actor, no matter if it's
+// available.
+func pickLastAttack(
+	actor creat.Creature,
+	_, _ []creat.Creature,
+	_ uint,
+) act.Action {
+	// Suppressing gosec "G115 integer overflow conversion int -> uint"
+	// because int length will never overflow a uint variable.
+	return act.Attack(uint(len(actor.Attacks) - 1)) //nolint:gosec
+}
+
+func TestRunDetailedRejectsUnavailableAttacks(t *testing.T) {
 	spear := atk.Attack{
 		Name: "Spear", TargetCharacteristic: atk.STR,
 		Dice: dice.D6, DiceCnt: 1, Charges: -1,
-		IsBlast: false,
+		IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone, PairedWith: 0,
+		Inflicts: atk.Condition{Kind: atk.ConditionNone, Rounds: 0}, Recharge: nil,
+		DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+		Area: atk.AreaZone, MaxTargets: 0,
 	}
-	player0 := creat.Creature{
-		ID: "player-0", Name: "John Appleseed", Attacks: []atk.Attack{spear},
+	volley := spear
+	volley.Name, volley.Usage = "Opening Volley", atk.UsageFirstRound
+	archer := creat.Creature{
+		ID: "player-0", Name: "Robin", Attacks: []atk.Attack{spear, volley},
 		STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-		IsDetachment: false,
+		IsDetachment: false, Conditions: nil,
+		Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil, Items: nil,
+		Zone: atk.ZoneEngaged,
 	}
-	monster0 := creat.Creature{
+	goblin := creat.Creature{
 		ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 		STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-		IsDetachment: false,
+		IsDetachment: false, Conditions: nil,
+		Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil, Items: nil,
+		Zone: atk.ZoneEngaged,
 	}
-	tests := []struct {
-		name                 string
-		damageToDefenders    []damage
-		attackers, defenders []creat.Creature
-		assignedAttackers    [][]attacker
-		usedAttackIdxs       []int
-		rng                  dice.RNG
-		wantDamage           []damage
-		wantAttackers        []creat.Creature
-	}{
-		{
-			name:              "EmptyDamageToDefenders",
-			damageToDefenders: []damage{},
-			attackers:         []creat.Creature{player0},
-			defenders:         []creat.Creature{monster0},
-			assignedAttackers: [][]attacker{{{attackerIdx: 0, attackIdx: 0}}},
-			usedAttackIdxs:    []int{42},
-			rng:               maxRNG{},
+
+	// The volley deals 1 damage in the first round and the goblin deals 1 damage
+	// back. In the second round the volley is rejected, so the players can't
+	// attack and lose.
+	rng := dicetest.NewSequence(t, 1, 1)
+	b, err := New(rng, pickLastAttack, picktargets.FirstAlive)
+	if err != nil {
+		t.Fatalf("New(): want nil error, got %v", err)
+	}
+
+	got, err := b.RunDetailed(
+		[]creat.Creature{archer}, []creat.Creature{goblin},
+	)
+	if err != nil {
+		t.Fatalf("RunDetailed(): want nil error, got %v", err)
+	}
+
+	if got.PlayersWon {
+		t.Errorf("RunDetailed(): want PlayersWon false, got true")
+	}
+	if got.Players[0].HP != 3 || got.Monsters[0].HP != 3 {
+		t.Errorf(
+			"RunDetailed(): want HP 3 and 3, got %d and %d",
+			got.Players[0].HP, got.Monsters[0].HP,
+		)
+	}
+}
+
+func TestRunDetailedMonstersAttackRecoveringPlayers(t *testing.T) {
+	noCondition := atk.Condition{Kind: atk.ConditionNone, Rounds: 0}
+	bolt := atk.Attack{
+		Name: "Fire Bolt", TargetCharacteristic: atk.STR,
+		Dice: dice.D8, DiceCnt: 1, Charges: 0,
+		IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+		PairedWith: 0, Inflicts: noCondition,
+		Recharge: &atk.Recharge{
+			Kind: atk.RechargeEveryNRounds, Rounds: 1,
+			Dice: 0, Threshold: 0, MaxCharges: 1, Elapsed: 0,
+		},
+		DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+		Area: atk.AreaZone, MaxTargets: 0,
+	}
+	spear := atk.Attack{
+		Name: "Spear", TargetCharacteristic: atk.STR,
+		Dice: dice.D6, DiceCnt: 1, Charges: -1,
+		IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+		PairedWith: 0, Inflicts: noCondition, Recharge: nil,
+		DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+		Area: atk.AreaZone, MaxTargets: 0,
+	}
+	mage := creat.Creature{
+		ID: "player-0", Name: "Ash", Attacks: []atk.Attack{bolt},
+		STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+		IsDetachment: false, Conditions: nil,
+		Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil, Items: nil,
+		Zone: atk.ZoneEngaged,
+	}
+	goblin := creat.Creature{
+		ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
+		STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+		IsDetachment: false, Conditions: nil,
+		Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil, Items: nil,
+		Zone: atk.ZoneEngaged,
+	}
+
+	// The mage can't attack in the first round while the bolt recharges, but
+	// the goblin can and deals 3 damage. The monsters' turn must check their
+	// own attackers rather than the ones the players assigned, otherwise the
+	// players win right away. In the second round the bolt deals 8 damage and
+	// the goblin fails the critical damage save with a 20.
+	rng := dicetest.NewSequence(t, 3, 8, 20)
+	b, err := New(rng, pickatk.MaxDmg, picktargets.FirstAlive)
+	if err != nil {
+		t.Fatalf("New(): want nil error, got %v", err)
+	}
+
+	got, err := b.RunDetailed(
+		[]creat.Creature{mage}, []creat.Creature{goblin},
+	)
+	if err != nil {
+		t.Fatalf("RunDetailed(): want nil error, got %v", err)
+	}
+
+	if !got.PlayersWon {
+		t.Errorf("RunDetailed(): want PlayersWon true, got false")
+	}
+	if got.Players[0].HP != 1 || !got.Monsters[0].IsOut() {
+		t.Errorf(
+			"RunDetailed(): want player HP 1 and monster out, got %d and %t",
+			got.Players[0].HP, got.Monsters[0].IsOut(),
+		)
+	}
+}
+
+func TestRunDetailedZones(t *testing.T) {
+	noCondition := atk.Condition{Kind: atk.ConditionNone, Rounds: 0}
+	archer := creat.Creature{
+		ID: "player-0", Name: "Robin",
+		Attacks: []atk.Attack{
+			{
+				Name: "Bow", TargetCharacteristic: atk.STR,
+				Dice: dice.D6, DiceCnt: 1, Charges: -1,
+				IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitRanged,
+				PairedWith: 0, Inflicts: noCondition, Recharge: nil,
+				DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+				Area: atk.AreaZone, MaxTargets: 0,
+			},
+		},
+		STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+		IsDetachment: false, Conditions: nil, Zone: atk.ZoneFar,
+		Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil, Items: nil,
+	}
+	brute := creat.Creature{
+		ID: "monster-0", Name: "Ogre",
+		Attacks: []atk.Attack{
+			{
+				Name: "Club", TargetCharacteristic: atk.STR,
+				Dice: dice.D8, DiceCnt: 1, Charges: -1,
+				IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+				PairedWith: 0, Inflicts: noCondition, Recharge: nil,
+				DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+				Area: atk.AreaZone, MaxTargets: 0,
+			},
+		},
+		STR: 16, DEX: 8, WIL: 8, HP: 5, Armor: 0,
+		IsDetachment: false, Conditions: nil, Zone: atk.ZoneFar,
+		Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil, Items: nil,
+	}
+
+	// The archer shoots the ogre for 1 damage every round. The ogre moves
+	// closer twice and then charges the archer keeping the distance, pulling
+	// it into the melee. In the fourth round the impaired bow deals 1 damage,
+	// the club deals 8 and the archer fails the critical damage save with a 20.
+	rng := dicetest.NewSequence(t, 1, 1, 1, 1, 8, 20)
+	b, err := New(rng, pickatk.MaxDmg, picktargets.FirstAlive)
+	if err != nil {
+		t.Fatalf("New(): want nil error, got %v", err)
+	}
+
+	got, err := b.RunDetailed(
+		[]creat.Creature{archer}, []creat.Creature{brute},
+	)
+	if err != nil {
+		t.Fatalf("RunDetailed(): want nil error, got %v", err)
+	}
+
+	if got.PlayersWon {
+		t.Errorf("RunDetailed(): want PlayersWon false, got true")
+	}
+	ogre, robin := got.Monsters[0], got.Players[0]
+	if ogre.HP != 1 || ogre.Zone != atk.ZoneEngaged {
+		t.Errorf(
+			"RunDetailed(): want HP 1 and zone Engaged, got %d and %s",
+			ogre.HP, ogre.Zone,
+		)
+	}
+	if !robin.IsOut() || robin.Zone != atk.ZoneEngaged {
+		t.Errorf(
+			"RunDetailed(): want the archer out and engaged, got %t and %s",
+			robin.IsOut(), robin.Zone,
+		)
+	}
+}
+
+type announcingRNG struct {
+	dicetest.Max
+	what []string
+}
+
+func (a *announcingRNG) Announce(what string) { a.what = append(a.what, what) }
+
+func TestRunAnnouncesRolls(t *testing.T) {
+	spear := atk.Attack{
+		Name: "Spear", TargetCharacteristic: atk.STR,
+		Dice: dice.D6, DiceCnt: 1, Charges: -1,
+		IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone, PairedWith: 0,
+		Inflicts: atk.Condition{Kind: atk.ConditionNone, Rounds: 0}, Recharge: nil,
+		DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+		Area: atk.AreaZone, MaxTargets: 0,
+	}
+	players := []creat.Creature{
+		{
+			ID: "player-0", Name: "John Appleseed", Attacks: []atk.Attack{spear},
+			STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+			IsDetachment: false, Conditions: nil,
+			Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil, Items: nil,
+			Zone: atk.ZoneEngaged,
+		},
+	}
+	monsters := []creat.Creature{
+		{
+			ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
+			STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+			IsDetachment: false, Conditions: nil,
+			Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil, Items: nil,
+			Zone: atk.ZoneEngaged,
+		},
+	}
+
+	rng := &announcingRNG{Max: dicetest.Max{}, what: nil}
+	b, err := New(rng, pickatk.MaxDmg, picktargets.FirstAlive)
+	if err != nil {
+		t.Fatalf("New(): want nil error, got %v", err)
+	}
+
+	if _, err := b.Run(players, monsters); err != nil {
+		t.Fatalf("Run(): want nil error, got %v", err)
+	}
+
+	// Spear deals 6 damage: 4 to HP and 2 to STR, then the critical damage save
+	// is rolled and failed with a 20. Each announcement is cleared once its dice
+	// are rolled.
+	want := []string{
+		"John Appleseed's Spear against Root Goblin", "",
+		"Root Goblin's critical damage STR save", "",
+	}
+	if !slices.Equal(rng.what, want) {
+		t.Fatalf("Run(): want announcements %q, got %q", want, rng.what)
+	}
+}
+
+func TestAssignAttackers(t *testing.T) {
+	a0, a1, a2, a3 := act.Attack(0), act.Attack(1), act.Attack(2), act.Attack(3)
+	none := act.None()
+	tests := []struct {
+		name      string
+		attackers [][]attacker
+		targets   [][]uint
+		actions   []act.Action
+		want      [][]attacker
+	}{
+		{
+			name:      "EmptyAttackers",
+			attackers: [][]attacker{},
+			targets:   [][]uint{{0, 1}, {1, 2}},
+			actions:   []act.Action{a0, a1},
+			want:      [][]attacker{},
+		},
+		{
+			name:      "NilAttackers",
+			attackers: nil,
+			targets:   [][]uint{{0}, {1, 2}},
+			actions:   []act.Action{a0, a1},
+			want:      nil,
+		},
+		{
+			name:      "EmptyTargets",
+			attackers: [][]attacker{nil, nil, nil},
+			targets:   [][]uint{},
+			actions:   []act.Action{a0, a1},
+			want:      [][]attacker{nil, nil, nil},
+		},
+		{
+			name:      "NilTargets",
+			attackers: [][]attacker{nil, nil, nil},
+			targets:   nil,
+			actions:   []act.Action{a0, a1},
+			want:      [][]attacker{nil, nil, nil},
+		},
+		{
+			name:      "AllTargetsEmpty",
+			attackers: [][]attacker{nil, nil, nil},
+			targets:   [][]uint{{}, {}},
+			actions:   []act.Action{a0, a1},
+			want:      [][]attacker{nil, nil, nil},
+		},
+		{
+			name:      "AllTargetsNil",
+			attackers: [][]attacker{nil, nil, nil},
+			targets:   [][]uint{nil, nil},
+			actions:   []act.Action{a0, a1},
+			want:      [][]attacker{nil, nil, nil},
+		},
+		{
+			name:      "InvalidIdxsInTargets",
+			attackers: [][]attacker{nil, nil, nil},
+			targets:   [][]uint{{2, 3}, {4}},
+			actions:   []act.Action{a1, a0},
+			want:      [][]attacker{nil, nil, {{attackerIdx: 0, action: a1}}},
+		},
+		{
+			name:      "EmptyActions",
+			attackers: [][]attacker{nil, nil, nil},
+			targets:   [][]uint{{0, 1}, {1, 2}},
+			actions:   []act.Action{},
+			want:      [][]attacker{nil, nil, nil},
+		},
+		{
+			name:      "NilActions",
+			attackers: [][]attacker{nil, nil, nil},
+			targets:   [][]uint{{0, 1}, {1, 2}},
+			actions:   nil,
+			want:      [][]attacker{nil, nil, nil},
+		},
+		{
+			name:      "NoneActions",
+			attackers: [][]attacker{nil, nil, nil},
+			targets:   [][]uint{{0, 1}, {1, 2}},
+			actions:   []act.Action{a1, none},
+			want: [][]attacker{
+				{{attackerIdx: 0, action: a1}},
+				{{attackerIdx: 0, action: a1}},
+				nil,
+			},
+		},
+		{
+			name: "DirtyAttackersReset",
+			attackers: [][]attacker{
+				{{attackerIdx: 0, action: a0}},
+				{{attackerIdx: 1, action: a0}},
+				{{attackerIdx: 2, action: a0}},
+			},
+			targets: [][]uint{nil, {1}, nil},
+			actions: []act.Action{none, a0, none},
+			want:    [][]attacker{nil, {{attackerIdx: 1, action: a0}}, nil},
+		},
+		{
+			name: "DirtyAttackersResetWithInvalidInputs",
+			attackers: [][]attacker{
+				{{attackerIdx: 0, action: a0}},
+				{{attackerIdx: 1, action: a0}},
+				{{attackerIdx: 2, action: a0}},
+			},
+			targets: nil,
+			actions: nil,
+			want:    [][]attacker{nil, nil, nil},
+		},
+		{
+			name:      "TargetsAndActionsOfDifferentLength",
+			attackers: [][]attacker{nil, nil, nil},
+			targets:   [][]uint{{0, 1}, {1, 2}},
+			actions:   []act.Action{a0},
+			want:      [][]attacker{nil, nil, nil},
+		},
+		{
+			name:      "SupportsNotAssigned",
+			attackers: [][]attacker{nil, nil, nil},
+			targets:   [][]uint{{0}, {1}},
+			actions:   []act.Action{act.Use(0), a1},
+			want:      [][]attacker{nil, {{attackerIdx: 1, action: a1}}, nil},
+		},
+		{
+			name:      "AllAttackersAttack",
+			attackers: [][]attacker{nil, nil, nil},
+			targets:   [][]uint{{0}, {1}, {2}, {0}},
+			actions:   []act.Action{a0, a1, a2, a3},
+			want: [][]attacker{
+				{{attackerIdx: 0, action: a0}, {attackerIdx: 3, action: a3}},
+				{{attackerIdx: 1, action: a1}},
+				{{attackerIdx: 2, action: a2}},
+			},
+		},
+		{
+			name:      "SomeAttackersAttack",
+			attackers: [][]attacker{nil, nil, nil},
+			targets:   [][]uint{{0}, nil, nil, {0}},
+			actions:   []act.Action{a0, none, none, a3},
+			want: [][]attacker{
+				{{attackerIdx: 0, action: a0}, {attackerIdx: 3, action: a3}},
+				nil,
+				nil,
+			},
+		},
+		{
+			name:      "SomeAttackersAttackMultipleTargets",
+			attackers: [][]attacker{nil, nil, nil},
+			targets:   [][]uint{{0, 1}, {2}, {1, 2}, {0}},
+			actions:   []act.Action{a0, a1, a2, a3},
+			want: [][]attacker{
+				{{attackerIdx: 0, action: a0}, {attackerIdx: 3, action: a3}},
+				{{attackerIdx: 0, action: a0}, {attackerIdx: 2, action: a2}},
+				{{attackerIdx: 1, action: a1}, {attackerIdx: 2, action: a2}},
+			},
+		},
+		{
+			name:      "AllAttackersAttackMultipleTargets",
+			attackers: [][]attacker{nil, nil, nil},
+			targets:   [][]uint{{0, 1, 2}, {0, 1, 2}, {0, 1, 2}, {0, 1, 2}},
+			actions:   []act.Action{a0, a1, a2, a3},
+			want: [][]attacker{
+				{
+					{attackerIdx: 0, action: a0},
+					{attackerIdx: 1, action: a1},
+					{attackerIdx: 2, action: a2},
+					{attackerIdx: 3, action: a3},
+				},
+				{
+					{attackerIdx: 0, action: a0},
+					{attackerIdx: 1, action: a1},
+					{attackerIdx: 2, action: a2},
+					{attackerIdx: 3, action: a3},
+				},
+				{
+					{attackerIdx: 0, action: a0},
+					{attackerIdx: 1, action: a1},
+					{attackerIdx: 2, action: a2},
+					{attackerIdx: 3, action: a3},
+				},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assignAttackers(test.attackers, test.targets, test.actions)
+			if !slices.EqualFunc(test.attackers, test.want, slices.Equal) {
+				t.Fatalf(
+					"assignAttackers(): want %v, got %v", test.want, test.attackers,
+				)
+			}
+		})
+	}
+}
+
+func TestNoAttackersAssigned(t *testing.T) {
+	a0, a1, a2, a3 := act.Attack(0), act.Attack(1), act.Attack(2), act.Attack(3)
+	tests := []struct {
+		name      string
+		attackers [][]attacker
+		want      bool
+	}{
+		{
+			name:      "EmptyAttackers",
+			attackers: [][]attacker{},
+			want:      true,
+		},
+		{
+			name:      "NilAttackers",
+			attackers: nil,
+			want:      true,
+		},
+		{
+			name:      "AllAttackersEmpty",
+			attackers: [][]attacker{{}, {}, {}},
+			want:      true,
+		},
+		{
+			name:      "AllAttackersNil",
+			attackers: [][]attacker{nil, nil, nil},
+			want:      true,
+		},
+		{
+			name:      "SomeAttackersAssigned",
+			attackers: [][]attacker{{{attackerIdx: 0, action: a0}}, nil, {}},
+			want:      false,
+		},
+		{
+			name: "AllAttackersAssigned",
+			attackers: [][]attacker{
+				{{attackerIdx: 0, action: a0}, {attackerIdx: 1, action: a1}},
+				{{attackerIdx: 2, action: a2}},
+				{{attackerIdx: 3, action: a3}},
+			},
+			want: false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			initial := make([][]attacker, len(test.attackers))
+			for i, assigned := range test.attackers {
+				initial[i] = make([]attacker, len(assigned))
+				copy(initial[i], assigned)
+			}
+
+			if got := noAttackersAssigned(test.attackers); got != test.want {
+				t.Fatalf("noAttackersAssigned(): want %t, got %t", test.want, got)
+			}
+
+			if !slices.EqualFunc(test.attackers, initial, slices.Equal) {
+				t.Fatalf(
+					"noAttackersAssigned(): attackers mutated, want %v, got %v",
+					initial, test.attackers,
+				)
+			}
+		})
+	}
+}
+
+func TestResolveAttacks(t *testing.T) {
+	a0, a1, a2, a3 := act.Attack(0), act.Attack(1), act.Attack(2), act.Attack(3)
+	noCondition := atk.Condition{Kind: atk.ConditionNone, Rounds: 0}
+	// junk, none, and junkSpell fill the reusable buffer of used actions.
+	junk, none, junkSpell := act.Attack(42), act.None(), act.Spell(7, true)
+	poison := atk.Condition{Kind: atk.ConditionPoisoned, Rounds: 2}
+	spear := atk.Attack{
+		Name: "Spear", TargetCharacteristic: atk.STR,
+		Dice: dice.D6, DiceCnt: 1, Charges: -1,
+		IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone, PairedWith: 0,
+		Inflicts: atk.Condition{Kind: atk.ConditionNone, Rounds: 0}, Recharge: nil,
+		DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+		Area: atk.AreaZone, MaxTargets: 0,
+	}
+	fireball := spell.Book{
+		Name: "Fireball",
+		Effect: atk.Attack{
+			Name: "Fireball", TargetCharacteristic: atk.STR,
+			Dice: dice.D8, DiceCnt: 1, Charges: -1,
+			IsBlast: true, Pool: atk.PoolHighest, Traits: atk.TraitMagical,
+			PairedWith: 0, Inflicts: noCondition, Recharge: nil,
+			DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+			Area: atk.AreaZone, MaxTargets: 0,
+		},
+	}
+	player0 := creat.Creature{
+		ID: "player-0", Name: "John Appleseed", Attacks: []atk.Attack{spear},
+		STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+		IsDetachment: false, Conditions: nil,
+		Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil, Items: nil,
+		Zone: atk.ZoneEngaged,
+	}
+	// spearPlusOne is a d6+1 spear, clubMinFour is a d8 club with min 4, pike is
+	// usable only against detachments, and bow is a d6 ranged attack.
+	spearPlusOne, clubMinFour, pike, bow := spear, spear, spear, spear
+	spearPlusOne.DmgMod = 1
+	clubMinFour.Name, clubMinFour.Dice, clubMinFour.MinDmg = "Club", dice.D8, 4
+	pike.Name, pike.Usage = "Pike", atk.UsageVsDetachments
+	bow.Name, bow.Traits = "Bow", atk.TraitRanged
+	player1 := creat.Creature{
+		ID: "player-1", Name: "Jane Appleseed",
+		Attacks: []atk.Attack{spearPlusOne, clubMinFour, pike, bow}, Armor: 0,
+		STR: 8, DEX: 14, WIL: 8, HP: 4,
+		IsDetachment: false, Conditions: nil,
+		Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil, Items: nil,
+		Zone: atk.ZoneEngaged,
+	}
+	monster0 := creat.Creature{
+		ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
+		STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+		IsDetachment: false, Conditions: nil,
+		Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil, Items: nil,
+		Zone: atk.ZoneEngaged,
+	}
+	tests := []struct {
+		name                 string
+		damageToDefenders    []damage
+		attackers, defenders []creat.Creature
+		assignedAttackers    [][]attacker
+		usedActions          []act.Action
+		rng                  dice.RNG
+		wantDamage           []damage
+		wantAttackers        []creat.Creature
+	}{
+		{
+			name:              "EmptyDamageToDefenders",
+			damageToDefenders: []damage{},
+			attackers:         []creat.Creature{player0},
+			defenders:         []creat.Creature{monster0},
+			assignedAttackers: [][]attacker{{{attackerIdx: 0, action: a0}}},
+			usedActions:       []act.Action{junk},
+			rng:               dicetest.Max{},
+			wantDamage:        []damage{},
+			wantAttackers:     []creat.Creature{player0},
+		},
+		{
+			name:              "NilDamageToDefenders",
+			damageToDefenders: nil,
+			attackers:         []creat.Creature{player0},
+			defenders:         []creat.Creature{monster0},
+			assignedAttackers: [][]attacker{{{attackerIdx: 0, action: a0}}},
+			usedActions:       []act.Action{junk},
+			rng:               dicetest.Max{},
+			wantDamage:        nil,
+			wantAttackers:     []creat.Creature{player0},
+		},
+		{
+			name: "EmptyAttackers",
+			damageToDefenders: []damage{
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+			},
+			attackers:         []creat.Creature{},
+			defenders:         []creat.Creature{monster0},
+			assignedAttackers: [][]attacker{{{attackerIdx: 0, action: a0}}},
+			usedActions:       []act.Action{junk},
+			rng:               dicetest.Max{},
+			wantDamage: []damage{
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+			},
+			wantAttackers: []creat.Creature{},
+		},
+		{
+			name: "NilAttackers",
+			damageToDefenders: []damage{
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+			},
+			attackers:         nil,
+			defenders:         []creat.Creature{monster0},
+			assignedAttackers: [][]attacker{{{attackerIdx: 0, action: a0}}},
+			usedActions:       []act.Action{junk},
+			rng:               dicetest.Max{},
+			wantDamage: []damage{
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+			},
+			wantAttackers: nil,
+		},
+		{
+			name:              "EmptyDefenders",
+			damageToDefenders: []damage{},
+			attackers:         []creat.Creature{player0},
+			defenders:         []creat.Creature{},
+			assignedAttackers: [][]attacker{{{attackerIdx: 0, action: a0}}},
+			usedActions:       []act.Action{junk},
+			rng:               dicetest.Max{},
 			wantDamage:        []damage{},
 			wantAttackers:     []creat.Creature{player0},
 		},
 		{
-			name:              "NilDamageToDefenders",
-			damageToDefenders: nil,
+			name:              "NilDefenders",
+			damageToDefenders: []damage{},
+			attackers:         []creat.Creature{player0},
+			defenders:         nil,
+			assignedAttackers: [][]attacker{{{attackerIdx: 0, action: a0}}},
+			usedActions:       []act.Action{junk},
+			rng:               dicetest.Max{},
+			wantDamage:        []damage{},
+			wantAttackers:     []creat.Creature{player0},
+		},
+		{
+			name: "EmptyAssignedAttackers",
+			damageToDefenders: []damage{
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+			},
+			attackers:         []creat.Creature{player0},
+			defenders:         []creat.Creature{monster0},
+			assignedAttackers: [][]attacker{},
+			usedActions:       []act.Action{junk},
+			rng:               dicetest.Max{},
+			wantDamage: []damage{
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+			},
+			wantAttackers: []creat.Creature{player0},
+		},
+		{
+			name: "NilAssignedAttackers",
+			damageToDefenders: []damage{
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+			},
+			attackers:         []creat.Creature{player0},
+			defenders:         []creat.Creature{monster0},
+			assignedAttackers: nil,
+			usedActions:       []act.Action{junk},
+			rng:               dicetest.Max{},
+			wantDamage: []damage{
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+			},
+			wantAttackers: []creat.Creature{player0},
+		},
+		{
+			name: "AllAssignedAttackersEmpty",
+			damageToDefenders: []damage{
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+			},
+			attackers:         []creat.Creature{player0},
+			defenders:         []creat.Creature{monster0},
+			assignedAttackers: [][]attacker{{}},
+			usedActions:       []act.Action{junk},
+			rng:               dicetest.Max{},
+			wantDamage: []damage{
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+			},
+			wantAttackers: []creat.Creature{player0},
+		},
+		{
+			name: "AllAssignedAttackersNil",
+			damageToDefenders: []damage{
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+			},
+			attackers:         []creat.Creature{player0},
+			defenders:         []creat.Creature{monster0},
+			assignedAttackers: [][]attacker{nil},
+			usedActions:       []act.Action{junk},
+			rng:               dicetest.Max{},
+			wantDamage: []damage{
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+			},
+			wantAttackers: []creat.Creature{player0},
+		},
+		{
+			name: "EmptyUsedActions",
+			damageToDefenders: []damage{
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+			},
+			attackers:         []creat.Creature{player0},
+			defenders:         []creat.Creature{monster0},
+			assignedAttackers: [][]attacker{{{attackerIdx: 0, action: a0}}},
+			usedActions:       []act.Action{},
+			rng:               dicetest.Max{},
+			wantDamage: []damage{
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+			},
+			wantAttackers: []creat.Creature{player0},
+		},
+		{
+			name: "NilUsedActions",
+			damageToDefenders: []damage{
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+			},
+			attackers:         []creat.Creature{player0},
+			defenders:         []creat.Creature{monster0},
+			assignedAttackers: [][]attacker{{{attackerIdx: 0, action: a0}}},
+			usedActions:       nil,
+			rng:               dicetest.Max{},
+			wantDamage: []damage{
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+			},
+			wantAttackers: []creat.Creature{player0},
+		},
+		{
+			name: "NilRNG",
+			damageToDefenders: []damage{
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+			},
+			attackers:         []creat.Creature{player0},
+			defenders:         []creat.Creature{monster0},
+			assignedAttackers: [][]attacker{{{attackerIdx: 0, action: a0}}},
+			usedActions:       []act.Action{junk},
+			rng:               nil,
+			wantDamage: []damage{
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+			},
+			wantAttackers: []creat.Creature{player0},
+		},
+		{
+			name: "DamageToDefendersAndDefendersOfDifferentLength",
+			damageToDefenders: []damage{
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+			},
+			attackers:         []creat.Creature{player0},
+			defenders:         []creat.Creature{monster0},
+			assignedAttackers: [][]attacker{{{attackerIdx: 0, action: a0}}},
+			usedActions:       []act.Action{junk},
+			rng:               dicetest.Max{},
+			wantDamage: []damage{
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+			},
+			wantAttackers: []creat.Creature{player0},
+		},
+		{
+			name: "DefendersAndAssignedAttackersOfDifferentLength",
+			damageToDefenders: []damage{
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+			},
+			attackers: []creat.Creature{player0},
+			defenders: []creat.Creature{monster0},
+			assignedAttackers: [][]attacker{
+				{{attackerIdx: 0, action: a0}},
+				{{attackerIdx: 1, action: a0}},
+			},
+			usedActions: []act.Action{junk},
+			rng:         dicetest.Max{},
+			wantDamage: []damage{
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+			},
+			wantAttackers: []creat.Creature{player0},
+		},
+		{
+			name: "AttackersAndUsedActionsOfDifferentLength",
+			damageToDefenders: []damage{
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+			},
+			attackers:         []creat.Creature{player0},
+			defenders:         []creat.Creature{monster0},
+			assignedAttackers: [][]attacker{{{attackerIdx: 0, action: a0}}},
+			usedActions:       []act.Action{none, junk},
+			rng:               dicetest.Max{},
+			wantDamage: []damage{
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+			},
+			wantAttackers: []creat.Creature{player0},
+		},
+		{
+			name: "AssignedAttackersOut",
+			damageToDefenders: []damage{
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+			},
+			attackers: []creat.Creature{
+				{
+					ID: "player-0", Name: "John Appleseed", Attacks: []atk.Attack{spear},
+					STR: 0, DEX: 14, WIL: 8, HP: 0, Armor: 0,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
+				},
+				{
+					ID: "player-1", Name: "Jane Appleseed", Attacks: []atk.Attack{spear},
+					STR: 8, DEX: 0, WIL: 8, HP: 4, Armor: 0,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
+				},
+			},
+			defenders: []creat.Creature{monster0},
+			assignedAttackers: [][]attacker{{
+				{attackerIdx: 0, action: a0},
+				{attackerIdx: 1, action: a0},
+			}},
+			usedActions: []act.Action{junk, none},
+			rng:         dicetest.Max{},
+			wantDamage: []damage{
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+			},
+			wantAttackers: []creat.Creature{
+				{
+					ID: "player-0", Name: "John Appleseed", Attacks: []atk.Attack{spear},
+					STR: 0, DEX: 14, WIL: 8, HP: 0, Armor: 0,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
+				},
+				{
+					ID: "player-1", Name: "Jane Appleseed", Attacks: []atk.Attack{spear},
+					STR: 8, DEX: 0, WIL: 8, HP: 4, Armor: 0,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
+				},
+			},
+		},
+		{
+			name: "TargetedDefendersOut",
+			damageToDefenders: []damage{
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+			},
+			attackers: []creat.Creature{player0},
+			defenders: []creat.Creature{
+				{
+					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
+					STR: 0, DEX: 14, WIL: 8, HP: 0, Armor: 0,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
+				},
+			},
+			assignedAttackers: [][]attacker{{{attackerIdx: 0, action: a0}}},
+			usedActions:       []act.Action{junk},
+			rng:               dicetest.Max{},
+			wantDamage: []damage{
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+			},
+			wantAttackers: []creat.Creature{player0},
+		},
+		{
+			name: "InvalidAttackerIndexes",
+			damageToDefenders: []damage{
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+			},
+			attackers:         []creat.Creature{player0},
+			defenders:         []creat.Creature{monster0},
+			assignedAttackers: [][]attacker{{{attackerIdx: 1, action: a0}}},
+			usedActions:       []act.Action{junk},
+			rng:               dicetest.Max{},
+			wantDamage: []damage{
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+			},
+			wantAttackers: []creat.Creature{player0},
+		},
+		{
+			name: "InvalidAttackIndexes",
+			damageToDefenders: []damage{
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+			},
+			attackers:         []creat.Creature{player0},
+			defenders:         []creat.Creature{monster0},
+			assignedAttackers: [][]attacker{{{attackerIdx: 0, action: a1}}},
+			usedActions:       []act.Action{junk},
+			rng:               dicetest.Max{},
+			wantDamage: []damage{
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+			},
+			wantAttackers: []creat.Creature{player0},
+		},
+		{
+			name: "AttackWithNoCharges",
+			damageToDefenders: []damage{
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+			},
+			attackers: []creat.Creature{
+				{
+					ID: "player-0", Name: "John Appleseed",
+					Attacks: []atk.Attack{
+						{
+							Name: "Fireball", TargetCharacteristic: atk.STR,
+							Dice: dice.D6, DiceCnt: 1, Charges: 0,
+							IsBlast: true, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+							PairedWith: 0,
+							Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+							Recharge:   nil,
+							DmgMod:     0,
+							MinDmg:     0,
+							Usage:      atk.UsageAlways,
+							Area:       atk.AreaZone,
+							MaxTargets: 0,
+						},
+					},
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
+				},
+			},
+			defenders:         []creat.Creature{monster0},
+			assignedAttackers: [][]attacker{{{attackerIdx: 0, action: a0}}},
+			usedActions:       []act.Action{junk},
+			rng:               dicetest.Max{},
+			wantDamage: []damage{
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+			},
+			wantAttackers: []creat.Creature{
+				{
+					ID: "player-0", Name: "John Appleseed",
+					Attacks: []atk.Attack{
+						{
+							Name: "Fireball", TargetCharacteristic: atk.STR,
+							Dice: dice.D6, DiceCnt: 1, Charges: 0,
+							IsBlast: true, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+							PairedWith: 0,
+							Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+							Recharge:   nil,
+							DmgMod:     0,
+							MinDmg:     0,
+							Usage:      atk.UsageAlways,
+							Area:       atk.AreaZone,
+							MaxTargets: 0,
+						},
+					},
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
+				},
+			},
+		},
+		{
+			name: "DirtyDamageToDefendersReset",
+			damageToDefenders: []damage{
+				{characteristic: atk.DEX, value: 6, inflicts: noCondition},
+			},
 			attackers:         []creat.Creature{player0},
 			defenders:         []creat.Creature{monster0},
-			assignedAttackers: [][]attacker{{{attackerIdx: 0, attackIdx: 0}}},
-			usedAttackIdxs:    []int{42},
-			rng:               maxRNG{},
-			wantDamage:        nil,
-			wantAttackers:     []creat.Creature{player0},
+			assignedAttackers: [][]attacker{},
+			usedActions:       []act.Action{junk},
+			rng:               dicetest.Max{},
+			wantDamage: []damage{
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+			},
+			wantAttackers: []creat.Creature{player0},
 		},
 		{
-			name:              "EmptyAttackers",
-			damageToDefenders: []damage{{characteristic: atk.STR, value: 0}},
-			attackers:         []creat.Creature{},
-			defenders:         []creat.Creature{monster0},
-			assignedAttackers: [][]attacker{{{attackerIdx: 0, attackIdx: 0}}},
-			usedAttackIdxs:    []int{42},
-			rng:               maxRNG{},
-			wantDamage:        []damage{{characteristic: atk.STR, value: 0}},
-			wantAttackers:     []creat.Creature{},
+			name: "AttackCannotPenetrateArmor",
+			damageToDefenders: []damage{
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+			},
+			attackers: []creat.Creature{player0},
+			defenders: []creat.Creature{
+				{
+					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 3,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
+				},
+			},
+			assignedAttackers: [][]attacker{{{attackerIdx: 0, action: a0}}},
+			usedActions:       []act.Action{junk},
+			rng:               dicetest.NewSequence(t, 3),
+			wantDamage: []damage{
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+			},
+			wantAttackers: []creat.Creature{player0},
 		},
 		{
-			name:              "NilAttackers",
-			damageToDefenders: []damage{{characteristic: atk.STR, value: 0}},
-			attackers:         nil,
-			defenders:         []creat.Creature{monster0},
-			assignedAttackers: [][]attacker{{{attackerIdx: 0, attackIdx: 0}}},
-			usedAttackIdxs:    []int{42},
-			rng:               maxRNG{},
-			wantDamage:        []damage{{characteristic: atk.STR, value: 0}},
-			wantAttackers:     nil,
+			name: "DmgModAppliedBeforeArmor",
+			damageToDefenders: []damage{
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+			},
+			attackers: []creat.Creature{player1},
+			defenders: []creat.Creature{
+				{
+					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 3,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
+				},
+			},
+			assignedAttackers: [][]attacker{{{attackerIdx: 0, action: a0}}},
+			usedActions:       []act.Action{junk},
+			rng:               dicetest.NewSequence(t, 3),
+			wantDamage: []damage{
+				{characteristic: atk.STR, value: 1, inflicts: noCondition},
+			},
+			wantAttackers: []creat.Creature{player1},
 		},
 		{
-			name:              "EmptyDefenders",
-			damageToDefenders: []damage{},
-			attackers:         []creat.Creature{player0},
-			defenders:         []creat.Creature{},
-			assignedAttackers: [][]attacker{{{attackerIdx: 0, attackIdx: 0}}},
-			usedAttackIdxs:    []int{42},
-			rng:               maxRNG{},
-			wantDamage:        []damage{},
-			wantAttackers:     []creat.Creature{player0},
+			name: "MinDmgAppliedBeforeArmor",
+			damageToDefenders: []damage{
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+			},
+			attackers: []creat.Creature{player1},
+			defenders: []creat.Creature{
+				{
+					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 3,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
+				},
+			},
+			assignedAttackers: [][]attacker{{{attackerIdx: 0, action: a1}}},
+			usedActions:       []act.Action{junk},
+			rng:               dicetest.NewSequence(t, 1),
+			wantDamage: []damage{
+				{characteristic: atk.STR, value: 1, inflicts: noCondition},
+			},
+			wantAttackers: []creat.Creature{player1},
 		},
 		{
-			name:              "NilDefenders",
-			damageToDefenders: []damage{},
-			attackers:         []creat.Creature{player0},
-			defenders:         nil,
-			assignedAttackers: [][]attacker{{{attackerIdx: 0, attackIdx: 0}}},
-			usedAttackIdxs:    []int{42},
-			rng:               maxRNG{},
-			wantDamage:        []damage{},
-			wantAttackers:     []creat.Creature{player0},
+			name: "DmgModAppliedToImpairedDice",
+			damageToDefenders: []damage{
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+			},
+			attackers: []creat.Creature{player1},
+			defenders: []creat.Creature{
+				{
+					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+					IsDetachment: true, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
+				},
+			},
+			assignedAttackers: [][]attacker{{{attackerIdx: 0, action: a0}}},
+			usedActions:       []act.Action{junk},
+			rng:               dicetest.NewSequence(t, 4),
+			wantDamage: []damage{
+				{characteristic: atk.STR, value: 5, inflicts: noCondition},
+			},
+			wantAttackers: []creat.Creature{player1},
 		},
 		{
-			name:              "EmptyAssignedAttackers",
-			damageToDefenders: []damage{{characteristic: atk.STR, value: 0}},
-			attackers:         []creat.Creature{player0},
+			name: "AttackVsDetachmentsIgnoresIndividuals",
+			damageToDefenders: []damage{
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+			},
+			attackers:         []creat.Creature{player1},
 			defenders:         []creat.Creature{monster0},
-			assignedAttackers: [][]attacker{},
-			usedAttackIdxs:    []int{42},
-			rng:               maxRNG{},
-			wantDamage:        []damage{{characteristic: atk.STR, value: 0}},
-			wantAttackers:     []creat.Creature{player0},
+			assignedAttackers: [][]attacker{{{attackerIdx: 0, action: a2}}},
+			usedActions:       []act.Action{junk},
+			rng:               dicetest.Max{},
+			wantDamage: []damage{
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+			},
+			wantAttackers: []creat.Creature{player1},
 		},
 		{
-			name:              "NilAssignedAttackers",
-			damageToDefenders: []damage{{characteristic: atk.STR, value: 0}},
-			attackers:         []creat.Creature{player0},
+			name: "MeleeAttackDoesNotReachNearDefender",
+			damageToDefenders: []damage{
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+			},
+			attackers: []creat.Creature{player1},
+			defenders: []creat.Creature{
+				{
+					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+					IsDetachment: false, Conditions: nil, Zone: atk.ZoneNear,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil,
+				},
+			},
+			assignedAttackers: [][]attacker{{{attackerIdx: 0, action: a0}}},
+			usedActions:       []act.Action{junk},
+			rng:               dicetest.Max{},
+			wantDamage: []damage{
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+			},
+			wantAttackers: []creat.Creature{player1},
+		},
+		{
+			name: "RangedAttackOfEngagedAttackerIsImpaired",
+			damageToDefenders: []damage{
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+			},
+			attackers: []creat.Creature{player1},
+			defenders: []creat.Creature{
+				{
+					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+					IsDetachment: false, Conditions: nil, Zone: atk.ZoneFar,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil,
+				},
+			},
+			assignedAttackers: [][]attacker{{{attackerIdx: 0, action: a3}}},
+			usedActions:       []act.Action{junk},
+			rng:               dicetest.Max{},
+			wantDamage: []damage{
+				{characteristic: atk.STR, value: 4, inflicts: noCondition},
+			},
+			wantAttackers: []creat.Creature{player1},
+		},
+		{
+			name: "ArmorPiercingAttackIgnoresArmor",
+			damageToDefenders: []damage{
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+			},
+			attackers: []creat.Creature{
+				{
+					ID: "player-0", Name: "John Appleseed",
+					Attacks: []atk.Attack{
+						{
+							Name: "Crossbow", TargetCharacteristic: atk.STR,
+							Dice: dice.D8, DiceCnt: 1, Charges: -1,
+							IsBlast: false, Pool: atk.PoolHighest,
+							Traits: atk.TraitArmorPiercing | atk.TraitRanged, PairedWith: 0,
+							Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+							Recharge:   nil,
+							DmgMod:     0,
+							MinDmg:     0,
+							Usage:      atk.UsageAlways,
+							Area:       atk.AreaZone,
+							MaxTargets: 0,
+						},
+					},
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneNear,
+				},
+			},
+			defenders: []creat.Creature{
+				{
+					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 3,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
+				},
+			},
+			assignedAttackers: [][]attacker{{{attackerIdx: 0, action: a0}}},
+			usedActions:       []act.Action{junk},
+			rng:               dicetest.NewSequence(t, 5),
+			wantDamage: []damage{
+				{characteristic: atk.STR, value: 5, inflicts: noCondition},
+			},
+			wantAttackers: []creat.Creature{
+				{
+					ID: "player-0", Name: "John Appleseed",
+					Attacks: []atk.Attack{
+						{
+							Name: "Crossbow", TargetCharacteristic: atk.STR,
+							Dice: dice.D8, DiceCnt: 1, Charges: -1,
+							IsBlast: false, Pool: atk.PoolHighest,
+							Traits: atk.TraitArmorPiercing | atk.TraitRanged, PairedWith: 0,
+							Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+							Recharge:   nil,
+							DmgMod:     0,
+							MinDmg:     0,
+							Usage:      atk.UsageAlways,
+							Area:       atk.AreaZone,
+							MaxTargets: 0,
+						},
+					},
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneNear,
+				},
+			},
+		},
+		{
+			name: "ArmorAppliesIfHighestDamageIsNotArmorPiercing",
+			damageToDefenders: []damage{
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+			},
+			attackers: []creat.Creature{
+				{
+					ID: "player-0", Name: "John Appleseed",
+					Attacks: []atk.Attack{
+						{
+							Name: "Crossbow", TargetCharacteristic: atk.STR,
+							Dice: dice.D8, DiceCnt: 1, Charges: -1,
+							IsBlast: false, Pool: atk.PoolHighest,
+							Traits: atk.TraitArmorPiercing | atk.TraitRanged, PairedWith: 0,
+							Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+							Recharge:   nil,
+							DmgMod:     0,
+							MinDmg:     0,
+							Usage:      atk.UsageAlways,
+							Area:       atk.AreaZone,
+							MaxTargets: 0,
+						},
+					},
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneNear,
+				},
+				player0,
+			},
+			defenders: []creat.Creature{
+				{
+					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 1,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
+				},
+			},
+			assignedAttackers: [][]attacker{{
+				{attackerIdx: 0, action: a0},
+				{attackerIdx: 1, action: a0},
+			}},
+			usedActions: []act.Action{junk, junk},
+			rng:         dicetest.NewSequence(t, 2, 6),
+			wantDamage: []damage{
+				{characteristic: atk.STR, value: 5, inflicts: noCondition},
+			},
+			wantAttackers: []creat.Creature{
+				{
+					ID: "player-0", Name: "John Appleseed",
+					Attacks: []atk.Attack{
+						{
+							Name: "Crossbow", TargetCharacteristic: atk.STR,
+							Dice: dice.D8, DiceCnt: 1, Charges: -1,
+							IsBlast: false, Pool: atk.PoolHighest,
+							Traits: atk.TraitArmorPiercing | atk.TraitRanged, PairedWith: 0,
+							Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+							Recharge:   nil,
+							DmgMod:     0,
+							MinDmg:     0,
+							Usage:      atk.UsageAlways,
+							Area:       atk.AreaZone,
+							MaxTargets: 0,
+						},
+					},
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneNear,
+				},
+				player0,
+			},
+		},
+		{
+			name: "PairedAttackKeepsHighestAndConsumesBothCharges",
+			damageToDefenders: []damage{
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+			},
+			attackers: []creat.Creature{
+				{
+					ID: "player-0", Name: "John Appleseed",
+					Attacks: []atk.Attack{
+						{
+							Name: "Sword", TargetCharacteristic: atk.STR,
+							Dice: dice.D8, DiceCnt: 1, Charges: -1,
+							IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+							PairedWith: 2,
+							Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+							Recharge:   nil,
+							DmgMod:     0,
+							MinDmg:     0,
+							Usage:      atk.UsageAlways,
+							Area:       atk.AreaZone,
+							MaxTargets: 0,
+						},
+						{
+							Name: "Throwing Knife", TargetCharacteristic: atk.STR,
+							Dice: dice.D4, DiceCnt: 1, Charges: 2,
+							IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+							PairedWith: 0,
+							Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+							Recharge:   nil,
+							DmgMod:     0,
+							MinDmg:     0,
+							Usage:      atk.UsageAlways,
+							Area:       atk.AreaZone,
+							MaxTargets: 0,
+						},
+					},
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
+				},
+			},
 			defenders:         []creat.Creature{monster0},
-			assignedAttackers: nil,
-			usedAttackIdxs:    []int{42},
-			rng:               maxRNG{},
-			wantDamage:        []damage{{characteristic: atk.STR, value: 0}},
-			wantAttackers:     []creat.Creature{player0},
+			assignedAttackers: [][]attacker{{{attackerIdx: 0, action: a0}}},
+			usedActions:       []act.Action{junk},
+			rng:               dicetest.NewSequence(t, 3, 4),
+			wantDamage: []damage{
+				{characteristic: atk.STR, value: 4, inflicts: noCondition},
+			},
+			wantAttackers: []creat.Creature{
+				{
+					ID: "player-0", Name: "John Appleseed",
+					Attacks: []atk.Attack{
+						{
+							Name: "Sword", TargetCharacteristic: atk.STR,
+							Dice: dice.D8, DiceCnt: 1, Charges: -1,
+							IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+							PairedWith: 2,
+							Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+							Recharge:   nil,
+							DmgMod:     0,
+							MinDmg:     0,
+							Usage:      atk.UsageAlways,
+							Area:       atk.AreaZone,
+							MaxTargets: 0,
+						},
+						{
+							Name: "Throwing Knife", TargetCharacteristic: atk.STR,
+							Dice: dice.D4, DiceCnt: 1, Charges: 1,
+							IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+							PairedWith: 0,
+							Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+							Recharge:   nil,
+							DmgMod:     0,
+							MinDmg:     0,
+							Usage:      atk.UsageAlways,
+							Area:       atk.AreaZone,
+							MaxTargets: 0,
+						},
+					},
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
+				},
+			},
 		},
 		{
-			name:              "AllAssignedAttackersEmpty",
-			damageToDefenders: []damage{{characteristic: atk.STR, value: 0}},
-			attackers:         []creat.Creature{player0},
+			name: "PairedSumPoolAttackKeepsHighest",
+			damageToDefenders: []damage{
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+			},
+			attackers: []creat.Creature{
+				{
+					ID: "player-0", Name: "John Appleseed",
+					Attacks: []atk.Attack{
+						{
+							Name: "Sword", TargetCharacteristic: atk.STR,
+							Dice: dice.D8, DiceCnt: 1, Charges: -1,
+							IsBlast: false, Pool: atk.PoolSum, Traits: atk.TraitNone,
+							PairedWith: 2,
+							Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+							Recharge:   nil,
+							DmgMod:     0,
+							MinDmg:     0,
+							Usage:      atk.UsageAlways,
+							Area:       atk.AreaZone,
+							MaxTargets: 0,
+						},
+						{
+							Name: "Throwing Knife", TargetCharacteristic: atk.STR,
+							Dice: dice.D4, DiceCnt: 1, Charges: 2,
+							IsBlast: false, Pool: atk.PoolSum, Traits: atk.TraitNone,
+							PairedWith: 0,
+							Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+							Recharge:   nil,
+							DmgMod:     0,
+							MinDmg:     0,
+							Usage:      atk.UsageAlways,
+							Area:       atk.AreaZone,
+							MaxTargets: 0,
+						},
+					},
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
+				},
+			},
 			defenders:         []creat.Creature{monster0},
-			assignedAttackers: [][]attacker{{}},
-			usedAttackIdxs:    []int{42},
-			rng:               maxRNG{},
-			wantDamage:        []damage{{characteristic: atk.STR, value: 0}},
-			wantAttackers:     []creat.Creature{player0},
+			assignedAttackers: [][]attacker{{{attackerIdx: 0, action: a0}}},
+			usedActions:       []act.Action{junk},
+			rng:               dicetest.NewSequence(t, 3, 4),
+			wantDamage: []damage{
+				{characteristic: atk.STR, value: 4, inflicts: noCondition},
+			},
+			wantAttackers: []creat.Creature{
+				{
+					ID: "player-0", Name: "John Appleseed",
+					Attacks: []atk.Attack{
+						{
+							Name: "Sword", TargetCharacteristic: atk.STR,
+							Dice: dice.D8, DiceCnt: 1, Charges: -1,
+							IsBlast: false, Pool: atk.PoolSum, Traits: atk.TraitNone,
+							PairedWith: 2,
+							Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+							Recharge:   nil,
+							DmgMod:     0,
+							MinDmg:     0,
+							Usage:      atk.UsageAlways,
+							Area:       atk.AreaZone,
+							MaxTargets: 0,
+						},
+						{
+							Name: "Throwing Knife", TargetCharacteristic: atk.STR,
+							Dice: dice.D4, DiceCnt: 1, Charges: 1,
+							IsBlast: false, Pool: atk.PoolSum, Traits: atk.TraitNone,
+							PairedWith: 0,
+							Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+							Recharge:   nil,
+							DmgMod:     0,
+							MinDmg:     0,
+							Usage:      atk.UsageAlways,
+							Area:       atk.AreaZone,
+							MaxTargets: 0,
+						},
+					},
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
+				},
+			},
 		},
 		{
-			name:              "AllAssignedAttackersNil",
-			damageToDefenders: []damage{{characteristic: atk.STR, value: 0}},
-			attackers:         []creat.Creature{player0},
+			name: "PairedAttackWithNoChargesIsNotRolled",
+			damageToDefenders: []damage{
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+			},
+			attackers: []creat.Creature{
+				{
+					ID: "player-0", Name: "John Appleseed",
+					Attacks: []atk.Attack{
+						{
+							Name: "Sword", TargetCharacteristic: atk.STR,
+							Dice: dice.D8, DiceCnt: 1, Charges: -1,
+							IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+							PairedWith: 2,
+							Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+							Recharge:   nil,
+							DmgMod:     0,
+							MinDmg:     0,
+							Usage:      atk.UsageAlways,
+							Area:       atk.AreaZone,
+							MaxTargets: 0,
+						},
+						{
+							Name: "Throwing Knife", TargetCharacteristic: atk.STR,
+							Dice: dice.D4, DiceCnt: 1, Charges: 0,
+							IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+							PairedWith: 0,
+							Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+							Recharge:   nil,
+							DmgMod:     0,
+							MinDmg:     0,
+							Usage:      atk.UsageAlways,
+							Area:       atk.AreaZone,
+							MaxTargets: 0,
+						},
+					},
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
+				},
+			},
 			defenders:         []creat.Creature{monster0},
-			assignedAttackers: [][]attacker{nil},
-			usedAttackIdxs:    []int{42},
-			rng:               maxRNG{},
-			wantDamage:        []damage{{characteristic: atk.STR, value: 0}},
-			wantAttackers:     []creat.Creature{player0},
+			assignedAttackers: [][]attacker{{{attackerIdx: 0, action: a0}}},
+			usedActions:       []act.Action{junk},
+			rng:               dicetest.NewSequence(t, 3),
+			wantDamage: []damage{
+				{characteristic: atk.STR, value: 3, inflicts: noCondition},
+			},
+			wantAttackers: []creat.Creature{
+				{
+					ID: "player-0", Name: "John Appleseed",
+					Attacks: []atk.Attack{
+						{
+							Name: "Sword", TargetCharacteristic: atk.STR,
+							Dice: dice.D8, DiceCnt: 1, Charges: -1,
+							IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+							PairedWith: 2,
+							Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+							Recharge:   nil,
+							DmgMod:     0,
+							MinDmg:     0,
+							Usage:      atk.UsageAlways,
+							Area:       atk.AreaZone,
+							MaxTargets: 0,
+						},
+						{
+							Name: "Throwing Knife", TargetCharacteristic: atk.STR,
+							Dice: dice.D4, DiceCnt: 1, Charges: 0,
+							IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+							PairedWith: 0,
+							Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+							Recharge:   nil,
+							DmgMod:     0,
+							MinDmg:     0,
+							Usage:      atk.UsageAlways,
+							Area:       atk.AreaZone,
+							MaxTargets: 0,
+						},
+					},
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
+				},
+			},
 		},
 		{
-			name:              "EmptyUsedAttackIndexes",
-			damageToDefenders: []damage{{characteristic: atk.STR, value: 0}},
-			attackers:         []creat.Creature{player0},
+			name: "FrightenedAttackerIsImpaired",
+			damageToDefenders: []damage{
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+			},
+			attackers: []creat.Creature{
+				{
+					ID: "player-0", Name: "John Appleseed",
+					Attacks: []atk.Attack{
+						{
+							Name: "Sword", TargetCharacteristic: atk.STR,
+							Dice: dice.D8, DiceCnt: 1, Charges: -1,
+							IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+							PairedWith: 0, Inflicts: noCondition, Recharge: nil,
+							DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+							Area: atk.AreaZone, MaxTargets: 0,
+						},
+					},
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+					IsDetachment: false, Spellbooks: nil, Fatigue: 0, IsDeprived: false,
+					Supports: nil, Items: nil,
+					Zone: atk.ZoneEngaged,
+					Conditions: []atk.Condition{
+						{Kind: atk.ConditionFrightened, Rounds: 1},
+					},
+				},
+			},
 			defenders:         []creat.Creature{monster0},
-			assignedAttackers: [][]attacker{{{attackerIdx: 0, attackIdx: 0}}},
-			usedAttackIdxs:    []int{},
-			rng:               maxRNG{},
-			wantDamage:        []damage{{characteristic: atk.STR, value: 0}},
-			wantAttackers:     []creat.Creature{player0},
+			assignedAttackers: [][]attacker{{{attackerIdx: 0, action: a0}}},
+			usedActions:       []act.Action{junk},
+			rng:               dicetest.Max{},
+			wantDamage: []damage{
+				{characteristic: atk.STR, value: 4, inflicts: noCondition},
+			},
+			wantAttackers: []creat.Creature{
+				{
+					ID: "player-0", Name: "John Appleseed",
+					Attacks: []atk.Attack{
+						{
+							Name: "Sword", TargetCharacteristic: atk.STR,
+							Dice: dice.D8, DiceCnt: 1, Charges: -1,
+							IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+							PairedWith: 0, Inflicts: noCondition, Recharge: nil,
+							DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+							Area: atk.AreaZone, MaxTargets: 0,
+						},
+					},
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+					IsDetachment: false, Spellbooks: nil, Fatigue: 0, IsDeprived: false,
+					Supports: nil, Items: nil,
+					Zone: atk.ZoneEngaged,
+					Conditions: []atk.Condition{
+						{Kind: atk.ConditionFrightened, Rounds: 1},
+					},
+				},
+			},
 		},
 		{
-			name:              "NilUsedAttackIndexes",
-			damageToDefenders: []damage{{characteristic: atk.STR, value: 0}},
-			attackers:         []creat.Creature{player0},
+			name: "EnhancedAttackerRollsD12",
+			damageToDefenders: []damage{
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+			},
+			attackers: []creat.Creature{
+				{
+					ID: "player-0", Name: "John Appleseed", Attacks: []atk.Attack{spear},
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+					IsDetachment: false, Spellbooks: nil, Supports: nil, Items: nil,
+					Zone:    atk.ZoneEngaged,
+					Fatigue: 0, IsDeprived: false,
+					Conditions: []atk.Condition{
+						{Kind: atk.ConditionEnhanced, Rounds: 1},
+					},
+				},
+			},
 			defenders:         []creat.Creature{monster0},
-			assignedAttackers: [][]attacker{{{attackerIdx: 0, attackIdx: 0}}},
-			usedAttackIdxs:    nil,
-			rng:               maxRNG{},
-			wantDamage:        []damage{{characteristic: atk.STR, value: 0}},
-			wantAttackers:     []creat.Creature{player0},
+			assignedAttackers: [][]attacker{{{attackerIdx: 0, action: a0}}},
+			usedActions:       []act.Action{junk},
+			rng:               dicetest.Max{},
+			wantDamage: []damage{
+				{characteristic: atk.STR, value: 12, inflicts: noCondition},
+			},
+			wantAttackers: []creat.Creature{
+				{
+					ID: "player-0", Name: "John Appleseed", Attacks: []atk.Attack{spear},
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+					IsDetachment: false, Spellbooks: nil, Supports: nil, Items: nil,
+					Zone:    atk.ZoneEngaged,
+					Fatigue: 0, IsDeprived: false,
+					Conditions: []atk.Condition{
+						{Kind: atk.ConditionEnhanced, Rounds: 1},
+					},
+				},
+			},
 		},
 		{
-			name:              "NilRNG",
-			damageToDefenders: []damage{{characteristic: atk.STR, value: 0}},
-			attackers:         []creat.Creature{player0},
+			name: "EnhancedAndFrightenedAttackerRollsAsUsual",
+			damageToDefenders: []damage{
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+			},
+			attackers: []creat.Creature{
+				{
+					ID: "player-0", Name: "John Appleseed", Attacks: []atk.Attack{spear},
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+					IsDetachment: false, Spellbooks: nil, Supports: nil, Items: nil,
+					Zone:    atk.ZoneEngaged,
+					Fatigue: 0, IsDeprived: false,
+					Conditions: []atk.Condition{
+						{Kind: atk.ConditionEnhanced, Rounds: 1},
+						{Kind: atk.ConditionFrightened, Rounds: 1},
+					},
+				},
+			},
 			defenders:         []creat.Creature{monster0},
-			assignedAttackers: [][]attacker{{{attackerIdx: 0, attackIdx: 0}}},
-			usedAttackIdxs:    []int{42},
-			rng:               nil,
-			wantDamage:        []damage{{characteristic: atk.STR, value: 0}},
-			wantAttackers:     []creat.Creature{player0},
+			assignedAttackers: [][]attacker{{{attackerIdx: 0, action: a0}}},
+			usedActions:       []act.Action{junk},
+			rng:               dicetest.Max{},
+			wantDamage: []damage{
+				{characteristic: atk.STR, value: 6, inflicts: noCondition},
+			},
+			wantAttackers: []creat.Creature{
+				{
+					ID: "player-0", Name: "John Appleseed", Attacks: []atk.Attack{spear},
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+					IsDetachment: false, Spellbooks: nil, Supports: nil, Items: nil,
+					Zone:    atk.ZoneEngaged,
+					Fatigue: 0, IsDeprived: false,
+					Conditions: []atk.Condition{
+						{Kind: atk.ConditionEnhanced, Rounds: 1},
+						{Kind: atk.ConditionFrightened, Rounds: 1},
+					},
+				},
+			},
 		},
 		{
-			name: "DamageToDefendersAndDefendersOfDifferentLength",
+			name: "ProtectedDefenderHasExtraArmor",
 			damageToDefenders: []damage{
-				{characteristic: atk.STR, value: 0},
-				{characteristic: atk.STR, value: 0},
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
 			},
-			attackers:         []creat.Creature{player0},
-			defenders:         []creat.Creature{monster0},
-			assignedAttackers: [][]attacker{{{attackerIdx: 0, attackIdx: 0}}},
-			usedAttackIdxs:    []int{42},
-			rng:               maxRNG{},
+			attackers: []creat.Creature{player0},
+			defenders: []creat.Creature{
+				{
+					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 1,
+					IsDetachment: false, Spellbooks: nil, Supports: nil, Items: nil,
+					Zone:    atk.ZoneEngaged,
+					Fatigue: 0, IsDeprived: false,
+					Conditions: []atk.Condition{
+						{Kind: atk.ConditionProtected, Rounds: 1},
+					},
+				},
+			},
+			assignedAttackers: [][]attacker{{{attackerIdx: 0, action: a0}}},
+			usedActions:       []act.Action{junk},
+			rng:               dicetest.Max{},
 			wantDamage: []damage{
-				{characteristic: atk.STR, value: 0},
-				{characteristic: atk.STR, value: 0},
+				{characteristic: atk.STR, value: 4, inflicts: noCondition},
 			},
 			wantAttackers: []creat.Creature{player0},
 		},
 		{
-			name:              "DefendersAndAssignedAttackersOfDifferentLength",
-			damageToDefenders: []damage{{characteristic: atk.STR, value: 0}},
-			attackers:         []creat.Creature{player0},
-			defenders:         []creat.Creature{monster0},
-			assignedAttackers: [][]attacker{
-				{{attackerIdx: 0, attackIdx: 0}},
-				{{attackerIdx: 1, attackIdx: 0}},
+			name: "BlindedAttackerIsImpaired",
+			damageToDefenders: []damage{
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+			},
+			attackers: []creat.Creature{
+				{
+					ID: "player-0", Name: "John Appleseed",
+					Attacks: []atk.Attack{
+						{
+							Name: "Sword", TargetCharacteristic: atk.STR,
+							Dice: dice.D8, DiceCnt: 1, Charges: -1,
+							IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+							PairedWith: 0, Inflicts: noCondition, Recharge: nil,
+							DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+							Area: atk.AreaZone, MaxTargets: 0,
+						},
+					},
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+					IsDetachment: false, Spellbooks: nil, Fatigue: 0, IsDeprived: false,
+					Supports: nil, Items: nil,
+					Zone: atk.ZoneEngaged,
+					Conditions: []atk.Condition{
+						{Kind: atk.ConditionBlinded, Rounds: -1},
+					},
+				},
 			},
-			usedAttackIdxs: []int{42},
-			rng:            maxRNG{},
-			wantDamage:     []damage{{characteristic: atk.STR, value: 0}},
-			wantAttackers:  []creat.Creature{player0},
-		},
-		{
-			name:              "AttackersAndUsedAttackIndexesOfDifferentLength",
-			damageToDefenders: []damage{{characteristic: atk.STR, value: 0}},
-			attackers:         []creat.Creature{player0},
 			defenders:         []creat.Creature{monster0},
-			assignedAttackers: [][]attacker{{{attackerIdx: 0, attackIdx: 0}}},
-			usedAttackIdxs:    []int{-123456, 123455},
-			rng:               maxRNG{},
-			wantDamage:        []damage{{characteristic: atk.STR, value: 0}},
-			wantAttackers:     []creat.Creature{player0},
+			assignedAttackers: [][]attacker{{{attackerIdx: 0, action: a0}}},
+			usedActions:       []act.Action{junk},
+			rng:               dicetest.Max{},
+			wantDamage: []damage{
+				{characteristic: atk.STR, value: 4, inflicts: noCondition},
+			},
+			wantAttackers: []creat.Creature{
+				{
+					ID: "player-0", Name: "John Appleseed",
+					Attacks: []atk.Attack{
+						{
+							Name: "Sword", TargetCharacteristic: atk.STR,
+							Dice: dice.D8, DiceCnt: 1, Charges: -1,
+							IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+							PairedWith: 0, Inflicts: noCondition, Recharge: nil,
+							DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+							Area: atk.AreaZone, MaxTargets: 0,
+						},
+					},
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+					IsDetachment: false, Spellbooks: nil, Fatigue: 0, IsDeprived: false,
+					Supports: nil, Items: nil,
+					Zone: atk.ZoneEngaged,
+					Conditions: []atk.Condition{
+						{Kind: atk.ConditionBlinded, Rounds: -1},
+					},
+				},
+			},
 		},
 		{
-			name:              "AssignedAttackersOut",
-			damageToDefenders: []damage{{characteristic: atk.STR, value: 0}},
+			name: "ConditionOfMaxDamageAttackIsInflicted",
+			damageToDefenders: []damage{
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+			},
 			attackers: []creat.Creature{
 				{
 					ID: "player-0", Name: "John Appleseed", Attacks: []atk.Attack{spear},
-					STR: 0, DEX: 14, WIL: 8, HP: 0, Armor: 0,
-					IsDetachment: false,
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
-					ID: "player-1", Name: "Jane Appleseed", Attacks: []atk.Attack{spear},
-					STR: 8, DEX: 0, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					ID: "player-1", Name: "Jane Appleseed",
+					Attacks: []atk.Attack{
+						{
+							Name: "Venom Fang", TargetCharacteristic: atk.STR,
+							Dice: dice.D4, DiceCnt: 1, Charges: -1,
+							IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+							PairedWith: 0, Inflicts: poison, Recharge: nil,
+							DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+							Area: atk.AreaZone, MaxTargets: 0,
+						},
+					},
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 			defenders: []creat.Creature{monster0},
-			assignedAttackers: [][]attacker{{
-				{attackerIdx: 0, attackIdx: 0},
-				{attackerIdx: 1, attackIdx: 0},
-			}},
-			usedAttackIdxs: []int{42, -10},
-			rng:            maxRNG{},
-			wantDamage:     []damage{{characteristic: atk.STR, value: 0}},
+			assignedAttackers: [][]attacker{
+				{{attackerIdx: 0, action: a0}, {attackerIdx: 1, action: a0}},
+			},
+			usedActions: []act.Action{junk, junk},
+			rng:         dicetest.NewSequence(t, 2, 3),
+			wantDamage: []damage{
+				{characteristic: atk.STR, value: 3, inflicts: poison},
+			},
 			wantAttackers: []creat.Creature{
 				{
 					ID: "player-0", Name: "John Appleseed", Attacks: []atk.Attack{spear},
-					STR: 0, DEX: 14, WIL: 8, HP: 0, Armor: 0,
-					IsDetachment: false,
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
-					ID: "player-1", Name: "Jane Appleseed", Attacks: []atk.Attack{spear},
-					STR: 8, DEX: 0, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					ID: "player-1", Name: "Jane Appleseed",
+					Attacks: []atk.Attack{
+						{
+							Name: "Venom Fang", TargetCharacteristic: atk.STR,
+							Dice: dice.D4, DiceCnt: 1, Charges: -1,
+							IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+							PairedWith: 0, Inflicts: poison, Recharge: nil,
+							DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+							Area: atk.AreaZone, MaxTargets: 0,
+						},
+					},
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 		},
 		{
-			name:              "TargetedDefendersOut",
-			damageToDefenders: []damage{{characteristic: atk.STR, value: 0}},
-			attackers:         []creat.Creature{player0},
-			defenders: []creat.Creature{
+			name: "SeveralAttacksToDifferentCharacteristics",
+			damageToDefenders: []damage{
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+			},
+			attackers: []creat.Creature{
 				{
-					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
-					STR: 0, DEX: 14, WIL: 8, HP: 0, Armor: 0,
-					IsDetachment: false,
+					ID: "player-0", Name: "John Appleseed",
+					Attacks: []atk.Attack{
+						{
+							Name: "Spear", TargetCharacteristic: atk.STR,
+							Dice: dice.D6, DiceCnt: 1, Charges: -1,
+							IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+							PairedWith: 0,
+							Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+							Recharge:   nil,
+							DmgMod:     0,
+							MinDmg:     0,
+							Usage:      atk.UsageAlways,
+							Area:       atk.AreaZone,
+							MaxTargets: 0,
+						},
+					},
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
+				},
+				{
+					ID: "player-1", Name: "Jane Appleseed",
+					Attacks: []atk.Attack{
+						{
+							Name: "Delirium", TargetCharacteristic: atk.WIL,
+							Dice: dice.D8, DiceCnt: 1, Charges: 1,
+							IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+							PairedWith: 0,
+							Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+							Recharge:   nil,
+							DmgMod:     0,
+							MinDmg:     0,
+							Usage:      atk.UsageAlways,
+							Area:       atk.AreaZone,
+							MaxTargets: 0,
+						},
+					},
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
+				},
+			},
+			defenders: []creat.Creature{monster0},
+			assignedAttackers: [][]attacker{{
+				{attackerIdx: 0, action: a0},
+				{attackerIdx: 1, action: a0},
+			}},
+			usedActions: []act.Action{junk, none},
+			rng:         dicetest.Max{},
+			wantDamage: []damage{
+				{characteristic: atk.WIL, value: 8, inflicts: noCondition},
+			},
+			wantAttackers: []creat.Creature{
+				{
+					ID: "player-0", Name: "John Appleseed",
+					Attacks: []atk.Attack{
+						{
+							Name: "Spear", TargetCharacteristic: atk.STR,
+							Dice: dice.D6, DiceCnt: 1, Charges: -1,
+							IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+							PairedWith: 0,
+							Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+							Recharge:   nil,
+							DmgMod:     0,
+							MinDmg:     0,
+							Usage:      atk.UsageAlways,
+							Area:       atk.AreaZone,
+							MaxTargets: 0,
+						},
+					},
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
+				},
+				{
+					ID: "player-1", Name: "Jane Appleseed",
+					Attacks: []atk.Attack{
+						{
+							Name: "Delirium", TargetCharacteristic: atk.WIL,
+							Dice: dice.D8, DiceCnt: 1, Charges: 0,
+							IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+							PairedWith: 0,
+							Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+							Recharge:   nil,
+							DmgMod:     0,
+							MinDmg:     0,
+							Usage:      atk.UsageAlways,
+							Area:       atk.AreaZone,
+							MaxTargets: 0,
+						},
+					},
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
-			assignedAttackers: [][]attacker{{{attackerIdx: 0, attackIdx: 0}}},
-			usedAttackIdxs:    []int{42},
-			rng:               maxRNG{},
-			wantDamage:        []damage{{characteristic: atk.STR, value: 0}},
-			wantAttackers:     []creat.Creature{player0},
-		},
-		{
-			name:              "InvalidAttackerIndexes",
-			damageToDefenders: []damage{{characteristic: atk.STR, value: 0}},
-			attackers:         []creat.Creature{player0},
-			defenders:         []creat.Creature{monster0},
-			assignedAttackers: [][]attacker{{{attackerIdx: 1, attackIdx: 0}}},
-			usedAttackIdxs:    []int{42},
-			rng:               maxRNG{},
-			wantDamage:        []damage{{characteristic: atk.STR, value: 0}},
-			wantAttackers:     []creat.Creature{player0},
-		},
-		{
-			name:              "InvalidAttackIndexes",
-			damageToDefenders: []damage{{characteristic: atk.STR, value: 0}},
-			attackers:         []creat.Creature{player0},
-			defenders:         []creat.Creature{monster0},
-			assignedAttackers: [][]attacker{{{attackerIdx: 0, attackIdx: 1}}},
-			usedAttackIdxs:    []int{42},
-			rng:               maxRNG{},
-			wantDamage:        []damage{{characteristic: atk.STR, value: 0}},
-			wantAttackers:     []creat.Creature{player0},
 		},
 		{
-			name:              "AttackWithNoCharges",
-			damageToDefenders: []damage{{characteristic: atk.STR, value: 0}},
+			name: "SingleAttackWithMultipleDice",
+			damageToDefenders: []damage{
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+			},
 			attackers: []creat.Creature{
 				{
 					ID: "player-0", Name: "John Appleseed",
 					Attacks: []atk.Attack{
 						{
 							Name: "Fireball", TargetCharacteristic: atk.STR,
-							Dice: dice.D6, DiceCnt: 1, Charges: 0,
-							IsBlast: true,
+							Dice: dice.D8, DiceCnt: 2, Charges: 2,
+							IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+							PairedWith: 0,
+							Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+							Recharge:   nil,
+							DmgMod:     0,
+							MinDmg:     0,
+							Usage:      atk.UsageAlways,
+							Area:       atk.AreaZone,
+							MaxTargets: 0,
 						},
 					},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
-			defenders:         []creat.Creature{monster0},
-			assignedAttackers: [][]attacker{{{attackerIdx: 0, attackIdx: 0}}},
-			usedAttackIdxs:    []int{42},
-			rng:               maxRNG{},
-			wantDamage:        []damage{{characteristic: atk.STR, value: 0}},
+			defenders:         []creat.Creature{monster0},
+			assignedAttackers: [][]attacker{{{attackerIdx: 0, action: a0}}},
+			usedActions:       []act.Action{none},
+			rng:               dicetest.NewSequence(t, 4, 7),
+			wantDamage: []damage{
+				{characteristic: atk.STR, value: 7, inflicts: noCondition},
+			},
 			wantAttackers: []creat.Creature{
 				{
 					ID: "player-0", Name: "John Appleseed",
 					Attacks: []atk.Attack{
 						{
 							Name: "Fireball", TargetCharacteristic: atk.STR,
-							Dice: dice.D6, DiceCnt: 1, Charges: 0,
-							IsBlast: true,
+							Dice: dice.D8, DiceCnt: 2, Charges: 1,
+							IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+							PairedWith: 0,
+							Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+							Recharge:   nil,
+							DmgMod:     0,
+							MinDmg:     0,
+							Usage:      atk.UsageAlways,
+							Area:       atk.AreaZone,
+							MaxTargets: 0,
 						},
 					},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 		},
 		{
-			name:              "DirtyDamageToDefendersReset",
-			damageToDefenders: []damage{{characteristic: atk.DEX, value: 6}},
-			attackers:         []creat.Creature{player0},
-			defenders:         []creat.Creature{monster0},
-			assignedAttackers: [][]attacker{},
-			usedAttackIdxs:    []int{42},
-			rng:               maxRNG{},
-			wantDamage:        []damage{{characteristic: atk.STR, value: 0}},
-			wantAttackers:     []creat.Creature{player0},
-		},
-		{
-			name:              "AttackCannotPenetrateArmor",
-			damageToDefenders: []damage{{characteristic: atk.STR, value: 0}},
-			attackers:         []creat.Creature{player0},
-			defenders: []creat.Creature{
-				{
-					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
-					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 3,
-					IsDetachment: false,
-				},
+			name: "MultipleAttacksWithMultipleDice",
+			damageToDefenders: []damage{
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
 			},
-			assignedAttackers: [][]attacker{{{attackerIdx: 0, attackIdx: 0}}},
-			usedAttackIdxs:    []int{42},
-			rng:               &sequenceRNG{seq: []uint{2}, idx: 0},
-			wantDamage:        []damage{{characteristic: atk.STR, value: 0}},
-			wantAttackers:     []creat.Creature{player0},
-		},
-		{
-			name:              "SeveralAttacksToDifferentCharacteristics",
-			damageToDefenders: []damage{{characteristic: atk.STR, value: 0}},
 			attackers: []creat.Creature{
 				{
 					ID: "player-0", Name: "John Appleseed",
 					Attacks: []atk.Attack{
 						{
-							Name: "Spear", TargetCharacteristic: atk.STR,
-							Dice: dice.D6, DiceCnt: 1, Charges: -1,
-							IsBlast: false,
+							Name: "Fireball", TargetCharacteristic: atk.STR,
+							Dice: dice.D8, DiceCnt: 2, Charges: 1,
+							IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+							PairedWith: 0,
+							Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+							Recharge:   nil,
+							DmgMod:     0,
+							MinDmg:     0,
+							Usage:      atk.UsageAlways,
+							Area:       atk.AreaZone,
+							MaxTargets: 0,
 						},
 					},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "player-1", Name: "Jane Appleseed",
 					Attacks: []atk.Attack{
 						{
-							Name: "Delirium", TargetCharacteristic: atk.WIL,
-							Dice: dice.D8, DiceCnt: 1, Charges: 1,
-							IsBlast: false,
+							Name: "Sword", TargetCharacteristic: atk.STR,
+							Dice: dice.D6, DiceCnt: 2, Charges: -1,
+							IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+							PairedWith: 0,
+							Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+							Recharge:   nil,
+							DmgMod:     0,
+							MinDmg:     0,
+							Usage:      atk.UsageAlways,
+							Area:       atk.AreaZone,
+							MaxTargets: 0,
 						},
 					},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
+				},
+			},
+			defenders: []creat.Creature{
+				{
+					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 1,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
-			defenders: []creat.Creature{monster0},
 			assignedAttackers: [][]attacker{{
-				{attackerIdx: 0, attackIdx: 0},
-				{attackerIdx: 1, attackIdx: 0},
+				{attackerIdx: 0, action: a0},
+				{attackerIdx: 1, action: a0},
 			}},
-			usedAttackIdxs: []int{42, -10},
-			rng:            maxRNG{},
-			wantDamage:     []damage{{characteristic: atk.WIL, value: 8}},
+			usedActions: []act.Action{none, junk},
+			rng:         dicetest.NewSequence(t, 1, 5, 4, 6),
+			wantDamage: []damage{
+				{characteristic: atk.STR, value: 5, inflicts: noCondition},
+			},
 			wantAttackers: []creat.Creature{
 				{
 					ID: "player-0", Name: "John Appleseed",
 					Attacks: []atk.Attack{
 						{
-							Name: "Spear", TargetCharacteristic: atk.STR,
-							Dice: dice.D6, DiceCnt: 1, Charges: -1,
-							IsBlast: false,
+							Name: "Fireball", TargetCharacteristic: atk.STR,
+							Dice: dice.D8, DiceCnt: 2, Charges: 0,
+							IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+							PairedWith: 0,
+							Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+							Recharge:   nil,
+							DmgMod:     0,
+							MinDmg:     0,
+							Usage:      atk.UsageAlways,
+							Area:       atk.AreaZone,
+							MaxTargets: 0,
 						},
 					},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "player-1", Name: "Jane Appleseed",
 					Attacks: []atk.Attack{
 						{
-							Name: "Delirium", TargetCharacteristic: atk.WIL,
-							Dice: dice.D8, DiceCnt: 1, Charges: 0,
-							IsBlast: false,
+							Name: "Sword", TargetCharacteristic: atk.STR,
+							Dice: dice.D6, DiceCnt: 2, Charges: -1,
+							IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+							PairedWith: 0,
+							Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+							Recharge:   nil,
+							DmgMod:     0,
+							MinDmg:     0,
+							Usage:      atk.UsageAlways,
+							Area:       atk.AreaZone,
+							MaxTargets: 0,
 						},
 					},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 		},
 		{
-			name:              "SingleAttackWithMultipleDice",
-			damageToDefenders: []damage{{characteristic: atk.STR, value: 0}},
+			name: "SingleAttackWithSummedDice",
+			damageToDefenders: []damage{
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+			},
 			attackers: []creat.Creature{
 				{
 					ID: "player-0", Name: "John Appleseed",
 					Attacks: []atk.Attack{
 						{
-							Name: "Fireball", TargetCharacteristic: atk.STR,
-							Dice: dice.D8, DiceCnt: 2, Charges: 2,
-							IsBlast: false,
+							Name: "Spear", TargetCharacteristic: atk.STR,
+							Dice: dice.D6, DiceCnt: 2, Charges: -1,
+							IsBlast: false, Pool: atk.PoolSum, Traits: atk.TraitNone,
+							PairedWith: 0,
+							Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+							Recharge:   nil,
+							DmgMod:     0,
+							MinDmg:     0,
+							Usage:      atk.UsageAlways,
+							Area:       atk.AreaZone,
+							MaxTargets: 0,
 						},
 					},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 			defenders:         []creat.Creature{monster0},
-			assignedAttackers: [][]attacker{{{attackerIdx: 0, attackIdx: 0}}},
-			usedAttackIdxs:    []int{-10},
-			rng:               &sequenceRNG{seq: []uint{3, 6}, idx: 0},
-			wantDamage:        []damage{{characteristic: atk.STR, value: 7}},
+			assignedAttackers: [][]attacker{{{attackerIdx: 0, action: a0}}},
+			usedActions:       []act.Action{junk},
+			rng:               dicetest.NewSequence(t, 4, 6),
+			wantDamage: []damage{
+				{characteristic: atk.STR, value: 10, inflicts: noCondition},
+			},
 			wantAttackers: []creat.Creature{
 				{
 					ID: "player-0", Name: "John Appleseed",
 					Attacks: []atk.Attack{
 						{
-							Name: "Fireball", TargetCharacteristic: atk.STR,
-							Dice: dice.D8, DiceCnt: 2, Charges: 1,
-							IsBlast: false,
+							Name: "Spear", TargetCharacteristic: atk.STR,
+							Dice: dice.D6, DiceCnt: 2, Charges: -1,
+							IsBlast: false, Pool: atk.PoolSum, Traits: atk.TraitNone,
+							PairedWith: 0,
+							Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+							Recharge:   nil,
+							DmgMod:     0,
+							MinDmg:     0,
+							Usage:      atk.UsageAlways,
+							Area:       atk.AreaZone,
+							MaxTargets: 0,
 						},
 					},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 		},
 		{
-			name:              "MultipleAttacksWithMultipleDice",
-			damageToDefenders: []damage{{characteristic: atk.STR, value: 0}},
+			name: "SingleAttackWithLowestDie",
+			damageToDefenders: []damage{
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+			},
 			attackers: []creat.Creature{
 				{
 					ID: "player-0", Name: "John Appleseed",
 					Attacks: []atk.Attack{
 						{
-							Name: "Fireball", TargetCharacteristic: atk.STR,
-							Dice: dice.D8, DiceCnt: 2, Charges: 1,
-							IsBlast: false,
+							Name: "Spear", TargetCharacteristic: atk.STR,
+							Dice: dice.D6, DiceCnt: 2, Charges: -1,
+							IsBlast: false, Pool: atk.PoolLowest, Traits: atk.TraitNone,
+							PairedWith: 0,
+							Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+							Recharge:   nil,
+							DmgMod:     0,
+							MinDmg:     0,
+							Usage:      atk.UsageAlways,
+							Area:       atk.AreaZone,
+							MaxTargets: 0,
 						},
 					},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
+			},
+			defenders:         []creat.Creature{monster0},
+			assignedAttackers: [][]attacker{{{attackerIdx: 0, action: a0}}},
+			usedActions:       []act.Action{junk},
+			rng:               dicetest.NewSequence(t, 4, 2),
+			wantDamage: []damage{
+				{characteristic: atk.STR, value: 2, inflicts: noCondition},
+			},
+			wantAttackers: []creat.Creature{
 				{
-					ID: "player-1", Name: "Jane Appleseed",
+					ID: "player-0", Name: "John Appleseed",
 					Attacks: []atk.Attack{
 						{
-							Name: "Sword", TargetCharacteristic: atk.STR,
+							Name: "Spear", TargetCharacteristic: atk.STR,
 							Dice: dice.D6, DiceCnt: 2, Charges: -1,
-							IsBlast: false,
+							IsBlast: false, Pool: atk.PoolLowest, Traits: atk.TraitNone,
+							PairedWith: 0,
+							Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+							Recharge:   nil,
+							DmgMod:     0,
+							MinDmg:     0,
+							Usage:      atk.UsageAlways,
+							Area:       atk.AreaZone,
+							MaxTargets: 0,
 						},
 					},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
-			defenders: []creat.Creature{
-				{
-					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
-					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 1,
-					IsDetachment: false,
-				},
+		},
+		{
+			name: "SingleAttackWithExplodingDice",
+			damageToDefenders: []damage{
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
 			},
-			assignedAttackers: [][]attacker{{
-				{attackerIdx: 0, attackIdx: 0},
-				{attackerIdx: 1, attackIdx: 0},
-			}},
-			usedAttackIdxs: []int{-10, 42},
-			rng:            &sequenceRNG{seq: []uint{0, 4, 3, 5}, idx: 0},
-			wantDamage:     []damage{{characteristic: atk.STR, value: 5}},
-			wantAttackers: []creat.Creature{
+			attackers: []creat.Creature{
 				{
 					ID: "player-0", Name: "John Appleseed",
 					Attacks: []atk.Attack{
 						{
-							Name: "Fireball", TargetCharacteristic: atk.STR,
-							Dice: dice.D8, DiceCnt: 2, Charges: 0,
-							IsBlast: false,
+							Name: "Spear", TargetCharacteristic: atk.STR,
+							Dice: dice.D6, DiceCnt: 2, Charges: -1,
+							IsBlast: false, Pool: atk.PoolExploding, Traits: atk.TraitNone,
+							PairedWith: 0,
+							Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+							Recharge:   nil,
+							DmgMod:     0,
+							MinDmg:     0,
+							Usage:      atk.UsageAlways,
+							Area:       atk.AreaZone,
+							MaxTargets: 0,
 						},
 					},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
+			},
+			defenders:         []creat.Creature{monster0},
+			assignedAttackers: [][]attacker{{{attackerIdx: 0, action: a0}}},
+			usedActions:       []act.Action{junk},
+			rng:               dicetest.NewSequence(t, 6, 2, 4),
+			wantDamage: []damage{
+				{characteristic: atk.STR, value: 8, inflicts: noCondition},
+			},
+			wantAttackers: []creat.Creature{
 				{
-					ID: "player-1", Name: "Jane Appleseed",
+					ID: "player-0", Name: "John Appleseed",
 					Attacks: []atk.Attack{
 						{
-							Name: "Sword", TargetCharacteristic: atk.STR,
+							Name: "Spear", TargetCharacteristic: atk.STR,
 							Dice: dice.D6, DiceCnt: 2, Charges: -1,
-							IsBlast: false,
+							IsBlast: false, Pool: atk.PoolExploding, Traits: atk.TraitNone,
+							PairedWith: 0,
+							Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+							Recharge:   nil,
+							DmgMod:     0,
+							MinDmg:     0,
+							Usage:      atk.UsageAlways,
+							Area:       atk.AreaZone,
+							MaxTargets: 0,
 						},
 					},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 		},
 		{
 			name: "AllAttackersAttackDifferentTargets",
 			damageToDefenders: []damage{
-				{characteristic: atk.STR, value: 0},
-				{characteristic: atk.STR, value: 0},
-				{characteristic: atk.STR, value: 0},
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
 			},
 			attackers: []creat.Creature{
 				{
@@ -1366,17 +3378,29 @@ func TestResolveAttacks(t *testing.T) {
 						{
 							Name: "Delirium", TargetCharacteristic: atk.WIL,
 							Dice: dice.D8, DiceCnt: 1, Charges: 1,
-							IsBlast: false,
+							IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+							PairedWith: 0,
+							Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+							Recharge:   nil,
+							DmgMod:     0,
+							MinDmg:     0,
+							Usage:      atk.UsageAlways,
+							Area:       atk.AreaZone,
+							MaxTargets: 0,
 						},
 					},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "player-1", Name: "Jane Appleseed",
 					Attacks: []atk.Attack{spear},
 					STR:     8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "player-2", Name: "John Doe",
@@ -1384,41 +3408,57 @@ func TestResolveAttacks(t *testing.T) {
 						{
 							Name: "Fireball", TargetCharacteristic: atk.STR,
 							Dice: dice.D6, DiceCnt: 1, Charges: 2,
-							IsBlast: false,
+							IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+							PairedWith: 0,
+							Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+							Recharge:   nil,
+							DmgMod:     0,
+							MinDmg:     0,
+							Usage:      atk.UsageAlways,
+							Area:       atk.AreaZone,
+							MaxTargets: 0,
 						},
 					},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 			defenders: []creat.Creature{
 				{
 					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-1", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 2,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-2", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 3,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 			assignedAttackers: [][]attacker{
-				{{attackerIdx: 0, attackIdx: 1}},
-				{{attackerIdx: 1, attackIdx: 0}},
-				{{attackerIdx: 2, attackIdx: 0}},
+				{{attackerIdx: 0, action: a1}},
+				{{attackerIdx: 1, action: a0}},
+				{{attackerIdx: 2, action: a0}},
 			},
-			usedAttackIdxs: []int{-10, 42, 123456},
-			rng:            maxRNG{},
+			usedActions: []act.Action{none, junk, junkSpell},
+			rng:         dicetest.Max{},
 			wantDamage: []damage{
-				{characteristic: atk.WIL, value: 8},
-				{characteristic: atk.STR, value: 4},
-				{characteristic: atk.STR, value: 3},
+				{characteristic: atk.WIL, value: 8, inflicts: noCondition},
+				{characteristic: atk.STR, value: 4, inflicts: noCondition},
+				{characteristic: atk.STR, value: 3, inflicts: noCondition},
 			},
 			wantAttackers: []creat.Creature{
 				{
@@ -1428,17 +3468,29 @@ func TestResolveAttacks(t *testing.T) {
 						{
 							Name: "Delirium", TargetCharacteristic: atk.WIL,
 							Dice: dice.D8, DiceCnt: 1, Charges: 0,
-							IsBlast: false,
+							IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+							PairedWith: 0,
+							Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+							Recharge:   nil,
+							DmgMod:     0,
+							MinDmg:     0,
+							Usage:      atk.UsageAlways,
+							Area:       atk.AreaZone,
+							MaxTargets: 0,
 						},
 					},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "player-1", Name: "Jane Appleseed",
 					Attacks: []atk.Attack{spear},
 					STR:     8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "player-2", Name: "John Doe",
@@ -1446,33 +3498,47 @@ func TestResolveAttacks(t *testing.T) {
 						{
 							Name: "Fireball", TargetCharacteristic: atk.STR,
 							Dice: dice.D6, DiceCnt: 1, Charges: 1,
-							IsBlast: false,
+							IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+							PairedWith: 0,
+							Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+							Recharge:   nil,
+							DmgMod:     0,
+							MinDmg:     0,
+							Usage:      atk.UsageAlways,
+							Area:       atk.AreaZone,
+							MaxTargets: 0,
 						},
 					},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 		},
 		{
 			name: "SomeAttackersAttackDifferentTargets",
 			damageToDefenders: []damage{
-				{characteristic: atk.STR, value: 0},
-				{characteristic: atk.STR, value: 0},
-				{characteristic: atk.STR, value: 0},
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
 			},
 			attackers: []creat.Creature{
 				{
 					ID: "player-0", Name: "John Appleseed",
 					Attacks: []atk.Attack{spear},
 					STR:     8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "player-1", Name: "Jane Appleseed",
 					Attacks: []atk.Attack{spear},
 					STR:     8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "player-2", Name: "John Doe",
@@ -1480,54 +3546,74 @@ func TestResolveAttacks(t *testing.T) {
 						{
 							Name: "Fireball", TargetCharacteristic: atk.STR,
 							Dice: dice.D6, DiceCnt: 1, Charges: 1,
-							IsBlast: false,
+							IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+							PairedWith: 0,
+							Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+							Recharge:   nil,
+							DmgMod:     0,
+							MinDmg:     0,
+							Usage:      atk.UsageAlways,
+							Area:       atk.AreaZone,
+							MaxTargets: 0,
 						},
 					},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 			defenders: []creat.Creature{
 				{
 					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-1", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 2,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-2", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 3,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 			assignedAttackers: [][]attacker{
-				{{attackerIdx: 2, attackIdx: 0}},
+				{{attackerIdx: 2, action: a0}},
 				nil,
-				{{attackerIdx: 0, attackIdx: 0}},
+				{{attackerIdx: 0, action: a0}},
 			},
-			usedAttackIdxs: []int{-10, 42, 123456},
-			rng:            maxRNG{},
+			usedActions: []act.Action{none, junk, junkSpell},
+			rng:         dicetest.Max{},
 			wantDamage: []damage{
-				{characteristic: atk.STR, value: 5},
-				{characteristic: atk.STR, value: 0},
-				{characteristic: atk.STR, value: 3},
+				{characteristic: atk.STR, value: 5, inflicts: noCondition},
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+				{characteristic: atk.STR, value: 3, inflicts: noCondition},
 			},
 			wantAttackers: []creat.Creature{
 				{
 					ID: "player-0", Name: "John Appleseed",
 					Attacks: []atk.Attack{spear},
 					STR:     8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "player-1", Name: "Jane Appleseed",
 					Attacks: []atk.Attack{spear},
 					STR:     8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "player-2", Name: "John Doe",
@@ -1535,33 +3621,47 @@ func TestResolveAttacks(t *testing.T) {
 						{
 							Name: "Fireball", TargetCharacteristic: atk.STR,
 							Dice: dice.D6, DiceCnt: 1, Charges: 0,
-							IsBlast: false,
+							IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+							PairedWith: 0,
+							Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+							Recharge:   nil,
+							DmgMod:     0,
+							MinDmg:     0,
+							Usage:      atk.UsageAlways,
+							Area:       atk.AreaZone,
+							MaxTargets: 0,
 						},
 					},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 		},
 		{
 			name: "SomeAttackersAttackMultipleTargets",
 			damageToDefenders: []damage{
-				{characteristic: atk.STR, value: 0},
-				{characteristic: atk.STR, value: 0},
-				{characteristic: atk.STR, value: 0},
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
 			},
 			attackers: []creat.Creature{
 				{
 					ID: "player-0", Name: "John Appleseed",
 					Attacks: []atk.Attack{spear},
 					STR:     8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "player-1", Name: "Jane Appleseed",
 					Attacks: []atk.Attack{spear},
 					STR:     8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "player-2", Name: "John Doe",
@@ -1569,54 +3669,74 @@ func TestResolveAttacks(t *testing.T) {
 						{
 							Name: "Paralyze", TargetCharacteristic: atk.DEX,
 							Dice: dice.D6, DiceCnt: 1, Charges: 1,
-							IsBlast: true,
+							IsBlast: true, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+							PairedWith: 0,
+							Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+							Recharge:   nil,
+							DmgMod:     0,
+							MinDmg:     0,
+							Usage:      atk.UsageAlways,
+							Area:       atk.AreaZone,
+							MaxTargets: 0,
 						},
 					},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 			defenders: []creat.Creature{
 				{
 					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-1", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 2,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-2", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 3,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 			assignedAttackers: [][]attacker{
-				{{attackerIdx: 2, attackIdx: 0}},
-				{{attackerIdx: 2, attackIdx: 0}},
-				{{attackerIdx: 2, attackIdx: 0}},
+				{{attackerIdx: 2, action: a0}},
+				{{attackerIdx: 2, action: a0}},
+				{{attackerIdx: 2, action: a0}},
 			},
-			usedAttackIdxs: []int{-10, 42, 123456},
-			rng:            maxRNG{},
+			usedActions: []act.Action{none, junk, junkSpell},
+			rng:         dicetest.Max{},
 			wantDamage: []damage{
-				{characteristic: atk.DEX, value: 6},
-				{characteristic: atk.DEX, value: 6},
-				{characteristic: atk.DEX, value: 6},
+				{characteristic: atk.DEX, value: 6, inflicts: noCondition},
+				{characteristic: atk.DEX, value: 6, inflicts: noCondition},
+				{characteristic: atk.DEX, value: 6, inflicts: noCondition},
 			},
 			wantAttackers: []creat.Creature{
 				{
 					ID: "player-0", Name: "John Appleseed",
 					Attacks: []atk.Attack{spear},
 					STR:     8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "player-1", Name: "Jane Appleseed",
 					Attacks: []atk.Attack{spear},
 					STR:     8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "player-2", Name: "John Doe",
@@ -1624,20 +3744,30 @@ func TestResolveAttacks(t *testing.T) {
 						{
 							Name: "Paralyze", TargetCharacteristic: atk.DEX,
 							Dice: dice.D6, DiceCnt: 1, Charges: 0,
-							IsBlast: true,
+							IsBlast: true, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+							PairedWith: 0,
+							Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+							Recharge:   nil,
+							DmgMod:     0,
+							MinDmg:     0,
+							Usage:      atk.UsageAlways,
+							Area:       atk.AreaZone,
+							MaxTargets: 0,
 						},
 					},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 		},
 		{
 			name: "AllAttackersAttackMultipleTargets",
 			damageToDefenders: []damage{
-				{characteristic: atk.STR, value: 0},
-				{characteristic: atk.STR, value: 0},
-				{characteristic: atk.STR, value: 0},
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
 			},
 			attackers: []creat.Creature{
 				{
@@ -1646,11 +3776,21 @@ func TestResolveAttacks(t *testing.T) {
 						{
 							Name: "Delirium", TargetCharacteristic: atk.WIL,
 							Dice: dice.D4, DiceCnt: 1, Charges: 1,
-							IsBlast: true,
+							IsBlast: true, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+							PairedWith: 0,
+							Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+							Recharge:   nil,
+							DmgMod:     0,
+							MinDmg:     0,
+							Usage:      atk.UsageAlways,
+							Area:       atk.AreaZone,
+							MaxTargets: 0,
 						},
 					},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "player-1", Name: "Jane Appleseed",
@@ -1658,11 +3798,21 @@ func TestResolveAttacks(t *testing.T) {
 						{
 							Name: "Fireball", TargetCharacteristic: atk.STR,
 							Dice: dice.D8, DiceCnt: 1, Charges: 1,
-							IsBlast: true,
+							IsBlast: true, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+							PairedWith: 0,
+							Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+							Recharge:   nil,
+							DmgMod:     0,
+							MinDmg:     0,
+							Usage:      atk.UsageAlways,
+							Area:       atk.AreaZone,
+							MaxTargets: 0,
 						},
 					},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "player-2", Name: "John Doe",
@@ -1670,53 +3820,69 @@ func TestResolveAttacks(t *testing.T) {
 						{
 							Name: "Paralyze", TargetCharacteristic: atk.DEX,
 							Dice: dice.D6, DiceCnt: 1, Charges: 1,
-							IsBlast: true,
+							IsBlast: true, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+							PairedWith: 0,
+							Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+							Recharge:   nil,
+							DmgMod:     0,
+							MinDmg:     0,
+							Usage:      atk.UsageAlways,
+							Area:       atk.AreaZone,
+							MaxTargets: 0,
 						},
 					},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 			defenders: []creat.Creature{
 				{
 					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-1", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 2,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-2", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 3,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 			assignedAttackers: [][]attacker{
 				{
-					{attackerIdx: 0, attackIdx: 0},
-					{attackerIdx: 1, attackIdx: 0},
-					{attackerIdx: 2, attackIdx: 0},
+					{attackerIdx: 0, action: a0},
+					{attackerIdx: 1, action: a0},
+					{attackerIdx: 2, action: a0},
 				},
 				{
-					{attackerIdx: 0, attackIdx: 0},
-					{attackerIdx: 1, attackIdx: 0},
-					{attackerIdx: 2, attackIdx: 0},
+					{attackerIdx: 0, action: a0},
+					{attackerIdx: 1, action: a0},
+					{attackerIdx: 2, action: a0},
 				},
 				{
-					{attackerIdx: 0, attackIdx: 0},
-					{attackerIdx: 1, attackIdx: 0},
-					{attackerIdx: 2, attackIdx: 0},
+					{attackerIdx: 0, action: a0},
+					{attackerIdx: 1, action: a0},
+					{attackerIdx: 2, action: a0},
 				},
 			},
-			usedAttackIdxs: []int{-10, 42, 123456},
-			rng:            maxRNG{},
+			usedActions: []act.Action{none, junk, junkSpell},
+			rng:         dicetest.Max{},
 			wantDamage: []damage{
-				{characteristic: atk.STR, value: 7},
-				{characteristic: atk.STR, value: 6},
-				{characteristic: atk.STR, value: 5},
+				{characteristic: atk.STR, value: 7, inflicts: noCondition},
+				{characteristic: atk.STR, value: 6, inflicts: noCondition},
+				{characteristic: atk.STR, value: 5, inflicts: noCondition},
 			},
 			wantAttackers: []creat.Creature{
 				{
@@ -1725,11 +3891,21 @@ func TestResolveAttacks(t *testing.T) {
 						{
 							Name: "Delirium", TargetCharacteristic: atk.WIL,
 							Dice: dice.D4, DiceCnt: 1, Charges: 0,
-							IsBlast: true,
+							IsBlast: true, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+							PairedWith: 0,
+							Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+							Recharge:   nil,
+							DmgMod:     0,
+							MinDmg:     0,
+							Usage:      atk.UsageAlways,
+							Area:       atk.AreaZone,
+							MaxTargets: 0,
 						},
 					},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "player-1", Name: "Jane Appleseed",
@@ -1737,11 +3913,21 @@ func TestResolveAttacks(t *testing.T) {
 						{
 							Name: "Fireball", TargetCharacteristic: atk.STR,
 							Dice: dice.D8, DiceCnt: 1, Charges: 0,
-							IsBlast: true,
+							IsBlast: true, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+							PairedWith: 0,
+							Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+							Recharge:   nil,
+							DmgMod:     0,
+							MinDmg:     0,
+							Usage:      atk.UsageAlways,
+							Area:       atk.AreaZone,
+							MaxTargets: 0,
 						},
 					},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "player-2", Name: "John Doe",
@@ -1749,19 +3935,29 @@ func TestResolveAttacks(t *testing.T) {
 						{
 							Name: "Paralyze", TargetCharacteristic: atk.DEX,
 							Dice: dice.D6, DiceCnt: 1, Charges: 0,
-							IsBlast: true,
+							IsBlast: true, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+							PairedWith: 0,
+							Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+							Recharge:   nil,
+							DmgMod:     0,
+							MinDmg:     0,
+							Usage:      atk.UsageAlways,
+							Area:       atk.AreaZone,
+							MaxTargets: 0,
 						},
 					},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 		},
 		{
 			name: "DetachmentVsDetachmentRegularDamage",
 			damageToDefenders: []damage{
-				{characteristic: atk.STR, value: 0},
-				{characteristic: atk.STR, value: 0},
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
 			},
 			attackers: []creat.Creature{
 				{
@@ -1770,11 +3966,21 @@ func TestResolveAttacks(t *testing.T) {
 						{
 							Name: "Sword", TargetCharacteristic: atk.STR,
 							Dice: dice.D6, DiceCnt: 1, Charges: -1,
-							IsBlast: false,
+							IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+							PairedWith: 0,
+							Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+							Recharge:   nil,
+							DmgMod:     0,
+							MinDmg:     0,
+							Usage:      atk.UsageAlways,
+							Area:       atk.AreaZone,
+							MaxTargets: 0,
 						},
 					},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: true,
+					IsDetachment: true, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "player-1", Name: "Jane Appleseed",
@@ -1782,34 +3988,48 @@ func TestResolveAttacks(t *testing.T) {
 						{
 							Name: "Spear", TargetCharacteristic: atk.STR,
 							Dice: dice.D8, DiceCnt: 1, Charges: -1,
-							IsBlast: false,
+							IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+							PairedWith: 0,
+							Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+							Recharge:   nil,
+							DmgMod:     0,
+							MinDmg:     0,
+							Usage:      atk.UsageAlways,
+							Area:       atk.AreaZone,
+							MaxTargets: 0,
 						},
 					},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: true,
+					IsDetachment: true, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 			defenders: []creat.Creature{
 				{
 					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 1,
-					IsDetachment: true,
+					IsDetachment: true, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-1", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 2,
-					IsDetachment: true,
+					IsDetachment: true, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 			assignedAttackers: [][]attacker{
-				{{attackerIdx: 0, attackIdx: 0}},
-				{{attackerIdx: 1, attackIdx: 0}},
+				{{attackerIdx: 0, action: a0}},
+				{{attackerIdx: 1, action: a0}},
 			},
-			usedAttackIdxs: []int{42, 123456},
-			rng:            maxRNG{},
+			usedActions: []act.Action{junk, junkSpell},
+			rng:         dicetest.Max{},
 			wantDamage: []damage{
-				{characteristic: atk.STR, value: 5},
-				{characteristic: atk.STR, value: 6},
+				{characteristic: atk.STR, value: 5, inflicts: noCondition},
+				{characteristic: atk.STR, value: 6, inflicts: noCondition},
 			},
 			wantAttackers: []creat.Creature{
 				{
@@ -1818,11 +4038,21 @@ func TestResolveAttacks(t *testing.T) {
 						{
 							Name: "Sword", TargetCharacteristic: atk.STR,
 							Dice: dice.D6, DiceCnt: 1, Charges: -1,
-							IsBlast: false,
+							IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+							PairedWith: 0,
+							Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+							Recharge:   nil,
+							DmgMod:     0,
+							MinDmg:     0,
+							Usage:      atk.UsageAlways,
+							Area:       atk.AreaZone,
+							MaxTargets: 0,
 						},
 					},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: true,
+					IsDetachment: true, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "player-1", Name: "Jane Appleseed",
@@ -1830,46 +4060,68 @@ func TestResolveAttacks(t *testing.T) {
 						{
 							Name: "Spear", TargetCharacteristic: atk.STR,
 							Dice: dice.D8, DiceCnt: 1, Charges: -1,
-							IsBlast: false,
+							IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+							PairedWith: 0,
+							Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+							Recharge:   nil,
+							DmgMod:     0,
+							MinDmg:     0,
+							Usage:      atk.UsageAlways,
+							Area:       atk.AreaZone,
+							MaxTargets: 0,
 						},
 					},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: true,
+					IsDetachment: true, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 		},
 		{
-			name:              "IndividualVsDetachmentImpaired",
-			damageToDefenders: []damage{{characteristic: atk.STR, value: 0}},
+			name: "IndividualVsDetachmentImpaired",
+			damageToDefenders: []damage{
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+			},
 			attackers: []creat.Creature{
 				{
 					ID: "player-0", Name: "John Appleseed", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 			defenders: []creat.Creature{
 				{
 					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 1,
-					IsDetachment: true,
+					IsDetachment: true, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
-			assignedAttackers: [][]attacker{{{attackerIdx: 0, attackIdx: 0}}},
-			usedAttackIdxs:    []int{42},
-			rng:               maxRNG{},
-			wantDamage:        []damage{{characteristic: atk.STR, value: 3}},
+			assignedAttackers: [][]attacker{{{attackerIdx: 0, action: a0}}},
+			usedActions:       []act.Action{junk},
+			rng:               dicetest.Max{},
+			wantDamage: []damage{
+				{characteristic: atk.STR, value: 3, inflicts: noCondition},
+			},
 			wantAttackers: []creat.Creature{
 				{
 					ID: "player-0", Name: "John Appleseed", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 		},
 		{
-			name:              "BlastIndividualVsDetachmentRegularDamage",
-			damageToDefenders: []damage{{characteristic: atk.STR, value: 0}},
+			name: "BlastIndividualVsDetachmentRegularDamage",
+			damageToDefenders: []damage{
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+			},
 			attackers: []creat.Creature{
 				{
 					ID: "player-0", Name: "John Appleseed",
@@ -1877,24 +4129,38 @@ func TestResolveAttacks(t *testing.T) {
 						{
 							Name: "Sword", TargetCharacteristic: atk.STR,
 							Dice: dice.D6, DiceCnt: 1, Charges: -1,
-							IsBlast: true,
+							IsBlast: true, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+							PairedWith: 0,
+							Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+							Recharge:   nil,
+							DmgMod:     0,
+							MinDmg:     0,
+							Usage:      atk.UsageAlways,
+							Area:       atk.AreaZone,
+							MaxTargets: 0,
 						},
 					},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 			defenders: []creat.Creature{
 				{
 					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 1,
-					IsDetachment: true,
+					IsDetachment: true, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
-			assignedAttackers: [][]attacker{{{attackerIdx: 0, attackIdx: 0}}},
-			usedAttackIdxs:    []int{42},
-			rng:               maxRNG{},
-			wantDamage:        []damage{{characteristic: atk.STR, value: 5}},
+			assignedAttackers: [][]attacker{{{attackerIdx: 0, action: a0}}},
+			usedActions:       []act.Action{junk},
+			rng:               dicetest.Max{},
+			wantDamage: []damage{
+				{characteristic: atk.STR, value: 5, inflicts: noCondition},
+			},
 			wantAttackers: []creat.Creature{
 				{
 					ID: "player-0", Name: "John Appleseed",
@@ -1902,54 +4168,171 @@ func TestResolveAttacks(t *testing.T) {
 						{
 							Name: "Sword", TargetCharacteristic: atk.STR,
 							Dice: dice.D6, DiceCnt: 1, Charges: -1,
-							IsBlast: true,
+							IsBlast: true, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+							PairedWith: 0,
+							Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+							Recharge:   nil,
+							DmgMod:     0,
+							MinDmg:     0,
+							Usage:      atk.UsageAlways,
+							Area:       atk.AreaZone,
+							MaxTargets: 0,
 						},
 					},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 		},
 		{
 			name: "DetachmentVsIndividualsEnhancedAndBlast",
 			damageToDefenders: []damage{
-				{characteristic: atk.STR, value: 0},
-				{characteristic: atk.STR, value: 0},
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
 			},
 			attackers: []creat.Creature{
 				{
 					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 1,
-					IsDetachment: true,
+					IsDetachment: true, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 			defenders: []creat.Creature{
 				{
 					ID: "player-0", Name: "John Appleseed", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 2,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "player-1", Name: "Jane Appleseed", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 3,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 			assignedAttackers: [][]attacker{
-				{{attackerIdx: 0, attackIdx: 0}},
-				{{attackerIdx: 0, attackIdx: 0}},
+				{{attackerIdx: 0, action: a0}},
+				{{attackerIdx: 0, action: a0}},
 			},
-			usedAttackIdxs: []int{42},
-			rng:            maxRNG{},
+			usedActions: []act.Action{junk},
+			rng:         dicetest.Max{},
 			wantDamage: []damage{
-				{characteristic: atk.STR, value: 10},
-				{characteristic: atk.STR, value: 9},
+				{characteristic: atk.STR, value: 10, inflicts: noCondition},
+				{characteristic: atk.STR, value: 9, inflicts: noCondition},
 			},
 			wantAttackers: []creat.Creature{
 				{
 					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 1,
-					IsDetachment: true,
+					IsDetachment: true, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
+				},
+			},
+		},
+		{
+			name: "SpellCastTakesFatigue",
+			damageToDefenders: []damage{
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+			},
+			attackers: []creat.Creature{
+				{
+					ID: "player-0", Name: "Merlin", Attacks: []atk.Attack{spear},
+					Spellbooks: []spell.Book{fireball}, Supports: nil, Items: nil,
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+					IsDetachment: false, Conditions: nil,
+					Fatigue: 0, IsDeprived: false, Zone: atk.ZoneEngaged,
+				},
+			},
+			defenders: []creat.Creature{monster0},
+			assignedAttackers: [][]attacker{
+				{{attackerIdx: 0, action: act.Spell(0, false)}},
+			},
+			usedActions: []act.Action{junk},
+			rng:         dicetest.NewSequence(t, 5),
+			wantDamage: []damage{
+				{characteristic: atk.STR, value: 5, inflicts: noCondition},
+			},
+			wantAttackers: []creat.Creature{
+				{
+					ID: "player-0", Name: "Merlin", Attacks: []atk.Attack{spear},
+					Spellbooks: []spell.Book{fireball}, Supports: nil, Items: nil,
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+					IsDetachment: false, Conditions: nil,
+					Fatigue: 1, IsDeprived: false, Zone: atk.ZoneEngaged,
+				},
+			},
+		},
+		{
+			name: "SpellMishapDealsNoDamage",
+			damageToDefenders: []damage{
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+			},
+			attackers: []creat.Creature{
+				{
+					ID: "player-0", Name: "Merlin", Attacks: []atk.Attack{spear},
+					Spellbooks: []spell.Book{fireball}, Supports: nil, Items: nil,
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+					IsDetachment: false, Conditions: nil,
+					Fatigue: 0, IsDeprived: true, Zone: atk.ZoneEngaged,
+				},
+			},
+			defenders: []creat.Creature{monster0},
+			assignedAttackers: [][]attacker{
+				{{attackerIdx: 0, action: act.Spell(0, false)}},
+			},
+			usedActions: []act.Action{junk},
+			rng:         dicetest.NewSequence(t, 20, 3),
+			wantDamage: []damage{
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+			},
+			wantAttackers: []creat.Creature{
+				{
+					ID: "player-0", Name: "Merlin", Attacks: []atk.Attack{spear},
+					Spellbooks: []spell.Book{fireball}, Supports: nil, Items: nil,
+					STR: 8, DEX: 14, WIL: 5, HP: 4, Armor: 0,
+					IsDetachment: false, Conditions: nil,
+					Fatigue: 1, IsDeprived: true, Zone: atk.ZoneEngaged,
+				},
+			},
+		},
+		{
+			name: "SpellWithoutFreeSlotsDealsNoDamage",
+			damageToDefenders: []damage{
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+			},
+			attackers: []creat.Creature{
+				{
+					ID: "player-0", Name: "Merlin", Attacks: []atk.Attack{spear},
+					Spellbooks: []spell.Book{fireball}, Supports: nil, Items: nil,
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+					IsDetachment: false, Conditions: nil,
+					Fatigue: 9, IsDeprived: false, Zone: atk.ZoneEngaged,
+				},
+			},
+			defenders: []creat.Creature{monster0},
+			assignedAttackers: [][]attacker{
+				{{attackerIdx: 0, action: act.Spell(0, true)}},
+			},
+			usedActions: []act.Action{junk},
+			rng:         dicetest.Max{},
+			wantDamage: []damage{
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+			},
+			wantAttackers: []creat.Creature{
+				{
+					ID: "player-0", Name: "Merlin", Attacks: []atk.Attack{spear},
+					Spellbooks: []spell.Book{fireball}, Supports: nil, Items: nil,
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+					IsDetachment: false, Conditions: nil,
+					Fatigue: 9, IsDeprived: false, Zone: atk.ZoneEngaged,
 				},
 			},
 		},
@@ -1961,7 +4344,7 @@ func TestResolveAttacks(t *testing.T) {
 				test.attackers,
 				test.defenders,
 				test.assignedAttackers,
-				test.usedAttackIdxs,
+				test.usedActions,
 				test.rng,
 			)
 			if !slices.Equal(test.damageToDefenders, test.wantDamage) {
@@ -1980,7 +4363,112 @@ func TestResolveAttacks(t *testing.T) {
 	}
 }
 
+func TestCombineRolls(t *testing.T) {
+	attack := func(name string, ch atk.Characteristic, pool atk.Pool) atk.Attack {
+		return atk.Attack{
+			Name: name, TargetCharacteristic: ch,
+			Dice: dice.D6, DiceCnt: 1, Charges: -1,
+			IsBlast: false, Pool: pool, Traits: atk.TraitNone, PairedWith: 0,
+			Inflicts: atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+			Recharge: nil, DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+			Area: atk.AreaZone, MaxTargets: 0,
+		}
+	}
+	tests := []struct {
+		name     string
+		rolls    []rolledAttack
+		wantName string
+		wantDmg  uint8
+	}{
+		{
+			name: "SingleRoll",
+			rolls: []rolledAttack{
+				{attack: attack("Spear", atk.STR, atk.PoolSum), dmg: 3},
+			},
+			wantName: "Spear",
+			wantDmg:  3,
+		},
+		{
+			name: "HighestKeepsHighest",
+			rolls: []rolledAttack{
+				{attack: attack("Spear", atk.STR, atk.PoolHighest), dmg: 3},
+				{attack: attack("Claws", atk.STR, atk.PoolHighest), dmg: 5},
+			},
+			wantName: "Claws",
+			wantDmg:  5,
+		},
+		{
+			name: "ExplodingKeepsHighest",
+			rolls: []rolledAttack{
+				{attack: attack("Spear", atk.STR, atk.PoolExploding), dmg: 9},
+				{attack: attack("Claws", atk.STR, atk.PoolExploding), dmg: 5},
+			},
+			wantName: "Spear",
+			wantDmg:  9,
+		},
+		{
+			name: "SumAddsUp",
+			rolls: []rolledAttack{
+				{attack: attack("Spear", atk.STR, atk.PoolSum), dmg: 3},
+				{attack: attack("Claws", atk.STR, atk.PoolSum), dmg: 5},
+			},
+			wantName: "Claws",
+			wantDmg:  8,
+		},
+		{
+			name: "SumAddsUpHighestCharacteristicOnly",
+			rolls: []rolledAttack{
+				{attack: attack("Spear", atk.STR, atk.PoolSum), dmg: 3},
+				{attack: attack("Curse", atk.WIL, atk.PoolSum), dmg: 5},
+				{attack: attack("Hex", atk.WIL, atk.PoolSum), dmg: 2},
+			},
+			wantName: "Curse",
+			wantDmg:  7,
+		},
+		{
+			name: "SumCapped",
+			rolls: []rolledAttack{
+				{attack: attack("Spear", atk.STR, atk.PoolSum), dmg: 200},
+				{attack: attack("Claws", atk.STR, atk.PoolSum), dmg: 100},
+			},
+			wantName: "Spear",
+			wantDmg:  255,
+		},
+		{
+			name: "LowestKeepsLowest",
+			rolls: []rolledAttack{
+				{attack: attack("Spear", atk.STR, atk.PoolLowest), dmg: 3},
+				{attack: attack("Claws", atk.STR, atk.PoolLowest), dmg: 1},
+				{attack: attack("Bite", atk.STR, atk.PoolLowest), dmg: 5},
+			},
+			wantName: "Claws",
+			wantDmg:  1,
+		},
+		{
+			name: "MixedPoolsKeepHighest",
+			rolls: []rolledAttack{
+				{attack: attack("Spear", atk.STR, atk.PoolSum), dmg: 3},
+				{attack: attack("Claws", atk.STR, atk.PoolLowest), dmg: 5},
+			},
+			wantName: "Claws",
+			wantDmg:  5,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := combineRolls(test.rolls)
+			if got.attack.Name != test.wantName || got.dmg != test.wantDmg {
+				t.Fatalf(
+					"combineRolls(): want %s dealing %d, got %s dealing %d",
+					test.wantName, test.wantDmg, got.attack.Name, got.dmg,
+				)
+			}
+		})
+	}
+}
+
 func TestNoDamageDone(t *testing.T) {
+	noCondition := atk.Condition{Kind: atk.ConditionNone, Rounds: 0}
 	tests := []struct {
 		name   string
 		damage []damage
@@ -1999,27 +4487,27 @@ func TestNoDamageDone(t *testing.T) {
 		{
 			name: "AllDamageZero",
 			damage: []damage{
-				{characteristic: atk.STR, value: 0},
-				{characteristic: atk.DEX, value: 0},
-				{characteristic: atk.WIL, value: 0},
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+				{characteristic: atk.DEX, value: 0, inflicts: noCondition},
+				{characteristic: atk.WIL, value: 0, inflicts: noCondition},
 			},
 			want: true,
 		},
 		{
 			name: "SomeDamageSet",
 			damage: []damage{
-				{characteristic: atk.STR, value: 0},
-				{characteristic: atk.DEX, value: 1},
-				{characteristic: atk.WIL, value: 0},
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+				{characteristic: atk.DEX, value: 1, inflicts: noCondition},
+				{characteristic: atk.WIL, value: 0, inflicts: noCondition},
 			},
 			want: false,
 		},
 		{
 			name: "AllDamageSet",
 			damage: []damage{
-				{characteristic: atk.STR, value: 1},
-				{characteristic: atk.DEX, value: 2},
-				{characteristic: atk.WIL, value: 3},
+				{characteristic: atk.STR, value: 1, inflicts: noCondition},
+				{characteristic: atk.DEX, value: 2, inflicts: noCondition},
+				{characteristic: atk.WIL, value: 3, inflicts: noCondition},
 			},
 			want: false,
 		},
@@ -2044,15 +4532,21 @@ func TestNoDamageDone(t *testing.T) {
 }
 
 func TestApplyDamageToPlayers(t *testing.T) {
+	noCondition := atk.Condition{Kind: atk.ConditionNone, Rounds: 0}
 	spear := atk.Attack{
 		Name: "Spear", TargetCharacteristic: atk.STR,
 		Dice: dice.D6, DiceCnt: 1, Charges: -1,
-		IsBlast: false,
+		IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone, PairedWith: 0,
+		Inflicts: atk.Condition{Kind: atk.ConditionNone, Rounds: 0}, Recharge: nil,
+		DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+		Area: atk.AreaZone, MaxTargets: 0,
 	}
 	player := creat.Creature{
 		ID: "player-0", Name: "John Appleseed", Attacks: []atk.Attack{spear},
 		STR: 0, DEX: 14, WIL: 8, HP: 0, Armor: 0,
-		IsDetachment: false,
+		IsDetachment: false, Conditions: nil,
+		Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil, Items: nil,
+		Zone: atk.ZoneEngaged,
 	}
 	tests := []struct {
 		name            string
@@ -2062,48 +4556,54 @@ func TestApplyDamageToPlayers(t *testing.T) {
 		want            []creat.Creature
 	}{
 		{
-			name:            "EmptyPlayers",
-			players:         []creat.Creature{},
-			damageToPlayers: []damage{{characteristic: atk.STR, value: 4}},
-			rng:             maxRNG{},
-			want:            []creat.Creature{},
+			name:    "EmptyPlayers",
+			players: []creat.Creature{},
+			damageToPlayers: []damage{
+				{characteristic: atk.STR, value: 4, inflicts: noCondition},
+			},
+			rng:  dicetest.Max{},
+			want: []creat.Creature{},
 		},
 		{
-			name:            "NilPlayers",
-			players:         nil,
-			damageToPlayers: []damage{{characteristic: atk.STR, value: 4}},
-			rng:             maxRNG{},
-			want:            nil,
+			name:    "NilPlayers",
+			players: nil,
+			damageToPlayers: []damage{
+				{characteristic: atk.STR, value: 4, inflicts: noCondition},
+			},
+			rng:  dicetest.Max{},
+			want: nil,
 		},
 		{
 			name:            "EmptyDamage",
 			players:         []creat.Creature{player},
 			damageToPlayers: []damage{},
-			rng:             maxRNG{},
+			rng:             dicetest.Max{},
 			want:            []creat.Creature{player},
 		},
 		{
 			name:            "NilDamage",
 			players:         []creat.Creature{player},
 			damageToPlayers: nil,
-			rng:             maxRNG{},
+			rng:             dicetest.Max{},
 			want:            []creat.Creature{player},
 		},
 		{
-			name:            "NilRNG",
-			players:         []creat.Creature{player},
-			damageToPlayers: []damage{{characteristic: atk.STR, value: 4}},
-			rng:             nil,
-			want:            []creat.Creature{player},
+			name:    "NilRNG",
+			players: []creat.Creature{player},
+			damageToPlayers: []damage{
+				{characteristic: atk.STR, value: 4, inflicts: noCondition},
+			},
+			rng:  nil,
+			want: []creat.Creature{player},
 		},
 		{
 			name:    "PlayersShorterThanDamage",
 			players: []creat.Creature{player},
 			damageToPlayers: []damage{
-				{characteristic: atk.STR, value: 4},
-				{characteristic: atk.DEX, value: 2},
+				{characteristic: atk.STR, value: 4, inflicts: noCondition},
+				{characteristic: atk.DEX, value: 2, inflicts: noCondition},
 			},
-			rng:  maxRNG{},
+			rng:  dicetest.Max{},
 			want: []creat.Creature{player},
 		},
 		{
@@ -2112,26 +4612,36 @@ func TestApplyDamageToPlayers(t *testing.T) {
 				{
 					ID: "player-0", Name: "John Appleseed", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "player-1", Name: "Jane Appleseed", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
-			damageToPlayers: []damage{{characteristic: atk.STR, value: 4}},
-			rng:             maxRNG{},
+			damageToPlayers: []damage{
+				{characteristic: atk.STR, value: 4, inflicts: noCondition},
+			},
+			rng: dicetest.Max{},
 			want: []creat.Creature{
 				{
 					ID: "player-0", Name: "John Appleseed", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "player-1", Name: "Jane Appleseed", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 		},
@@ -2141,38 +4651,48 @@ func TestApplyDamageToPlayers(t *testing.T) {
 				{
 					ID: "player-0", Name: "John Appleseed", Attacks: []atk.Attack{spear},
 					STR: 0, DEX: 14, WIL: 8, HP: 0, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "player-1", Name: "Jane Appleseed", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 0, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 			damageToPlayers: []damage{
-				{characteristic: atk.STR, value: 4},
-				{characteristic: atk.STR, value: 4},
+				{characteristic: atk.STR, value: 4, inflicts: noCondition},
+				{characteristic: atk.STR, value: 4, inflicts: noCondition},
 			},
-			rng: maxRNG{},
+			rng: dicetest.Max{},
 			want: []creat.Creature{
 				{
 					ID: "player-0", Name: "John Appleseed", Attacks: []atk.Attack{spear},
 					STR: 0, DEX: 14, WIL: 8, HP: 0, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "player-1", Name: "Jane Appleseed", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 0, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 		},
 		{
-			name:            "AllDamageZero",
-			players:         []creat.Creature{player},
-			damageToPlayers: []damage{{characteristic: atk.STR, value: 0}},
-			rng:             maxRNG{},
-			want:            []creat.Creature{player},
+			name:    "AllDamageZero",
+			players: []creat.Creature{player},
+			damageToPlayers: []damage{
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+			},
+			rng:  dicetest.Max{},
+			want: []creat.Creature{player},
 		},
 		{
 			name: "DamageToSTRReducesHP",
@@ -2180,29 +4700,38 @@ func TestApplyDamageToPlayers(t *testing.T) {
 				{
 					ID: "player-0", Name: "John Appleseed", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "player-1", Name: "Jane Appleseed", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 			damageToPlayers: []damage{
-				{characteristic: atk.STR, value: 3},
-				{characteristic: atk.STR, value: 4},
+				{characteristic: atk.STR, value: 3, inflicts: noCondition},
+				{characteristic: atk.STR, value: 4, inflicts: noCondition},
 			},
-			rng: maxRNG{},
+			rng: dicetest.Max{},
 			want: []creat.Creature{
 				{
 					ID: "player-0", Name: "John Appleseed", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 1, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "player-1", Name: "Jane Appleseed", Attacks: []atk.Attack{spear},
-					STR: 8, DEX: 14, WIL: 8, HP: 0, Armor: 1,
-					IsDetachment: false,
+					STR: 8, DEX: 14, WIL: 8, HP: 0,
+					Scars: []creat.Scar{{Detail: "", Kind: creat.ScarDoomed}}, Armor: 1,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 		},
@@ -2212,16 +4741,22 @@ func TestApplyDamageToPlayers(t *testing.T) {
 				{
 					ID: "player-0", Name: "John Appleseed", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
-			damageToPlayers: []damage{{characteristic: atk.STR, value: 7}},
-			rng:             minRNG{},
+			damageToPlayers: []damage{
+				{characteristic: atk.STR, value: 7, inflicts: noCondition},
+			},
+			rng: dicetest.Min{},
 			want: []creat.Creature{
 				{
 					ID: "player-0", Name: "John Appleseed", Attacks: []atk.Attack{spear},
 					STR: 5, DEX: 14, WIL: 8, HP: 0, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 		},
@@ -2231,16 +4766,49 @@ func TestApplyDamageToPlayers(t *testing.T) {
 				{
 					ID: "player-0", Name: "John Appleseed", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
+				},
+			},
+			damageToPlayers: []damage{
+				{characteristic: atk.STR, value: 7, inflicts: noCondition},
+			},
+			rng: dicetest.Max{},
+			want: []creat.Creature{
+				{
+					ID: "player-0", Name: "John Appleseed", Attacks: []atk.Attack{spear},
+					STR: 0, DEX: 14, WIL: 8, HP: 0, Armor: 1,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
+				},
+			},
+		},
+		{
+			// A deprived player passes the critical damage save with a 3, but the
+			// disadvantage keeps the higher 6.
+			name: "DeprivedDamageToSTRSaveWithDisadvantage",
+			players: []creat.Creature{
+				{
+					ID: "player-0", Name: "John Appleseed", Attacks: []atk.Attack{spear},
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 1,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: true, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
-			damageToPlayers: []damage{{characteristic: atk.STR, value: 7}},
-			rng:             maxRNG{},
+			damageToPlayers: []damage{
+				{characteristic: atk.STR, value: 7, inflicts: noCondition},
+			},
+			rng: dicetest.NewSequence(t, 3, 6),
 			want: []creat.Creature{
 				{
 					ID: "player-0", Name: "John Appleseed", Attacks: []atk.Attack{spear},
 					STR: 0, DEX: 14, WIL: 8, HP: 0, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: true, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 		},
@@ -2250,29 +4818,37 @@ func TestApplyDamageToPlayers(t *testing.T) {
 				{
 					ID: "player-0", Name: "John Appleseed", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "player-1", Name: "Jane Appleseed", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 			damageToPlayers: []damage{
-				{characteristic: atk.STR, value: 12},
-				{characteristic: atk.STR, value: 15},
+				{characteristic: atk.STR, value: 12, inflicts: noCondition},
+				{characteristic: atk.STR, value: 15, inflicts: noCondition},
 			},
-			rng: maxRNG{},
+			rng: dicetest.Max{},
 			want: []creat.Creature{
 				{
 					ID: "player-0", Name: "John Appleseed", Attacks: []atk.Attack{spear},
 					STR: 0, DEX: 14, WIL: 8, HP: 0, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "player-1", Name: "Jane Appleseed", Attacks: []atk.Attack{spear},
 					STR: 0, DEX: 14, WIL: 8, HP: 0, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 		},
@@ -2282,16 +4858,22 @@ func TestApplyDamageToPlayers(t *testing.T) {
 				{
 					ID: "player-0", Name: "John Appleseed", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
-			damageToPlayers: []damage{{characteristic: atk.DEX, value: 8}},
-			rng:             maxRNG{},
+			damageToPlayers: []damage{
+				{characteristic: atk.DEX, value: 8, inflicts: noCondition},
+			},
+			rng: dicetest.Max{},
 			want: []creat.Creature{
 				{
 					ID: "player-0", Name: "John Appleseed", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 6, WIL: 8, HP: 4, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 		},
@@ -2301,16 +4883,22 @@ func TestApplyDamageToPlayers(t *testing.T) {
 				{
 					ID: "player-0", Name: "John Appleseed", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
-			damageToPlayers: []damage{{characteristic: atk.WIL, value: 7}},
-			rng:             maxRNG{},
+			damageToPlayers: []damage{
+				{characteristic: atk.WIL, value: 7, inflicts: noCondition},
+			},
+			rng: dicetest.Max{},
 			want: []creat.Creature{
 				{
 					ID: "player-0", Name: "John Appleseed", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 1, HP: 4, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 		},
@@ -2320,16 +4908,23 @@ func TestApplyDamageToPlayers(t *testing.T) {
 				{
 					ID: "player-0", Name: "John Appleseed", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
-			damageToPlayers: []damage{{characteristic: atk.STR, value: 4}},
-			rng:             maxRNG{},
+			damageToPlayers: []damage{
+				{characteristic: atk.STR, value: 4, inflicts: noCondition},
+			},
+			rng: dicetest.Max{},
 			want: []creat.Creature{
 				{
 					ID: "player-0", Name: "John Appleseed", Attacks: []atk.Attack{spear},
-					STR: 8, DEX: 14, WIL: 8, HP: 0, Armor: 1,
-					IsDetachment: false,
+					STR: 8, DEX: 14, WIL: 8, HP: 0,
+					Scars: []creat.Scar{{Detail: "", Kind: creat.ScarDoomed}}, Armor: 1,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 		},
@@ -2348,15 +4943,21 @@ func TestApplyDamageToPlayers(t *testing.T) {
 }
 
 func TestApplyDamageToMonsters(t *testing.T) {
+	noCondition := atk.Condition{Kind: atk.ConditionNone, Rounds: 0}
 	spear := atk.Attack{
 		Name: "Spear", TargetCharacteristic: atk.STR,
 		Dice: dice.D6, DiceCnt: 1, Charges: -1,
-		IsBlast: false,
+		IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone, PairedWith: 0,
+		Inflicts: atk.Condition{Kind: atk.ConditionNone, Rounds: 0}, Recharge: nil,
+		DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+		Area: atk.AreaZone, MaxTargets: 0,
 	}
 	monster := creat.Creature{
 		ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 		STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-		IsDetachment: false,
+		IsDetachment: false, Conditions: nil,
+		Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil, Items: nil,
+		Zone: atk.ZoneEngaged,
 	}
 	tests := []struct {
 		name             string
@@ -2366,48 +4967,54 @@ func TestApplyDamageToMonsters(t *testing.T) {
 		want             []creat.Creature
 	}{
 		{
-			name:             "EmptyMonsters",
-			monsters:         []creat.Creature{},
-			damageToMonsters: []damage{{characteristic: atk.STR, value: 4}},
-			rng:              maxRNG{},
-			want:             []creat.Creature{},
+			name:     "EmptyMonsters",
+			monsters: []creat.Creature{},
+			damageToMonsters: []damage{
+				{characteristic: atk.STR, value: 4, inflicts: noCondition},
+			},
+			rng:  dicetest.Max{},
+			want: []creat.Creature{},
 		},
 		{
-			name:             "NilMonsters",
-			monsters:         nil,
-			damageToMonsters: []damage{{characteristic: atk.STR, value: 4}},
-			rng:              maxRNG{},
-			want:             nil,
+			name:     "NilMonsters",
+			monsters: nil,
+			damageToMonsters: []damage{
+				{characteristic: atk.STR, value: 4, inflicts: noCondition},
+			},
+			rng:  dicetest.Max{},
+			want: nil,
 		},
 		{
 			name:             "EmptyDamage",
 			monsters:         []creat.Creature{monster},
 			damageToMonsters: []damage{},
-			rng:              maxRNG{},
+			rng:              dicetest.Max{},
 			want:             []creat.Creature{monster},
 		},
 		{
 			name:             "NilDamage",
 			monsters:         []creat.Creature{monster},
 			damageToMonsters: nil,
-			rng:              maxRNG{},
+			rng:              dicetest.Max{},
 			want:             []creat.Creature{monster},
 		},
 		{
-			name:             "NilRNG",
-			monsters:         []creat.Creature{monster},
-			damageToMonsters: []damage{{characteristic: atk.STR, value: 4}},
-			rng:              nil,
-			want:             []creat.Creature{monster},
+			name:     "NilRNG",
+			monsters: []creat.Creature{monster},
+			damageToMonsters: []damage{
+				{characteristic: atk.STR, value: 4, inflicts: noCondition},
+			},
+			rng:  nil,
+			want: []creat.Creature{monster},
 		},
 		{
 			name:     "MonstersShorterThanDamage",
 			monsters: []creat.Creature{monster},
 			damageToMonsters: []damage{
-				{characteristic: atk.STR, value: 4},
-				{characteristic: atk.DEX, value: 2},
+				{characteristic: atk.STR, value: 4, inflicts: noCondition},
+				{characteristic: atk.DEX, value: 2, inflicts: noCondition},
 			},
-			rng:  maxRNG{},
+			rng:  dicetest.Max{},
 			want: []creat.Creature{monster},
 		},
 		{
@@ -2416,26 +5023,36 @@ func TestApplyDamageToMonsters(t *testing.T) {
 				{
 					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-1", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
-			damageToMonsters: []damage{{characteristic: atk.STR, value: 4}},
-			rng:              maxRNG{},
+			damageToMonsters: []damage{
+				{characteristic: atk.STR, value: 4, inflicts: noCondition},
+			},
+			rng: dicetest.Max{},
 			want: []creat.Creature{
 				{
 					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-1", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 		},
@@ -2445,38 +5062,48 @@ func TestApplyDamageToMonsters(t *testing.T) {
 				{
 					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 0, DEX: 14, WIL: 8, HP: 0, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-1", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 0, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 			damageToMonsters: []damage{
-				{characteristic: atk.STR, value: 4},
-				{characteristic: atk.STR, value: 4},
+				{characteristic: atk.STR, value: 4, inflicts: noCondition},
+				{characteristic: atk.STR, value: 4, inflicts: noCondition},
 			},
-			rng: maxRNG{},
+			rng: dicetest.Max{},
 			want: []creat.Creature{
 				{
 					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 0, DEX: 14, WIL: 8, HP: 0, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-1", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 0, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 		},
 		{
-			name:             "AllDamageZero",
-			monsters:         []creat.Creature{monster},
-			damageToMonsters: []damage{{characteristic: atk.STR, value: 0}},
-			rng:              maxRNG{},
-			want:             []creat.Creature{monster},
+			name:     "AllDamageZero",
+			monsters: []creat.Creature{monster},
+			damageToMonsters: []damage{
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+			},
+			rng:  dicetest.Max{},
+			want: []creat.Creature{monster},
 		},
 		{
 			name: "DamageToSTRReducesHP",
@@ -2484,29 +5111,37 @@ func TestApplyDamageToMonsters(t *testing.T) {
 				{
 					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-1", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 2,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 			damageToMonsters: []damage{
-				{characteristic: atk.STR, value: 3},
-				{characteristic: atk.STR, value: 4},
+				{characteristic: atk.STR, value: 3, inflicts: noCondition},
+				{characteristic: atk.STR, value: 4, inflicts: noCondition},
 			},
-			rng: maxRNG{},
+			rng: dicetest.Max{},
 			want: []creat.Creature{
 				{
 					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 1, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-1", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 0, Armor: 2,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 		},
@@ -2516,16 +5151,22 @@ func TestApplyDamageToMonsters(t *testing.T) {
 				{
 					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
-			damageToMonsters: []damage{{characteristic: atk.STR, value: 7}},
-			rng:              minRNG{},
+			damageToMonsters: []damage{
+				{characteristic: atk.STR, value: 7, inflicts: noCondition},
+			},
+			rng: dicetest.Min{},
 			want: []creat.Creature{
 				{
 					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 5, DEX: 14, WIL: 8, HP: 0, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 		},
@@ -2535,16 +5176,22 @@ func TestApplyDamageToMonsters(t *testing.T) {
 				{
 					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
-			damageToMonsters: []damage{{characteristic: atk.STR, value: 7}},
-			rng:              maxRNG{},
+			damageToMonsters: []damage{
+				{characteristic: atk.STR, value: 7, inflicts: noCondition},
+			},
+			rng: dicetest.Max{},
 			want: []creat.Creature{
 				{
 					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 0, DEX: 14, WIL: 8, HP: 0, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 		},
@@ -2554,29 +5201,37 @@ func TestApplyDamageToMonsters(t *testing.T) {
 				{
 					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-1", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 			damageToMonsters: []damage{
-				{characteristic: atk.STR, value: 12},
-				{characteristic: atk.STR, value: 15},
+				{characteristic: atk.STR, value: 12, inflicts: noCondition},
+				{characteristic: atk.STR, value: 15, inflicts: noCondition},
 			},
-			rng: maxRNG{},
+			rng: dicetest.Max{},
 			want: []creat.Creature{
 				{
 					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 0, DEX: 14, WIL: 8, HP: 0, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-1", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 0, DEX: 14, WIL: 8, HP: 0, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 		},
@@ -2586,16 +5241,22 @@ func TestApplyDamageToMonsters(t *testing.T) {
 				{
 					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
-			damageToMonsters: []damage{{characteristic: atk.DEX, value: 8}},
-			rng:              maxRNG{},
+			damageToMonsters: []damage{
+				{characteristic: atk.DEX, value: 8, inflicts: noCondition},
+			},
+			rng: dicetest.Max{},
 			want: []creat.Creature{
 				{
 					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 6, WIL: 8, HP: 4, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 		},
@@ -2605,16 +5266,22 @@ func TestApplyDamageToMonsters(t *testing.T) {
 				{
 					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
-			damageToMonsters: []damage{{characteristic: atk.WIL, value: 7}},
-			rng:              maxRNG{},
+			damageToMonsters: []damage{
+				{characteristic: atk.WIL, value: 7, inflicts: noCondition},
+			},
+			rng: dicetest.Max{},
 			want: []creat.Creature{
 				{
 					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 1, HP: 4, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 		},
@@ -2624,16 +5291,22 @@ func TestApplyDamageToMonsters(t *testing.T) {
 				{
 					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
-			damageToMonsters: []damage{{characteristic: atk.STR, value: 4}},
-			rng:              minRNG{},
+			damageToMonsters: []damage{
+				{characteristic: atk.STR, value: 4, inflicts: noCondition},
+			},
+			rng: dicetest.Min{},
 			want: []creat.Creature{
 				{
 					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 0, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 		},
@@ -2643,16 +5316,22 @@ func TestApplyDamageToMonsters(t *testing.T) {
 				{
 					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
-			damageToMonsters: []damage{{characteristic: atk.STR, value: 4}},
-			rng:              maxRNG{},
+			damageToMonsters: []damage{
+				{characteristic: atk.STR, value: 4, inflicts: noCondition},
+			},
+			rng: dicetest.Max{},
 			want: []creat.Creature{
 				{
 					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 0, DEX: 14, WIL: 8, HP: 0, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 		},
@@ -2662,16 +5341,22 @@ func TestApplyDamageToMonsters(t *testing.T) {
 				{
 					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
-			damageToMonsters: []damage{{characteristic: atk.STR, value: 6}},
-			rng:              &sequenceRNG{seq: []uint{5, 7}, idx: 0},
+			damageToMonsters: []damage{
+				{characteristic: atk.STR, value: 6, inflicts: noCondition},
+			},
+			rng: dicetest.NewSequence(t, 6, 8),
 			want: []creat.Creature{
 				{
 					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 6, DEX: 14, WIL: 8, HP: 0, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 		},
@@ -2681,16 +5366,22 @@ func TestApplyDamageToMonsters(t *testing.T) {
 				{
 					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
-			damageToMonsters: []damage{{characteristic: atk.STR, value: 6}},
-			rng:              &sequenceRNG{seq: []uint{5, 8}, idx: 0},
+			damageToMonsters: []damage{
+				{characteristic: atk.STR, value: 6, inflicts: noCondition},
+			},
+			rng: dicetest.NewSequence(t, 6, 9),
 			want: []creat.Creature{
 				{
 					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 0, DEX: 14, WIL: 8, HP: 0, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 		},
@@ -2700,40 +5391,52 @@ func TestApplyDamageToMonsters(t *testing.T) {
 				{
 					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-1", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-2", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 			damageToMonsters: []damage{
-				{characteristic: atk.STR, value: 6},
-				{characteristic: atk.STR, value: 0},
-				{characteristic: atk.STR, value: 0},
+				{characteristic: atk.STR, value: 6, inflicts: noCondition},
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
 			},
-			rng: &sequenceRNG{seq: []uint{6, 7, 7}, idx: 0},
+			rng: dicetest.NewSequence(t, 7, 8, 8),
 			want: []creat.Creature{
 				{
 					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 0, DEX: 14, WIL: 8, HP: 0, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-1", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-2", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 		},
@@ -2743,40 +5446,52 @@ func TestApplyDamageToMonsters(t *testing.T) {
 				{
 					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-1", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-2", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 			damageToMonsters: []damage{
-				{characteristic: atk.STR, value: 6},
-				{characteristic: atk.STR, value: 0},
-				{characteristic: atk.STR, value: 0},
+				{characteristic: atk.STR, value: 6, inflicts: noCondition},
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
 			},
-			rng: &sequenceRNG{seq: []uint{6, 8, 7}, idx: 0},
+			rng: dicetest.NewSequence(t, 7, 9, 8),
 			want: []creat.Creature{
 				{
 					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 0, DEX: 14, WIL: 8, HP: 0, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-1", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 0, DEX: 14, WIL: 8, HP: 0, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-2", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 		},
@@ -2786,40 +5501,52 @@ func TestApplyDamageToMonsters(t *testing.T) {
 				{
 					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-1", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-2", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 			damageToMonsters: []damage{
-				{characteristic: atk.STR, value: 6},
-				{characteristic: atk.STR, value: 0},
-				{characteristic: atk.STR, value: 0},
+				{characteristic: atk.STR, value: 6, inflicts: noCondition},
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
 			},
-			rng: &sequenceRNG{seq: []uint{6, 8, 9}, idx: 0},
+			rng: dicetest.NewSequence(t, 7, 9, 10),
 			want: []creat.Creature{
 				{
 					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 0, DEX: 14, WIL: 8, HP: 0, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-1", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 0, DEX: 14, WIL: 8, HP: 0, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-2", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 0, DEX: 14, WIL: 8, HP: 0, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 		},
@@ -2829,51 +5556,67 @@ func TestApplyDamageToMonsters(t *testing.T) {
 				{
 					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 0, DEX: 14, WIL: 8, HP: 0, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-1", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-2", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-3", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 			damageToMonsters: []damage{
-				{characteristic: atk.STR, value: 0},
-				{characteristic: atk.STR, value: 6},
-				{characteristic: atk.STR, value: 0},
-				{characteristic: atk.STR, value: 0},
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+				{characteristic: atk.STR, value: 6, inflicts: noCondition},
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
 			},
-			rng: &sequenceRNG{seq: []uint{6, 7, 7}, idx: 0},
+			rng: dicetest.NewSequence(t, 7, 8, 8),
 			want: []creat.Creature{
 				{
 					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 0, DEX: 14, WIL: 8, HP: 0, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-1", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 0, DEX: 14, WIL: 8, HP: 0, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-2", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-3", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 		},
@@ -2883,51 +5626,67 @@ func TestApplyDamageToMonsters(t *testing.T) {
 				{
 					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 0, DEX: 14, WIL: 8, HP: 0, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-1", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-2", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-3", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 			damageToMonsters: []damage{
-				{characteristic: atk.STR, value: 0},
-				{characteristic: atk.STR, value: 6},
-				{characteristic: atk.STR, value: 0},
-				{characteristic: atk.STR, value: 0},
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+				{characteristic: atk.STR, value: 6, inflicts: noCondition},
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
 			},
-			rng: &sequenceRNG{seq: []uint{6, 7, 8}, idx: 0},
+			rng: dicetest.NewSequence(t, 7, 8, 9),
 			want: []creat.Creature{
 				{
 					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 0, DEX: 14, WIL: 8, HP: 0, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-1", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 0, DEX: 14, WIL: 8, HP: 0, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-2", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-3", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 0, DEX: 14, WIL: 8, HP: 0, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 		},
@@ -2937,51 +5696,67 @@ func TestApplyDamageToMonsters(t *testing.T) {
 				{
 					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 0, DEX: 14, WIL: 8, HP: 0, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-1", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-2", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-3", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 			damageToMonsters: []damage{
-				{characteristic: atk.STR, value: 0},
-				{characteristic: atk.STR, value: 6},
-				{characteristic: atk.STR, value: 0},
-				{characteristic: atk.STR, value: 0},
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+				{characteristic: atk.STR, value: 6, inflicts: noCondition},
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
 			},
-			rng: &sequenceRNG{seq: []uint{6, 8, 9}, idx: 0},
+			rng: dicetest.NewSequence(t, 7, 9, 10),
 			want: []creat.Creature{
 				{
 					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 0, DEX: 14, WIL: 8, HP: 0, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-1", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 0, DEX: 14, WIL: 8, HP: 0, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-2", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 0, DEX: 14, WIL: 8, HP: 0, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-3", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 0, DEX: 14, WIL: 8, HP: 0, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 		},
@@ -2991,40 +5766,52 @@ func TestApplyDamageToMonsters(t *testing.T) {
 				{
 					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 0, DEX: 14, WIL: 8, HP: 0, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-1", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-2", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 			damageToMonsters: []damage{
-				{characteristic: atk.STR, value: 0},
-				{characteristic: atk.STR, value: 6},
-				{characteristic: atk.STR, value: 0},
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+				{characteristic: atk.STR, value: 6, inflicts: noCondition},
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
 			},
-			rng: &sequenceRNG{seq: []uint{6, 7}, idx: 0},
+			rng: dicetest.NewSequence(t, 7, 8),
 			want: []creat.Creature{
 				{
 					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 0, DEX: 14, WIL: 8, HP: 0, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-1", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 0, DEX: 14, WIL: 8, HP: 0, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-2", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 		},
@@ -3034,62 +5821,82 @@ func TestApplyDamageToMonsters(t *testing.T) {
 				{
 					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 0, DEX: 14, WIL: 8, HP: 0, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-1", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-2", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-3", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-4", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 			damageToMonsters: []damage{
-				{characteristic: atk.STR, value: 0},
-				{characteristic: atk.STR, value: 6},
-				{characteristic: atk.STR, value: 6},
-				{characteristic: atk.STR, value: 0},
-				{characteristic: atk.STR, value: 0},
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+				{characteristic: atk.STR, value: 6, inflicts: noCondition},
+				{characteristic: atk.STR, value: 6, inflicts: noCondition},
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
 			},
-			rng: &sequenceRNG{seq: []uint{6, 6, 8, 7}, idx: 0},
+			rng: dicetest.NewSequence(t, 7, 7, 9, 8),
 			want: []creat.Creature{
 				{
 					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 0, DEX: 14, WIL: 8, HP: 0, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-1", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 0, DEX: 14, WIL: 8, HP: 0, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-2", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 0, DEX: 14, WIL: 8, HP: 0, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-3", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 0, DEX: 14, WIL: 8, HP: 0, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-4", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 		},
@@ -3099,40 +5906,52 @@ func TestApplyDamageToMonsters(t *testing.T) {
 				{
 					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 0, DEX: 14, WIL: 8, HP: 0, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-1", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-2", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 			damageToMonsters: []damage{
-				{characteristic: atk.STR, value: 0},
-				{characteristic: atk.STR, value: 6},
-				{characteristic: atk.STR, value: 0},
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
+				{characteristic: atk.STR, value: 6, inflicts: noCondition},
+				{characteristic: atk.STR, value: 0, inflicts: noCondition},
 			},
-			rng: &sequenceRNG{seq: []uint{6, 8}, idx: 0},
+			rng: dicetest.NewSequence(t, 7, 9),
 			want: []creat.Creature{
 				{
 					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 0, DEX: 14, WIL: 8, HP: 0, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-1", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 0, DEX: 14, WIL: 8, HP: 0, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-2", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 0, DEX: 14, WIL: 8, HP: 0, Armor: 1,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 		},
@@ -3150,11 +5969,649 @@ func TestApplyDamageToMonsters(t *testing.T) {
 	}
 }
 
+func TestInflictConditions(t *testing.T) {
+	noCondition := atk.Condition{Kind: atk.ConditionNone, Rounds: 0}
+	fear := atk.Condition{Kind: atk.ConditionFrightened, Rounds: 2}
+	spear := atk.Attack{
+		Name: "Spear", TargetCharacteristic: atk.STR,
+		Dice: dice.D6, DiceCnt: 1, Charges: -1,
+		IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone, PairedWith: 0,
+		Inflicts: noCondition, Recharge: nil, DmgMod: 0, MinDmg: 0,
+		Usage:      atk.UsageAlways,
+		Area:       atk.AreaZone,
+		MaxTargets: 0,
+	}
+	goblin := func(str uint8, conditions ...atk.Condition) creat.Creature {
+		return creat.Creature{
+			ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
+			STR: str, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+			IsDetachment: false, Conditions: conditions,
+			Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil, Items: nil,
+			Zone: atk.ZoneEngaged,
+		}
+	}
+	tests := []struct {
+		name          string
+		defenders     []creat.Creature
+		damage        []damage
+		faces         []uint
+		wantDefenders []creat.Creature
+	}{
+		{
+			name:      "FailedSave",
+			defenders: []creat.Creature{goblin(8)},
+			damage: []damage{
+				{characteristic: atk.STR, value: 1, inflicts: fear},
+			},
+			faces:         []uint{9},
+			wantDefenders: []creat.Creature{goblin(8, fear)},
+		},
+		{
+			name:      "SuccessfulSave",
+			defenders: []creat.Creature{goblin(8)},
+			damage: []damage{
+				{characteristic: atk.STR, value: 1, inflicts: fear},
+			},
+			faces:         []uint{8},
+			wantDefenders: []creat.Creature{goblin(8)},
+		},
+		{
+			name:      "NoDamage",
+			defenders: []creat.Creature{goblin(8)},
+			damage: []damage{
+				{characteristic: atk.STR, value: 0, inflicts: fear},
+			},
+			faces:         nil,
+			wantDefenders: []creat.Creature{goblin(8)},
+		},
+		{
+			name:      "NoCondition",
+			defenders: []creat.Creature{goblin(8)},
+			damage: []damage{
+				{characteristic: atk.STR, value: 1, inflicts: noCondition},
+			},
+			faces:         nil,
+			wantDefenders: []creat.Creature{goblin(8)},
+		},
+		{
+			name:      "OutDefender",
+			defenders: []creat.Creature{goblin(0)},
+			damage: []damage{
+				{characteristic: atk.STR, value: 1, inflicts: fear},
+			},
+			faces:         nil,
+			wantDefenders: []creat.Creature{goblin(0)},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rng := dicetest.NewSequence(t, test.faces...)
+			inflictConditions(test.defenders, test.damage, rng)
+			if !creat.CreatureSlice(test.defenders).Equals(test.wantDefenders) {
+				t.Fatalf(
+					"inflictConditions(): want %v, got %v",
+					test.wantDefenders, test.defenders,
+				)
+			}
+		})
+	}
+}
+
+func TestTickConditions(t *testing.T) {
+	poison := atk.Condition{Kind: atk.ConditionPoisoned, Rounds: -1}
+	spear := atk.Attack{
+		Name: "Spear", TargetCharacteristic: atk.STR,
+		Dice: dice.D6, DiceCnt: 1, Charges: -1,
+		IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone, PairedWith: 0,
+		Inflicts: atk.Condition{Kind: atk.ConditionNone, Rounds: 0}, Recharge: nil,
+		DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+		Area: atk.AreaZone, MaxTargets: 0,
+	}
+	creatures := []creat.Creature{
+		{
+			ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
+			STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+			IsDetachment: false, Conditions: []atk.Condition{poison},
+			Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil, Items: nil,
+			Zone: atk.ZoneEngaged,
+		},
+		{
+			ID: "monster-1", Name: "Root Goblin", Attacks: []atk.Attack{spear},
+			STR: 8, DEX: 14, WIL: 0, HP: 4, Armor: 0,
+			IsDetachment: false, Conditions: []atk.Condition{poison},
+			Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil, Items: nil,
+			Zone: atk.ZoneEngaged,
+		},
+	}
+
+	tickConditions(creatures)
+
+	if creatures[0].STR != 7 {
+		t.Errorf("tickConditions(): want STR 7, got %d", creatures[0].STR)
+	}
+	if creatures[1].STR != 8 {
+		t.Errorf(
+			"tickConditions(): out creature ticked, want STR 8, got %d",
+			creatures[1].STR,
+		)
+	}
+}
+
+func TestBlastTargets(t *testing.T) {
+	noCondition := atk.Condition{Kind: atk.ConditionNone, Rounds: 0}
+	fireball := atk.Attack{
+		Name: "Fireball", TargetCharacteristic: atk.STR,
+		Dice: dice.D8, DiceCnt: 1, Charges: -1,
+		IsBlast: true, Pool: atk.PoolHighest, Traits: atk.TraitReach,
+		PairedWith: 0, Inflicts: noCondition, Recharge: nil,
+		DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+		Area: atk.AreaZone, MaxTargets: 0,
+	}
+	spear, sweep := fireball, fireball
+	spear.Name, spear.IsBlast = "Spear", false
+	sweep.Name, sweep.Area, sweep.MaxTargets = "Sweep", atk.AreaAll, 2
+	actor := creat.Creature{
+		ID: "player-0", Name: "Tuck",
+		Attacks: []atk.Attack{
+			{
+				Name: "Spear", TargetCharacteristic: atk.STR,
+				Dice: dice.D6, DiceCnt: 1, Charges: -1,
+				IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+				PairedWith: 0,
+				Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+				Recharge:   nil, DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+				Area: atk.AreaZone, MaxTargets: 0,
+			},
+		},
+		Supports: []act.Support{
+			{
+				Name: "Healing Potion", Kind: act.SupportHeal,
+				Dice: dice.D6, DiceCnt: 1, Rounds: 0, Charges: 1,
+			},
+			{
+				Name: "Shield of Faith", Kind: act.SupportProtect,
+				Dice: 0, DiceCnt: 0, Rounds: 1, Charges: -1,
+			},
+		},
+		STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+		IsDetachment: false, Conditions: nil,
+		Spellbooks: nil, Fatigue: 0, IsDeprived: false, Zone: atk.ZoneEngaged,
+		Items: nil,
+	}
+	actor.Attacks = []atk.Attack{spear, fireball, sweep}
+	enemies := []creat.Creature{
+		actor.DeepCopy(), actor.DeepCopy(), actor.DeepCopy(), actor.DeepCopy(),
+	}
+	enemies[0].Zone = atk.ZoneFar
+	enemies[2].Zone = atk.ZoneNear
+	tests := []struct {
+		name    string
+		action  act.Action
+		targets []uint
+		want    []uint
+	}{
+		{
+			name: "NotBlast", action: act.Attack(0),
+			targets: []uint{0, 1, 2}, want: []uint{0, 1, 2},
+		},
+		{
+			name: "BlastKeepsFirstReachableZone", action: act.Attack(1),
+			targets: []uint{0, 1, 2, 3, 42}, want: []uint{1, 3},
+		},
+		{
+			name: "BlastNoneReachable", action: act.Attack(1),
+			targets: []uint{0, 42}, want: []uint{},
+		},
+		{
+			name: "BlastAreaAllWithMaxTargets", action: act.Attack(2),
+			targets: []uint{0, 1, 2, 3}, want: []uint{1, 2},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := blastTargets(&actor, test.action, test.targets, enemies)
+			if !slices.Equal(got, test.want) {
+				t.Fatalf("blastTargets() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestResolveMoves(t *testing.T) {
+	cleric := creat.Creature{
+		ID: "player-0", Name: "Tuck",
+		Attacks: []atk.Attack{
+			{
+				Name: "Spear", TargetCharacteristic: atk.STR,
+				Dice: dice.D6, DiceCnt: 1, Charges: -1,
+				IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+				PairedWith: 0,
+				Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+				Recharge:   nil, DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+				Area: atk.AreaZone, MaxTargets: 0,
+			},
+		},
+		Supports: []act.Support{
+			{
+				Name: "Healing Potion", Kind: act.SupportHeal,
+				Dice: dice.D6, DiceCnt: 1, Rounds: 0, Charges: 1,
+			},
+			{
+				Name: "Shield of Faith", Kind: act.SupportProtect,
+				Dice: 0, DiceCnt: 0, Rounds: 1, Charges: -1,
+			},
+		},
+		STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+		IsDetachment: false, Conditions: nil,
+		Spellbooks: nil, Fatigue: 0, IsDeprived: false, Zone: atk.ZoneEngaged,
+		Items: nil,
+	}
+	tests := []struct {
+		name             string
+		actions          []act.Action
+		wantZones        []atk.Zone
+		wantEnemiesZones []atk.Zone
+		want             bool
+	}{
+		{
+			name:             "MoveCloser",
+			actions:          []act.Action{act.Move(atk.ZoneEngaged), act.None()},
+			wantZones:        []atk.Zone{atk.ZoneEngaged, atk.ZoneNear},
+			wantEnemiesZones: []atk.Zone{atk.ZoneFar, atk.ZoneEngaged},
+			want:             true,
+		},
+		{
+			name:             "MoveAway",
+			actions:          []act.Action{act.Move(atk.ZoneFar), act.Attack(0)},
+			wantZones:        []atk.Zone{atk.ZoneFar, atk.ZoneNear},
+			wantEnemiesZones: []atk.Zone{atk.ZoneFar, atk.ZoneEngaged},
+			want:             false,
+		},
+		{
+			name:             "UnknownZone",
+			actions:          []act.Action{act.Move(atk.Zone(42)), act.None()},
+			wantZones:        []atk.Zone{atk.ZoneNear, atk.ZoneNear},
+			wantEnemiesZones: []atk.Zone{atk.ZoneFar, atk.ZoneEngaged},
+			want:             false,
+		},
+		{
+			name:             "OutCreatureDoesNotMove",
+			actions:          []act.Action{act.None(), act.Move(atk.ZoneEngaged)},
+			wantZones:        []atk.Zone{atk.ZoneNear, atk.ZoneNear},
+			wantEnemiesZones: []atk.Zone{atk.ZoneFar, atk.ZoneEngaged},
+			want:             false,
+		},
+		{
+			name:             "DifferentLengths",
+			actions:          []act.Action{act.Move(atk.ZoneEngaged)},
+			wantZones:        []atk.Zone{atk.ZoneNear, atk.ZoneNear},
+			wantEnemiesZones: []atk.Zone{atk.ZoneFar, atk.ZoneEngaged},
+			want:             false,
+		},
+		{
+			name:             "ChargePullsEnemyIntoMelee",
+			actions:          []act.Action{act.Charge(0), act.None()},
+			wantZones:        []atk.Zone{atk.ZoneNear, atk.ZoneNear},
+			wantEnemiesZones: []atk.Zone{atk.ZoneEngaged, atk.ZoneEngaged},
+			want:             true,
+		},
+		{
+			name:             "ChargeEngagedEnemy",
+			actions:          []act.Action{act.Charge(1), act.None()},
+			wantZones:        []atk.Zone{atk.ZoneNear, atk.ZoneNear},
+			wantEnemiesZones: []atk.Zone{atk.ZoneFar, atk.ZoneEngaged},
+			want:             false,
+		},
+		{
+			name:             "ChargeUnknownEnemy",
+			actions:          []act.Action{act.Charge(42), act.None()},
+			wantZones:        []atk.Zone{atk.ZoneNear, atk.ZoneNear},
+			wantEnemiesZones: []atk.Zone{atk.ZoneFar, atk.ZoneEngaged},
+			want:             false,
+		},
+		{
+			name:             "OutCreatureDoesNotCharge",
+			actions:          []act.Action{act.None(), act.Charge(0)},
+			wantZones:        []atk.Zone{atk.ZoneNear, atk.ZoneNear},
+			wantEnemiesZones: []atk.Zone{atk.ZoneFar, atk.ZoneEngaged},
+			want:             false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actors := []creat.Creature{cleric.DeepCopy(), cleric.DeepCopy()}
+			actors[0].Zone = atk.ZoneNear
+			actors[1].ID, actors[1].Zone, actors[1].STR = "player-1", atk.ZoneNear, 0
+
+			enemies := []creat.Creature{cleric.DeepCopy(), cleric.DeepCopy()}
+			enemies[0].ID, enemies[0].Zone = "monster-0", atk.ZoneFar
+			enemies[1].ID = "monster-1"
+
+			got := resolveMoves(actors, enemies, test.actions)
+
+			if got != test.want {
+				t.Errorf("resolveMoves() = %t, want %t", got, test.want)
+			}
+			for i, actor := range actors {
+				if actor.Zone != test.wantZones[i] {
+					t.Errorf(
+						"resolveMoves(): actor %d: want zone %s, got %s",
+						i, test.wantZones[i], actor.Zone,
+					)
+				}
+			}
+			for i, enemy := range enemies {
+				if enemy.Zone != test.wantEnemiesZones[i] {
+					t.Errorf(
+						"resolveMoves(): enemy %d: want zone %s, got %s",
+						i, test.wantEnemiesZones[i], enemy.Zone,
+					)
+				}
+			}
+		})
+	}
+}
+
+func TestResolveSupports(t *testing.T) {
+	cleric := creat.Creature{
+		ID: "player-0", Name: "Tuck",
+		Attacks: []atk.Attack{
+			{
+				Name: "Spear", TargetCharacteristic: atk.STR,
+				Dice: dice.D6, DiceCnt: 1, Charges: -1,
+				IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+				PairedWith: 0,
+				Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+				Recharge:   nil, DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+				Area: atk.AreaZone, MaxTargets: 0,
+			},
+		},
+		Supports: []act.Support{
+			{
+				Name: "Healing Potion", Kind: act.SupportHeal,
+				Dice: dice.D6, DiceCnt: 1, Rounds: 0, Charges: 1,
+			},
+			{
+				Name: "Shield of Faith", Kind: act.SupportProtect,
+				Dice: 0, DiceCnt: 0, Rounds: 1, Charges: -1,
+			},
+		},
+		STR: 8, DEX: 14, WIL: 8, HP: 4, MaxHP: 4, Armor: 0,
+		IsDetachment: false, Conditions: nil,
+		Spellbooks: nil, Fatigue: 0, IsDeprived: false, Zone: atk.ZoneEngaged,
+		Items: nil,
+	}
+	wounded, out := cleric.DeepCopy(), cleric.DeepCopy()
+	wounded.ID, wounded.HP = "player-1", 1
+	out.ID, out.STR = "player-2", 0
+	tests := []struct {
+		name              string
+		actions           []act.Action
+		targets           [][]uint
+		faces             []uint
+		wantHPs           []uint8
+		wantConditions    []atk.Condition
+		wantPotionCharges int8
+		wantIsChargeSpent bool
+	}{
+		{
+			name:    "HealCappedAtMaxHPOnFirstTarget",
+			actions: []act.Action{act.Use(0), act.None(), act.None()},
+			targets: [][]uint{{1, 0}, nil, nil},
+			faces:   []uint{6},
+			wantHPs: []uint8{4, 4, 4}, wantConditions: nil,
+			wantPotionCharges: 0, wantIsChargeSpent: true,
+		},
+		{
+			name:    "ProtectWithUnlimitedCharges",
+			actions: []act.Action{act.Use(1), act.None(), act.None()},
+			targets: [][]uint{{1}, nil, nil},
+			faces:   nil,
+			wantHPs: []uint8{4, 1, 4},
+			wantConditions: []atk.Condition{
+				{Kind: atk.ConditionProtected, Rounds: 1},
+			},
+			wantPotionCharges: 1, wantIsChargeSpent: false,
+		},
+		{
+			name:    "OutAllyIgnored",
+			actions: []act.Action{act.Use(0), act.None(), act.None()},
+			targets: [][]uint{{2}, nil, nil},
+			faces:   nil,
+			wantHPs: []uint8{4, 1, 4}, wantConditions: nil,
+			wantPotionCharges: 1, wantIsChargeSpent: false,
+		},
+		{
+			name:    "OutActorIgnored",
+			actions: []act.Action{act.None(), act.None(), act.Use(0)},
+			targets: [][]uint{nil, nil, {1}},
+			faces:   nil,
+			wantHPs: []uint8{4, 1, 4}, wantConditions: nil,
+			wantPotionCharges: 1, wantIsChargeSpent: false,
+		},
+		{
+			name:    "HostileActionsIgnored",
+			actions: []act.Action{act.Attack(0), act.None(), act.None()},
+			targets: [][]uint{{1}, nil, nil},
+			faces:   nil,
+			wantHPs: []uint8{4, 1, 4}, wantConditions: nil,
+			wantPotionCharges: 1, wantIsChargeSpent: false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actors := []creat.Creature{
+				cleric.DeepCopy(), wounded.DeepCopy(), out.DeepCopy(),
+			}
+			got := resolveSupports(
+				actors, test.actions, test.targets,
+				dicetest.NewSequence(t, test.faces...),
+			)
+			if got != test.wantIsChargeSpent {
+				t.Errorf(
+					"resolveSupports() = %t, want %t", got, test.wantIsChargeSpent,
+				)
+			}
+			for i, wantHP := range test.wantHPs {
+				if actors[i].HP != wantHP {
+					t.Errorf(
+						"resolveSupports(): want HP %d at idx %d, got %d",
+						wantHP, i, actors[i].HP,
+					)
+				}
+			}
+			if !slices.Equal(actors[1].Conditions, test.wantConditions) {
+				t.Errorf(
+					"resolveSupports(): want conditions %v, got %v",
+					test.wantConditions, actors[1].Conditions,
+				)
+			}
+			if charges := actors[0].Supports[0].Charges; charges !=
+				test.wantPotionCharges {
+				t.Errorf(
+					"resolveSupports(): want potion charges %d, got %d",
+					test.wantPotionCharges, charges,
+				)
+			}
+		})
+	}
+}
+
+func TestTickBenefits(t *testing.T) {
+	protected := atk.Condition{Kind: atk.ConditionProtected, Rounds: 1}
+	cleric := creat.Creature{
+		ID: "player-0", Name: "Tuck",
+		Attacks: []atk.Attack{
+			{
+				Name: "Spear", TargetCharacteristic: atk.STR,
+				Dice: dice.D6, DiceCnt: 1, Charges: -1,
+				IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+				PairedWith: 0,
+				Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+				Recharge:   nil, DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+				Area: atk.AreaZone, MaxTargets: 0,
+			},
+		},
+		Supports: []act.Support{
+			{
+				Name: "Healing Potion", Kind: act.SupportHeal,
+				Dice: dice.D6, DiceCnt: 1, Rounds: 0, Charges: 1,
+			},
+			{
+				Name: "Shield of Faith", Kind: act.SupportProtect,
+				Dice: 0, DiceCnt: 0, Rounds: 1, Charges: -1,
+			},
+		},
+		STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+		IsDetachment: false, Conditions: nil,
+		Spellbooks: nil, Fatigue: 0, IsDeprived: false, Zone: atk.ZoneEngaged,
+		Items: nil,
+	}
+	creatures := []creat.Creature{cleric, cleric.DeepCopy()}
+	creatures[0].Conditions = []atk.Condition{protected}
+	creatures[1].Conditions = []atk.Condition{protected}
+	creatures[1].ID, creatures[1].WIL = "player-1", 0
+
+	tickBenefits(creatures)
+
+	if len(creatures[0].Conditions) != 0 {
+		t.Errorf(
+			"tickBenefits(): want no conditions, got %v", creatures[0].Conditions,
+		)
+	}
+	if len(creatures[1].Conditions) != 1 {
+		t.Errorf(
+			"tickBenefits(): out creature ticked, want %v, got %v",
+			[]atk.Condition{protected}, creatures[1].Conditions,
+		)
+	}
+}
+
+func TestAnyTemporarilyParalysed(t *testing.T) {
+	spear := atk.Attack{
+		Name: "Spear", TargetCharacteristic: atk.STR,
+		Dice: dice.D6, DiceCnt: 1, Charges: -1,
+		IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone, PairedWith: 0,
+		Inflicts: atk.Condition{Kind: atk.ConditionNone, Rounds: 0}, Recharge: nil,
+		DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+		Area: atk.AreaZone, MaxTargets: 0,
+	}
+	goblin := func(str uint8, condition atk.Condition) creat.Creature {
+		return creat.Creature{
+			ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
+			STR: str, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+			IsDetachment: false, Conditions: []atk.Condition{condition},
+			Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil, Items: nil,
+			Zone: atk.ZoneEngaged,
+		}
+	}
+	tests := []struct {
+		name      string
+		creatures []creat.Creature
+		want      bool
+	}{
+		{
+			name: "Timed",
+			creatures: []creat.Creature{
+				goblin(8, atk.Condition{Kind: atk.ConditionPoisoned, Rounds: 1}),
+				goblin(8, atk.Condition{Kind: atk.ConditionParalysed, Rounds: 1}),
+			},
+			want: true,
+		},
+		{
+			name: "UntilTheEnd",
+			creatures: []creat.Creature{
+				goblin(8, atk.Condition{Kind: atk.ConditionParalysed, Rounds: -1}),
+			},
+			want: false,
+		},
+		{
+			name: "Out",
+			creatures: []creat.Creature{
+				goblin(0, atk.Condition{Kind: atk.ConditionParalysed, Rounds: 1}),
+			},
+			want: false,
+		},
+		{
+			name: "NotParalysed",
+			creatures: []creat.Creature{
+				goblin(8, atk.Condition{Kind: atk.ConditionBlinded, Rounds: 1}),
+			},
+			want: false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := anyTemporarilyParalysed(test.creatures); got != test.want {
+				t.Fatalf("anyTemporarilyParalysed(): want %t, got %t", test.want, got)
+			}
+		})
+	}
+}
+
+func TestAnyRecharging(t *testing.T) {
+	noCondition := atk.Condition{Kind: atk.ConditionNone, Rounds: 0}
+	dragon := func(str uint8, charges int8) creat.Creature {
+		return creat.Creature{
+			ID: "monster-0", Name: "Red Dragon",
+			Attacks: []atk.Attack{
+				{
+					Name: "Fire Breath", TargetCharacteristic: atk.STR,
+					Dice: dice.D12, DiceCnt: 1, Charges: charges,
+					IsBlast: true, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+					PairedWith: 0, Inflicts: noCondition,
+					Recharge: &atk.Recharge{
+						Kind: atk.RechargeOnRoll, Rounds: 0, Dice: dice.D6,
+						Threshold: 5, MaxCharges: 1, Elapsed: 0,
+					},
+					DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+					Area: atk.AreaZone, MaxTargets: 0,
+				},
+			},
+			STR: str, DEX: 12, WIL: 16, HP: 20, Armor: 3,
+			IsDetachment: false, Conditions: nil,
+			Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil, Items: nil,
+			Zone: atk.ZoneEngaged,
+		}
+	}
+	tests := []struct {
+		name      string
+		creatures []creat.Creature
+		want      bool
+	}{
+		{
+			name:      "Recharging",
+			creatures: []creat.Creature{dragon(18, 1), dragon(18, 0)},
+			want:      true,
+		},
+		{
+			name:      "FullCharges",
+			creatures: []creat.Creature{dragon(18, 1)},
+			want:      false,
+		},
+		{
+			name:      "Out",
+			creatures: []creat.Creature{dragon(0, 0)},
+			want:      false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := anyRecharging(test.creatures); got != test.want {
+				t.Fatalf("anyRecharging(): want %t, got %t", test.want, got)
+			}
+		})
+	}
+}
+
 func TestAllOut(t *testing.T) {
 	spear := atk.Attack{
 		Name: "Spear", TargetCharacteristic: atk.STR,
 		Dice: dice.D6, DiceCnt: 1, Charges: -1,
-		IsBlast: false,
+		IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone, PairedWith: 0,
+		Inflicts: atk.Condition{Kind: atk.ConditionNone, Rounds: 0}, Recharge: nil,
+		DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+		Area: atk.AreaZone, MaxTargets: 0,
 	}
 	tests := []struct {
 		name      string
@@ -3177,17 +6634,23 @@ func TestAllOut(t *testing.T) {
 				{
 					ID: "player-0", Name: "John Appleseed", Attacks: []atk.Attack{spear},
 					STR: 0, DEX: 14, WIL: 8, HP: 0, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "player-1", Name: "Jane Appleseed", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 0, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "player-2", Name: "John Doe", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 0, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 			want: true,
@@ -3198,17 +6661,23 @@ func TestAllOut(t *testing.T) {
 				{
 					ID: "player-0", Name: "John Appleseed", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 0, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "player-1", Name: "Jane Appleseed", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "player-2", Name: "John Doe", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 0, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 			want: false,
@@ -3219,17 +6688,23 @@ func TestAllOut(t *testing.T) {
 				{
 					ID: "player-0", Name: "John Appleseed", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "player-1", Name: "Jane Appleseed", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "player-2", Name: "John Doe", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 			want: false,
@@ -0,0 +1,209 @@
+// Package bestiary provides a catalog of monster stat blocks. The built-in
+// catalog is embedded from JSON data files, and teams can layer their own
+// files over it, e.g. to tweak a monster or to add new ones.
+//
+// A data file holds a list of monsters:
+//
+//	{
+//	  "monsters": [
+//	    {
+//	      "name": "Root Goblin",
+//	      "tags": ["goblin", "forest"],
+//	      "hp": 4, "armor": 0, "str": 8, "dex": 14, "wil": 8,
+//	      "attacks": [{"name": "Spear", "dice": "d6"}]
+//	    }
+//	  ]
+//	}
+//
+// A monster is a creature in the creat.Creature JSON encoding along with its
+// "tags", the ID defaults to the monster's slug and the max HP to its HP, see
+// creat.Creature.UnmarshalJSON. So an attack targets STR with a single die and
+// has unlimited charges unless its "target", "diceCnt", and "charges" say
+// otherwise, see atk.Attack.UnmarshalJSON.
+// Every monster is validated with creat.Creature.Validate at load time.
+package bestiary
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/rozag/cabasi/creat"
+)
+
+// builtin holds the data files of the built-in catalog.
+//
+//go:embed data/*.json
+var builtin embed.FS
+
+// Entry is a monster of the catalog. Creature is its stat block with the
+// monster's slug as the ID, see Slug.
+type Entry struct {
+	Creature creat.Creature
+	Tags     []string
+}
+
+// Catalog is a collection of monsters looked up by name or tag. Names and tags
+// are case-insensitive.
+type Catalog struct {
+	entries map[string]Entry
+}
+
+// New creates an empty Catalog.
+func New() *Catalog {
+	return &Catalog{entries: make(map[string]Entry)}
+}
+
+// Builtin creates a Catalog of the built-in monsters.
+func Builtin() (*Catalog, error) {
+	data, err := fs.Sub(builtin, "data")
+	if err != nil {
+		return nil, fmt.Errorf("failed to open built-in data: %w", err)
+	}
+
+	catalog := New()
+	if err := catalog.Load(data); err != nil {
+		return nil, fmt.Errorf("failed to load built-in data: %w", err)
+	}
+	return catalog, nil
+}
+
+// Load loads all the "*.json" data files at the root of the file system into
+// the Catalog. The loaded monsters replace the Catalog's monsters with the
+// same name, so catalogs can be layered by loading them one after another. A
+// monster can be defined only once per Load though.
+//
+// Load returns an error with `Unwrap() []error` method to get all the errors
+// if any file or monster is invalid, the Catalog is left unchanged then.
+func (c *Catalog) Load(fsys fs.FS) error {
+	paths, err := fs.Glob(fsys, "*.json")
+	if err != nil {
+		return fmt.Errorf("failed to list data files: %w", err)
+	}
+
+	var errs []error
+	loaded := make(map[string]Entry)
+	sources := make(map[string]string)
+	for _, path := range paths {
+		entries, err := readFile(fsys, path)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", path, err))
+			continue
+		}
+
+		for _, entry := range entries {
+			key := strings.ToLower(entry.Creature.Name)
+			if source, ok := sources[key]; ok {
+				errs = append(errs, fmt.Errorf(
+					"%s: monster %q is already defined in %s",
+					path, entry.Creature.Name, source,
+				))
+				continue
+			}
+
+			sources[key] = path
+			loaded[key] = entry
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	maps.Copy(c.entries, loaded)
+	return nil
+}
+
+// Names returns the names of all the monsters of the Catalog in alphabetical
+// order.
+func (c *Catalog) Names() []string {
+	names := make([]string, 0, len(c.entries))
+	for _, entry := range c.entries {
+		names = append(names, entry.Creature.Name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// Lookup returns a copy of the monster with the provided name and true if the
+// Catalog has it, otherwise it returns false.
+func (c *Catalog) Lookup(name string) (Entry, bool) {
+	entry, ok := c.entries[strings.ToLower(name)]
+	if !ok {
+		var none Entry
+		return none, false
+	}
+	return Entry{
+		Creature: entry.Creature.DeepCopy(),
+		Tags:     slices.Clone(entry.Tags),
+	}, true
+}
+
+// Tagged returns the names of the monsters with the provided tag in
+// alphabetical order.
+func (c *Catalog) Tagged(tag string) []string {
+	var names []string
+	for _, entry := range c.entries {
+		if slices.ContainsFunc(entry.Tags, func(t string) bool {
+			return strings.EqualFold(t, tag)
+		}) {
+			names = append(names, entry.Creature.Name)
+		}
+	}
+	slices.Sort(names)
+	return names
+}
+
+// Template returns a creat.Template of the monster with the provided name and
+// true if the Catalog has it, otherwise it returns false. The template spawns
+// instances with IDs made of the monster's slug, e.g. "root-goblin-1", and
+// keeps the monster's HP.
+func (c *Catalog) Template(name string) (creat.Template, bool) {
+	entry, ok := c.entries[strings.ToLower(name)]
+	if !ok {
+		var none creat.Template
+		return none, false
+	}
+	return creat.Template{
+		Creature: entry.Creature.DeepCopy(), HPDice: 0, HPDiceCnt: 0,
+	}, true
+}
+
+// Spawn returns cnt copies of the monster with the provided name taking their
+// IDs from the registry, e.g. "root-goblin-1", "root-goblin-2", and so on.
+// Share the registry across spawns to keep the IDs unique in a battle.
+func (c *Catalog) Spawn(
+	ids *creat.Registry, name string, cnt uint,
+) ([]creat.Creature, error) {
+	tmpl, ok := c.Template(name)
+	if !ok {
+		return nil, fmt.Errorf("unknown monster %q", name)
+	}
+	// The template has no HP dice, so there's nothing to roll.
+	return tmpl.Spawn(ids, cnt, nil), nil
+}
+
+// Slug returns the lowercase name with every run of characters other than
+// ASCII letters and digits replaced by a hyphen, e.g. "root-goblin" for
+// "Root Goblin".
+func Slug(name string) string {
+	var sb strings.Builder
+	pendingHyphen := false
+	for _, r := range strings.ToLower(name) {
+		if (r < 'a' || r > 'z') && (r < '0' || r > '9') {
+			pendingHyphen = sb.Len() > 0
+			continue
+		}
+
+		if pendingHyphen {
+			sb.WriteByte('-')
+			pendingHyphen = false
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
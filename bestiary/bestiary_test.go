@@ -0,0 +1,301 @@
+package bestiary
+
+import (
+	"slices"
+	"testing"
+	"testing/fstest"
+
+	"github.com/rozag/cabasi/creat"
+	"github.com/rozag/cabasi/dice/dicetest"
+)
+
+func TestBuiltin(t *testing.T) {
+	catalog, err := Builtin()
+	if err != nil {
+		t.Fatalf("Builtin(): want nil error, got %v", err)
+	}
+
+	names := catalog.Names()
+	if len(names) == 0 {
+		t.Fatalf("Builtin(): want monsters, got none")
+	}
+	for _, name := range names {
+		entry, ok := catalog.Lookup(name)
+		if !ok {
+			t.Fatalf("Catalog.Lookup(%q): want monster, got none", name)
+		}
+		if err := entry.Creature.Validate(); err != nil {
+			t.Errorf("Catalog.Lookup(%q): want valid monster, got %v", name, err)
+		}
+	}
+}
+
+func newGoblinFS(hp string) fstest.MapFS {
+	return fstest.MapFS{
+		"goblins.json": &fstest.MapFile{
+			Data: []byte(`{"monsters": [
+				{
+					"name": "Root Goblin", "tags": ["Goblin", "forest"],
+					"hp": ` + hp + `, "armor": 0, "str": 8, "dex": 14, "wil": 8,
+					"attacks": [{"name": "Spear", "dice": "d6"}]
+				},
+				{
+					"name": "Goblin Archer", "tags": ["goblin"],
+					"hp": 3, "armor": 0, "str": 8, "dex": 14, "wil": 8,
+					"attacks": [
+						{"name": "Shortbow", "dice": "D6", "traits": "ranged"}
+					]
+				}
+			]}`),
+		},
+	}
+}
+
+func TestCatalogLoad(t *testing.T) {
+	tests := []struct {
+		name      string
+		fsys      fstest.MapFS
+		wantNames []string
+		wantErr   bool
+	}{
+		{
+			name:      "Valid",
+			fsys:      newGoblinFS("4"),
+			wantNames: []string{"Goblin Archer", "Root Goblin"},
+			wantErr:   false,
+		},
+		{
+			name: "IgnoresOtherFiles",
+			fsys: fstest.MapFS{
+				"README.md": &fstest.MapFile{Data: []byte("# Goblins")},
+			},
+			wantNames: nil,
+			wantErr:   false,
+		},
+		{
+			name: "MalformedFile",
+			fsys: fstest.MapFS{
+				"broken.json": &fstest.MapFile{Data: []byte(`{"monsters": [`)},
+			},
+			wantNames: nil,
+			wantErr:   true,
+		},
+		{
+			name: "UnknownField",
+			fsys: fstest.MapFS{
+				"typo.json": &fstest.MapFile{Data: []byte(`{"monstres": []}`)},
+			},
+			wantNames: nil,
+			wantErr:   true,
+		},
+		{
+			name:      "InvalidMonster",
+			fsys:      newGoblinFS("0"),
+			wantNames: nil,
+			wantErr:   true,
+		},
+		{
+			name: "DuplicateInSingleLoad",
+			fsys: fstest.MapFS{
+				"a.json": newGoblinFS("4")["goblins.json"],
+				"b.json": newGoblinFS("5")["goblins.json"],
+			},
+			wantNames: nil,
+			wantErr:   true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			catalog := New()
+			err := catalog.Load(test.fsys)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("Catalog.Load(): want error %t, got %v", test.wantErr, err)
+			}
+			if got := catalog.Names(); !slices.Equal(got, test.wantNames) {
+				t.Fatalf("Catalog.Names() = %v, want %v", got, test.wantNames)
+			}
+		})
+	}
+}
+
+func TestCatalogLoadLayers(t *testing.T) {
+	catalog, err := Builtin()
+	if err != nil {
+		t.Fatalf("Builtin(): want nil error, got %v", err)
+	}
+	builtinCnt := len(catalog.Names())
+
+	if err := catalog.Load(newGoblinFS("6")); err != nil {
+		t.Fatalf("Catalog.Load(): want nil error, got %v", err)
+	}
+
+	if got := len(catalog.Names()); got != builtinCnt+1 {
+		t.Errorf("Catalog.Names(): want %d monsters, got %d", builtinCnt+1, got)
+	}
+	goblin, ok := catalog.Lookup("Root Goblin")
+	if !ok || goblin.Creature.HP != 6 {
+		t.Errorf(
+			"Catalog.Lookup(): want the layered Root Goblin with HP 6, got %v",
+			&goblin.Creature,
+		)
+	}
+
+	if err := catalog.Load(newGoblinFS("0")); err == nil {
+		t.Fatalf("Catalog.Load(): want error for invalid layer, got nil")
+	}
+	goblin, _ = catalog.Lookup("Root Goblin")
+	if goblin.Creature.HP != 6 {
+		t.Errorf(
+			"Catalog.Load(): invalid layer must leave the catalog unchanged, got %v",
+			&goblin.Creature,
+		)
+	}
+}
+
+func TestCatalogLookup(t *testing.T) {
+	catalog := New()
+	if err := catalog.Load(newGoblinFS("4")); err != nil {
+		t.Fatalf("Catalog.Load(): want nil error, got %v", err)
+	}
+
+	entry, ok := catalog.Lookup("goblin ARCHER")
+	if !ok {
+		t.Fatalf("Catalog.Lookup(): want case-insensitive match, got none")
+	}
+	if entry.Creature.ID != "goblin-archer" ||
+		entry.Creature.Name != "Goblin Archer" ||
+		len(entry.Creature.Attacks) != 1 ||
+		entry.Creature.Attacks[0].Charges != -1 ||
+		entry.Creature.Attacks[0].DiceCnt != 1 {
+		t.Errorf("Catalog.Lookup(): unexpected monster %v", &entry.Creature)
+	}
+
+	entry.Creature.Attacks[0].Name = "Longbow"
+	entry.Tags[0] = "orc"
+	again, _ := catalog.Lookup("Goblin Archer")
+	if again.Creature.Attacks[0].Name != "Shortbow" || again.Tags[0] != "goblin" {
+		t.Errorf("modifying the looked up monster affected the catalog")
+	}
+
+	if _, ok := catalog.Lookup("Hobgoblin"); ok {
+		t.Errorf("Catalog.Lookup(): want no match for unknown monster")
+	}
+}
+
+func TestCatalogTagged(t *testing.T) {
+	catalog := New()
+	if err := catalog.Load(newGoblinFS("4")); err != nil {
+		t.Fatalf("Catalog.Load(): want nil error, got %v", err)
+	}
+
+	tests := []struct {
+		tag  string
+		want []string
+	}{
+		{tag: "goblin", want: []string{"Goblin Archer", "Root Goblin"}},
+		{tag: "FOREST", want: []string{"Root Goblin"}},
+		{tag: "undead", want: nil},
+	}
+	for _, test := range tests {
+		t.Run(test.tag, func(t *testing.T) {
+			if got := catalog.Tagged(test.tag); !slices.Equal(got, test.want) {
+				t.Fatalf("Catalog.Tagged() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestCatalogSpawn(t *testing.T) {
+	catalog := New()
+	if err := catalog.Load(newGoblinFS("4")); err != nil {
+		t.Fatalf("Catalog.Load(): want nil error, got %v", err)
+	}
+
+	ids := creat.NewRegistry()
+	goblins, err := catalog.Spawn(ids, "Root Goblin", 2)
+	if err != nil {
+		t.Fatalf("Catalog.Spawn(): want nil error, got %v", err)
+	}
+	more, err := catalog.Spawn(ids, "Root Goblin", 1)
+	if err != nil {
+		t.Fatalf("Catalog.Spawn(): want nil error, got %v", err)
+	}
+	goblins = append(goblins, more...)
+	wantIDs := []creat.ID{"root-goblin-1", "root-goblin-2", "root-goblin-3"}
+	if len(goblins) != len(wantIDs) {
+		t.Fatalf(
+			"Catalog.Spawn(): want %d monsters, got %d", len(wantIDs), len(goblins),
+		)
+	}
+	for idx, id := range wantIDs {
+		if goblins[idx].ID != id {
+			t.Errorf("Catalog.Spawn(): want ID %s, got %s", id, goblins[idx].ID)
+		}
+	}
+
+	goblins[0].Attacks[0].Name = "Pitchfork"
+	if goblins[1].Attacks[0].Name != "Spear" {
+		t.Errorf("modifying a spawned monster affected another one")
+	}
+
+	if _, err := catalog.Spawn(ids, "Hobgoblin", 1); err == nil {
+		t.Errorf("Catalog.Spawn(): want error for unknown monster, got nil")
+	}
+}
+
+func TestCatalogTemplate(t *testing.T) {
+	catalog := New()
+	if err := catalog.Load(newGoblinFS("4")); err != nil {
+		t.Fatalf("Catalog.Load(): want nil error, got %v", err)
+	}
+
+	tmpl, ok := catalog.Template("root goblin")
+	if !ok {
+		t.Fatalf("Catalog.Template(): want the root goblin, got none")
+	}
+	if err := tmpl.Validate(); err != nil {
+		t.Fatalf("Catalog.Template(): want a valid template, got %v", err)
+	}
+
+	ids := creat.NewRegistry()
+	if err := ids.Reserve("root-goblin-1"); err != nil {
+		t.Fatalf("Registry.Reserve(): want nil error, got %v", err)
+	}
+	goblins := tmpl.Spawn(ids, 2, dicetest.Min{})
+	if goblins[0].ID != "root-goblin-2" || goblins[1].ID != "root-goblin-3" {
+		t.Fatalf(
+			"Template.Spawn(): want root-goblin-2 and root-goblin-3, got %s and %s",
+			goblins[0].ID, goblins[1].ID,
+		)
+	}
+
+	tmpl.Creature.Attacks[0].Name = "Pitchfork"
+	again, _ := catalog.Template("Root Goblin")
+	if again.Creature.Attacks[0].Name != "Spear" {
+		t.Errorf("modifying a template affected the catalog")
+	}
+
+	if _, ok := catalog.Template("Hobgoblin"); ok {
+		t.Errorf("Catalog.Template(): want false for unknown monster, got true")
+	}
+}
+
+func TestSlug(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{name: "Root Goblin", want: "root-goblin"},
+		{name: "  Bandit   Gang ", want: "bandit-gang"},
+		{name: "Will-o'-the-Wisp", want: "will-o-the-wisp"},
+		{name: "Ogre 2", want: "ogre-2"},
+		{name: "", want: ""},
+	}
+	for _, test := range tests {
+		t.Run(test.want, func(t *testing.T) {
+			if got := Slug(test.name); got != test.want {
+				t.Fatalf("Slug(%q) = %q, want %q", test.name, got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package bestiary
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"strings"
+
+	"github.com/rozag/cabasi/creat"
+	"github.com/rozag/cabasi/internal/codec"
+)
+
+// fileData is the content of a data file. Monsters are kept raw to decode
+// them one by one, so the errors report the failed monster.
+type fileData struct {
+	Monsters []json.RawMessage `json:"monsters"`
+}
+
+// readFile reads the monsters of the data file at the provided path. Unknown
+// fields are reported as errors to catch typos.
+func readFile(fsys fs.FS, path string) ([]Entry, error) {
+	content, err := fs.ReadFile(fsys, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read: %w", err)
+	}
+
+	var data fileData
+	if err := codec.DecodeJSON(content, &data); err != nil {
+		return nil, fmt.Errorf("failed to decode: %w", err)
+	}
+
+	var errs []error
+	entries := make([]Entry, 0, len(data.Monsters))
+	for idx, monster := range data.Monsters {
+		entry, err := decodeEntry(monster)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("invalid monster at idx %d: %w", idx, err))
+			continue
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, errors.Join(errs...)
+}
+
+// decodeEntry decodes a monster of a data file to a catalog Entry and
+// validates it. A monster is a creature in the creat.Creature JSON encoding
+// wrapped with its "tags", the ID defaults to the monster's slug, see Slug.
+// Errors of the fields are reported as *codec.FieldError, e.g.
+// "attacks[0].dice".
+func decodeEntry(data []byte) (Entry, error) {
+	var none Entry
+
+	var fields map[string]json.RawMessage
+	if err := codec.DecodeJSON(data, &fields); err != nil {
+		// Suppressing wrapcheck "error returned from external package is
+		// unwrapped" because the error already reports the failed field.
+		return none, err // nolint:wrapcheck
+	}
+
+	var errs []error
+
+	var tags []string
+	if raw, ok := fields["tags"]; ok {
+		delete(fields, "tags")
+		if err := codec.DecodeJSON(raw, &tags); err != nil {
+			errs = append(errs, codec.WrapField("tags", err))
+		}
+	}
+	for idx, tag := range tags {
+		tags[idx] = strings.ToLower(tag)
+	}
+
+	rest, err := json.Marshal(fields)
+	if err != nil {
+		return none, fmt.Errorf("failed to encode creature: %w", err)
+	}
+
+	var creature creat.Creature
+	if err := creature.UnmarshalJSON(rest); err != nil {
+		errs = append(errs, err)
+	}
+	if len(errs) > 0 {
+		return none, errors.Join(errs...)
+	}
+
+	if len(creature.ID) == 0 {
+		creature.ID = creat.ID(Slug(creature.Name))
+	}
+	if err := creature.Validate(); err != nil {
+		return none, err
+	}
+
+	return Entry{Creature: creature, Tags: tags}, nil
+}
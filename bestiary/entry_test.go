@@ -0,0 +1,186 @@
+package bestiary
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/rozag/cabasi/atk"
+	"github.com/rozag/cabasi/creat"
+	"github.com/rozag/cabasi/dice"
+	"github.com/rozag/cabasi/internal/codec"
+)
+
+func TestDecodeEntry(t *testing.T) {
+	tests := []struct {
+		name        string
+		attack      string
+		wantPaths   []string
+		wantDice    dice.Dice
+		wantTarget  atk.Characteristic
+		wantTraits  atk.Traits
+		wantCnt     uint8
+		wantCharges int8
+	}{
+		{
+			name:      "Defaults",
+			attack:    `{"name": "Spear", "dice": "d6"}`,
+			wantPaths: nil, wantDice: dice.D6, wantTarget: atk.STR,
+			wantTraits: atk.TraitNone, wantCnt: 1, wantCharges: -1,
+		},
+		{
+			name: "Explicit",
+			attack: `{
+				"name": "Fire Breath", "dice": "D12", "target": "wil",
+				"traits": "Magical|armorpiercing", "diceCnt": 2, "dmgMod": 1,
+				"charges": 1, "isBlast": true
+			}`,
+			wantPaths: nil, wantDice: dice.D12, wantTarget: atk.WIL,
+			wantTraits: atk.TraitMagical | atk.TraitArmorPiercing, wantCnt: 2,
+			wantCharges: 1,
+		},
+		{
+			name: "UnknownValues",
+			attack: `{
+				"name": "Spear", "dice": "d7", "target": "CON", "traits": "Sharp"
+			}`,
+			wantPaths: []string{
+				"attacks[0].target", "attacks[0].dice", "attacks[0].traits",
+			},
+			wantDice: 0, wantTarget: 0, wantTraits: atk.TraitNone,
+			wantCnt: 0, wantCharges: 0,
+		},
+		{
+			name:      "InvalidAttack",
+			attack:    `{"name": "Spear", "dice": "d6", "diceCnt": 0}`,
+			wantPaths: []string{"attacks[0].diceCnt"}, wantDice: dice.D6,
+			wantTarget: atk.STR, wantTraits: atk.TraitNone,
+			wantCnt: 0, wantCharges: -1,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			monster := `{
+				"name": "Root Goblin", "tags": ["Goblin"],
+				"hp": 4, "armor": 0, "str": 8, "dex": 14, "wil": 8,
+				"attacks": [` + test.attack + `]
+			}`
+
+			entry, err := decodeEntry([]byte(monster))
+			if paths := fieldPaths(err); !slices.Equal(paths, test.wantPaths) {
+				t.Fatalf(
+					"decodeEntry(): want paths %v, got %v (%v)",
+					test.wantPaths, paths, err,
+				)
+			}
+			if err != nil {
+				return
+			}
+
+			attack := entry.Creature.Attacks[0]
+			if attack.Dice != test.wantDice ||
+				attack.TargetCharacteristic != test.wantTarget ||
+				attack.Traits != test.wantTraits ||
+				attack.DiceCnt != test.wantCnt ||
+				attack.Charges != test.wantCharges {
+				t.Errorf("decodeEntry(): unexpected attack %v", &attack)
+			}
+		})
+	}
+}
+
+func TestDecodeEntryCreature(t *testing.T) {
+	tests := []struct {
+		name      string
+		monster   string
+		wantPaths []string
+		wantID    creat.ID
+		wantTags  []string
+		wantMaxHP uint8
+	}{
+		{
+			name: "Defaults",
+			monster: `{
+				"name": "Root Goblin", "tags": ["Goblin", "forest"],
+				"hp": 4, "armor": 0, "str": 8, "dex": 14, "wil": 8,
+				"attacks": [{"name": "Spear", "dice": "d6"}]
+			}`,
+			wantPaths: nil, wantID: "root-goblin",
+			wantTags: []string{"goblin", "forest"}, wantMaxHP: 4,
+		},
+		{
+			name: "Explicit",
+			monster: `{
+				"id": "goblin", "name": "Root Goblin", "zone": "Near",
+				"hp": 4, "maxHP": 6, "armor": 0, "str": 8, "dex": 14, "wil": 8,
+				"attacks": [{"name": "Spear", "dice": "d6"}]
+			}`,
+			wantPaths: nil, wantID: "goblin", wantTags: nil, wantMaxHP: 6,
+		},
+		{
+			name: "UnknownField",
+			monster: `{
+				"name": "Root Goblin", "tags": ["goblin"], "hit": 4,
+				"attacks": [{"name": "Spear", "dice": "d6"}]
+			}`,
+			wantPaths: []string{"hit"}, wantID: "", wantTags: nil, wantMaxHP: 0,
+		},
+		{
+			name: "InvalidTagsAndCreature",
+			monster: `{
+				"name": "Root Goblin", "tags": "goblin",
+				"hp": 4, "armor": 0, "str": "8", "dex": 14, "wil": 8,
+				"attacks": [{"name": "Spear", "dice": "d6"}]
+			}`,
+			wantPaths: []string{"tags", "str"},
+			wantID:    "", wantTags: nil, wantMaxHP: 0,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			entry, err := decodeEntry([]byte(test.monster))
+			if paths := fieldPaths(err); !slices.Equal(paths, test.wantPaths) {
+				t.Fatalf(
+					"decodeEntry(): want paths %v, got %v (%v)",
+					test.wantPaths, paths, err,
+				)
+			}
+			if len(test.wantID) == 0 {
+				if err == nil {
+					t.Fatalf("decodeEntry(): want error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("decodeEntry(): want nil error, got %v", err)
+			}
+
+			c := entry.Creature
+			if c.ID != test.wantID || c.MaxHP != test.wantMaxHP ||
+				!slices.Equal(entry.Tags, test.wantTags) {
+				t.Errorf(
+					"decodeEntry(): want %s with MaxHP %d tagged %v, "+
+						"got %s with MaxHP %d tagged %v",
+					test.wantID, test.wantMaxHP, test.wantTags,
+					c.ID, c.MaxHP, entry.Tags,
+				)
+			}
+		})
+	}
+}
+
+func fieldPaths(err error) []string {
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		var result []string
+		for _, err := range joined.Unwrap() {
+			result = append(result, fieldPaths(err)...)
+		}
+		return result
+	}
+
+	var fieldErr *codec.FieldError
+	if errors.As(err, &fieldErr) {
+		return []string{fieldErr.Path}
+	}
+	return nil
+}
@@ -0,0 +1,132 @@
+// Package chargen rolls random Cairn player characters: characteristics, HP,
+// a background, starting gear, and a name. Characters rolled with the same
+// seeded RNG are the same, e.g. with a dice.Streams sub-stream.
+package chargen
+
+import (
+	"github.com/rozag/cabasi/atk"
+	"github.com/rozag/cabasi/creat"
+	"github.com/rozag/cabasi/dice"
+	"github.com/rozag/cabasi/item"
+)
+
+// characteristicDiceCnt is the number of d6 rolled for a characteristic.
+const characteristicDiceCnt = 3
+
+// Character is a rolled player character along with the background it comes
+// from.
+type Character struct {
+	Background string
+	Creature   creat.Creature
+}
+
+// Generator rolls random characters with the provided RNG.
+type Generator struct {
+	rng dice.RNG
+}
+
+// NewGenerator creates a new Generator rolling with the provided RNG.
+func NewGenerator(rng dice.RNG) *Generator {
+	return &Generator{rng: rng}
+}
+
+// Character rolls a new character taking its ID from the registry, e.g.
+// "player-1". Share the registry with the monsters' spawns to keep the IDs
+// unique in a battle. The rolls go in the order of Cairn's character
+// creation: a name, 3d6 for STR, DEX, and WIL in order, d6 for HP, a
+// background, armor, a helmet and a shield, and a weapon. The character
+// carries rations, a torch, and the gear of its background, and has its armor
+// and weapon equipped. The character starts engaged, placing it is up to the
+// caller.
+func (g *Generator) Character(ids *creat.Registry) Character {
+	id := ids.Next("player")
+
+	name := rollName(g.rng)
+	str := g.rollCharacteristic(name, atk.STR)
+	dex := g.rollCharacteristic(name, atk.DEX)
+	wil := g.rollCharacteristic(name, atk.WIL)
+	dice.Announcef(g.rng, "%s's HP", name)
+	hp := dice.D6.Roll(g.rng)
+	dice.Unannounce(g.rng)
+
+	bg := rollOnList(g.rng, name+"'s background", backgrounds())
+	items := []item.Item{gear("Rations"), gear("Torch"), gear(bg.gear)}
+	items = append(items, rollOnTable(g.rng, name+"'s armor", armors())...)
+	items = append(
+		items,
+		rollOnTable(g.rng, name+"'s helmet and shield", helmetsAndShields())...,
+	)
+	items = append(items, rollOnTable(g.rng, name+"'s weapon", weapons()))
+
+	character := creat.Creature{
+		ID: id, Name: name, Attacks: nil, Spellbooks: nil, Supports: nil,
+		Items: items, Conditions: nil,
+		STR: str, DEX: dex, WIL: wil, HP: hp,
+		MaxHP: hp, Scars: nil, Armor: 0, Fatigue: 0,
+		Zone: atk.ZoneEngaged, IsDetachment: false, IsDeprived: false,
+	}
+	character.Equip()
+	return Character{Background: bg.name, Creature: character}
+}
+
+// Party rolls the provided number of characters taking their IDs from the
+// registry and returns their creatures.
+func (g *Generator) Party(ids *creat.Registry, size uint) []creat.Creature {
+	party := make([]creat.Creature, 0, size)
+	for range size {
+		party = append(party, g.Character(ids).Creature)
+	}
+	return party
+}
+
+// rollCharacteristic rolls 3d6 for the characteristic of the character with
+// the provided name.
+func (g *Generator) rollCharacteristic(
+	name string,
+	characteristic atk.Characteristic,
+) uint8 {
+	dice.Announcef(g.rng, "%s's %s", name, characteristic)
+	sum := uint8(0)
+	for range characteristicDiceCnt {
+		sum += dice.D6.Roll(g.rng)
+	}
+	dice.Unannounce(g.rng)
+	return sum
+}
+
+// rollName rolls a first name and a surname.
+func rollName(rng dice.RNG) string {
+	first := rollOnList(rng, "first name", firstNames())
+	surname := rollOnList(rng, "surname", surnames())
+	return first + " " + surname
+}
+
+// rollOnList rolls d20 for the described purpose and returns the list entry
+// at the roll, the list must have an entry per face.
+func rollOnList[T any](rng dice.RNG, what string, list []T) T {
+	dice.Announcef(rng, "%s", what)
+	roll := dice.D20.Roll(rng)
+	dice.Unannounce(rng)
+	return list[roll-1]
+}
+
+// row is a row of a d20 table, it covers the rolls from the previous row's
+// upTo exclusive to its own upTo inclusive.
+type row[T any] struct {
+	value T
+	upTo  uint8
+}
+
+// rollOnTable rolls d20 for the described purpose and returns the value of
+// the table row covering the roll.
+func rollOnTable[T any](rng dice.RNG, what string, table []row[T]) T {
+	dice.Announcef(rng, "%s", what)
+	roll := dice.D20.Roll(rng)
+	dice.Unannounce(rng)
+	for _, r := range table {
+		if roll <= r.upTo {
+			return r.value
+		}
+	}
+	return table[len(table)-1].value
+}
@@ -0,0 +1,169 @@
+package chargen
+
+import (
+	"testing"
+
+	"github.com/rozag/cabasi/atk"
+	"github.com/rozag/cabasi/creat"
+	"github.com/rozag/cabasi/dice"
+	"github.com/rozag/cabasi/dice/dicetest"
+)
+
+func TestGeneratorCharacter(t *testing.T) {
+	tests := []struct {
+		name           string
+		faces          []uint
+		wantName       string
+		wantBackground string
+		wantAttack     string
+		wantItemsCnt   int
+		wantSTR        uint8
+		wantDEX        uint8
+		wantWIL        uint8
+		wantHP         uint8
+		wantArmor      uint8
+		wantZone       atk.Zone
+	}{
+		{
+			name: "FullyArmored",
+			faces: []uint{
+				1, 2, // name
+				1, 2, 3, // STR
+				6, 6, 6, // DEX
+				3, 3, 3, // WIL
+				4,  // HP
+				1,  // background
+				20, // armor
+				20, // helmet and shield
+				20, // weapon
+			},
+			wantName: "Agnes Blackwood", wantBackground: "Aurifex",
+			wantAttack: "Halberd", wantItemsCnt: 7,
+			wantSTR: 6, wantDEX: 18, wantWIL: 9, wantHP: 4, wantArmor: 3,
+			wantZone: atk.ZoneEngaged,
+		},
+		{
+			name: "UnarmoredArcher",
+			faces: []uint{
+				20, 20, // name
+				1, 1, 1, // STR
+				2, 2, 2, // DEX
+				5, 5, 5, // WIL
+				1,  // HP
+				20, // background
+				3,  // armor
+				13, // helmet and shield
+				15, // weapon
+			},
+			wantName: "Wynn Thistle", wantBackground: "Woodcutter",
+			wantAttack: "Bow", wantItemsCnt: 4,
+			wantSTR: 3, wantDEX: 6, wantWIL: 15, wantHP: 1, wantArmor: 0,
+			wantZone: atk.ZoneEngaged,
+		},
+		{
+			name: "SwordAndShield",
+			faces: []uint{
+				10, 6, // name
+				4, 4, 4, // STR
+				3, 4, 5, // DEX
+				2, 2, 2, // WIL
+				6,  // HP
+				12, // background
+				14, // armor
+				19, // helmet and shield
+				14, // weapon
+			},
+			wantName: "Jocelin Fenwick", wantBackground: "Jongleur",
+			wantAttack: "Sword", wantItemsCnt: 6,
+			wantSTR: 12, wantDEX: 12, wantWIL: 6, wantHP: 6, wantArmor: 2,
+			wantZone: atk.ZoneEngaged,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rng := dicetest.NewSequence(t, test.faces...)
+			character := NewGenerator(rng).Character(creat.NewRegistry())
+			c := character.Creature
+
+			if c.ID != "player-1" || c.Name != test.wantName ||
+				character.Background != test.wantBackground {
+				t.Errorf(
+					"Generator.Character(): want player-1 %q the %s, got %s %q the %s",
+					test.wantName, test.wantBackground,
+					c.ID, c.Name, character.Background,
+				)
+			}
+			if c.STR != test.wantSTR || c.DEX != test.wantDEX ||
+				c.WIL != test.wantWIL || c.HP != test.wantHP {
+				t.Errorf(
+					"Generator.Character(): want STR %d DEX %d WIL %d HP %d, "+
+						"got STR %d DEX %d WIL %d HP %d",
+					test.wantSTR, test.wantDEX, test.wantWIL, test.wantHP,
+					c.STR, c.DEX, c.WIL, c.HP,
+				)
+			}
+			if c.Armor != test.wantArmor || c.Zone != test.wantZone {
+				t.Errorf(
+					"Generator.Character(): want Armor %d in %s, got Armor %d in %s",
+					test.wantArmor, test.wantZone, c.Armor, c.Zone,
+				)
+			}
+			if len(c.Attacks) != 1 || c.Attacks[0].Name != test.wantAttack {
+				t.Errorf(
+					"Generator.Character(): want a single %s attack, got %v",
+					test.wantAttack, atk.AttackSlice(c.Attacks),
+				)
+			}
+			if len(c.Items) != test.wantItemsCnt {
+				t.Errorf(
+					"Generator.Character(): want %d items, got %d",
+					test.wantItemsCnt, len(c.Items),
+				)
+			}
+			if err := c.Validate(); err != nil {
+				t.Errorf("Generator.Character(): want valid creature, got %v", err)
+			}
+		})
+	}
+}
+
+func TestGeneratorCharacterIsValid(t *testing.T) {
+	for _, rng := range []dice.RNG{dicetest.Min{}, dicetest.Max{}} {
+		c := NewGenerator(rng).Character(creat.NewRegistry()).Creature
+		if err := c.Validate(); err != nil {
+			t.Errorf("Generator.Character(): want valid creature, got %v", err)
+		}
+	}
+
+	registry := creat.NewRegistry()
+	// The IDs taken up front must be skipped by the characters.
+	if err := registry.Reserve("player-2"); err != nil {
+		t.Fatalf("Registry.Reserve(): want nil error, got %v", err)
+	}
+	party := NewGenerator(dice.NewStreams(42).Stream(0)).Party(registry, 1000)
+	ids := map[creat.ID]struct{}{"player-2": {}}
+	for _, c := range party {
+		if err := c.Validate(); err != nil {
+			t.Fatalf("Generator.Party(): want valid creatures, got %v", err)
+		}
+		if _, ok := ids[c.ID]; ok {
+			t.Fatalf("Generator.Party(): want unique IDs, got %s twice", c.ID)
+		}
+		ids[c.ID] = struct{}{}
+	}
+}
+
+func TestGeneratorReproducible(t *testing.T) {
+	party := func(seed uint64) []creat.Creature {
+		return NewGenerator(dice.NewStreams(seed).Stream(0)).
+			Party(creat.NewRegistry(), 10)
+	}
+	first, second, other := party(7), party(7), party(8)
+
+	if !creat.CreatureSlice(first).Equals(creat.CreatureSlice(second)) {
+		t.Fatalf("Generator.Party(): parties differ for the same seed")
+	}
+	if creat.CreatureSlice(first).Equals(creat.CreatureSlice(other)) {
+		t.Fatalf("Generator.Party(): parties are the same for different seeds")
+	}
+}
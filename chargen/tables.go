@@ -0,0 +1,131 @@
+package chargen
+
+import (
+	"github.com/rozag/cabasi/atk"
+	"github.com/rozag/cabasi/dice"
+	"github.com/rozag/cabasi/item"
+)
+
+// background is a character's past life, it brings a piece of gear.
+type background struct {
+	name string
+	gear string
+}
+
+// backgrounds returns the d20 list of backgrounds.
+func backgrounds() []background {
+	return []background{
+		{name: "Aurifex", gear: "Vial of Quicksilver"},
+		{name: "Barber-Surgeon", gear: "Bone Saw"},
+		{name: "Beast Handler", gear: "Leash and Muzzle"},
+		{name: "Bonekeeper", gear: "Shovel"},
+		{name: "Cutpurse", gear: "Lockpicks"},
+		{name: "Fieldwarden", gear: "Bag of Seeds"},
+		{name: "Fletchwind", gear: "Fletching Kit"},
+		{name: "Foundling", gear: "Lucky Charm"},
+		{name: "Fungal Forager", gear: "Spore Pouch"},
+		{name: "Greenwise", gear: "Herbal Salve"},
+		{name: "Hexenbane", gear: "Silver Bell"},
+		{name: "Jongleur", gear: "Lute"},
+		{name: "Kettlewright", gear: "Cooking Pot"},
+		{name: "Marchguard", gear: "Signal Horn"},
+		{name: "Mountebank", gear: "Bottled Elixir"},
+		{name: "Outrider", gear: "Saddlebags"},
+		{name: "Prowler", gear: "Grappling Hook"},
+		{name: "Rill Runner", gear: "Fishing Net"},
+		{name: "Scrivener", gear: "Ink and Quill"},
+		{name: "Woodcutter", gear: "Handsaw"},
+	}
+}
+
+// firstNames returns the d20 list of first names.
+func firstNames() []string {
+	return []string{
+		"Agnes", "Bertram", "Cecily", "Dunstan", "Edith",
+		"Fulk", "Gisela", "Hamon", "Isolde", "Jocelin",
+		"Kenric", "Lettice", "Maud", "Norbert", "Osric",
+		"Petronella", "Ranulf", "Sibyl", "Tybalt", "Wynn",
+	}
+}
+
+// surnames returns the d20 list of surnames.
+func surnames() []string {
+	return []string{
+		"Ashdown", "Blackwood", "Crowley", "Dunmore", "Elderberry",
+		"Fenwick", "Greythorn", "Hollowell", "Ironside", "Juniper",
+		"Kettleburn", "Longfield", "Marsh", "Nettlebed", "Oakhart",
+		"Pennywhistle", "Quarrel", "Rookwood", "Stonebridge", "Thistle",
+	}
+}
+
+// armors returns the d20 table of body armor, some characters start without
+// any.
+func armors() []row[[]item.Item] {
+	brigandine := protection("Brigandine", item.KindArmor, 1)
+	chainmail := protection("Chainmail", item.KindArmor, 2)
+	plate := protection("Plate", item.KindArmor, 3)
+	plate.IsBulky = true
+	return []row[[]item.Item]{
+		{value: nil, upTo: 3},
+		{value: []item.Item{brigandine}, upTo: 14},
+		{value: []item.Item{chainmail}, upTo: 19},
+		{value: []item.Item{plate}, upTo: 20},
+	}
+}
+
+// helmetsAndShields returns the d20 table of helmets and shields, some
+// characters start without any.
+func helmetsAndShields() []row[[]item.Item] {
+	helmet := protection("Helmet", item.KindHelmet, 1)
+	shield := protection("Shield", item.KindShield, 1)
+	return []row[[]item.Item]{
+		{value: nil, upTo: 13},
+		{value: []item.Item{helmet}, upTo: 16},
+		{value: []item.Item{shield}, upTo: 19},
+		{value: []item.Item{helmet, shield}, upTo: 20},
+	}
+}
+
+// weapons returns the d20 table of weapons.
+func weapons() []row[item.Item] {
+	return []row[item.Item]{
+		{value: weapon("Dagger", dice.D6, atk.TraitNone), upTo: 5},
+		{value: weapon("Sword", dice.D8, atk.TraitNone), upTo: 14},
+		{value: weapon("Bow", dice.D6, atk.TraitRanged|atk.TraitBulky), upTo: 19},
+		{value: weapon("Halberd", dice.D10, atk.TraitBulky), upTo: 20},
+	}
+}
+
+// gear creates a piece of gear with the provided name.
+func gear(name string) item.Item {
+	return item.Item{
+		Name: name, Weapon: nil, Kind: item.KindGear, Armor: 0,
+		IsBulky: false, IsEquipped: false, AttackIdx: 0,
+	}
+}
+
+// protection creates an equipped armor piece of the provided kind.
+func protection(name string, kind item.Kind, armor uint8) item.Item {
+	return item.Item{
+		Name: name, Weapon: nil, Kind: kind, Armor: armor,
+		IsBulky: false, IsEquipped: true, AttackIdx: 0,
+	}
+}
+
+// weapon creates an equipped weapon making a single die attack. It's bulky if
+// the traits include TraitBulky.
+func weapon(name string, d dice.Dice, traits atk.Traits) item.Item {
+	return item.Item{
+		Name: name,
+		Weapon: &atk.Attack{
+			Name: name, TargetCharacteristic: atk.STR,
+			Dice: d, DiceCnt: 1, Charges: -1,
+			IsBlast: false, Pool: atk.PoolHighest, Traits: traits,
+			PairedWith: 0, Recharge: nil, DmgMod: 0, MinDmg: 0,
+			Usage: atk.UsageAlways, Area: atk.AreaZone, MaxTargets: 0,
+			Inflicts: atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+		},
+		Kind: item.KindWeapon, Armor: 0,
+		IsBulky: traits.Has(atk.TraitBulky), IsEquipped: true, AttackIdx: 0,
+	}
+}
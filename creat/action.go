@@ -0,0 +1,141 @@
+package creat
+
+import (
+	"fmt"
+
+	"github.com/rozag/cabasi/act"
+	"github.com/rozag/cabasi/atk"
+	"github.com/rozag/cabasi/dice"
+)
+
+// Attack returns the attack made by the hostile action - either one of the
+// Creature's attacks or the effect of a spell cast from its spellbook. It
+// returns false if the action isn't hostile or its index is out of range.
+func (c *Creature) Attack(action act.Action) (atk.Attack, bool) {
+	var none atk.Attack
+	switch action.Kind {
+	case act.KindAttack:
+		if action.Idx >= uint(len(c.Attacks)) {
+			return none, false
+		}
+		return c.Attacks[action.Idx], true
+	case act.KindSpell:
+		if action.Idx >= uint(len(c.Spellbooks)) {
+			return none, false
+		}
+		return c.Spellbooks[action.Idx].Cast(action.IsEnhanced), true
+	case act.KindNone, act.KindSupport, act.KindMove,
+		act.KindCharge:
+		return none, false
+	default:
+		return none, false
+	}
+}
+
+// Situation returns the state of the battle from the point of view of the
+// Creature. It receives the current round (starting at 1), the Creature's
+// allies (the Creature itself may be included), and its enemies.
+func (c *Creature) Situation(
+	round uint,
+	allies, enemies []Creature,
+) atk.Situation {
+	situation := atk.Situation{
+		Round:               round,
+		IsBloodied:          c.HP == 0,
+		IsAllyOut:           false,
+		IsDetachmentEngaged: false,
+	}
+
+	for _, ally := range allies {
+		if ally.IsOut() {
+			situation.IsAllyOut = true
+			break
+		}
+	}
+
+	for _, enemy := range enemies {
+		if enemy.IsDetachment && !enemy.IsOut() {
+			situation.IsDetachmentEngaged = true
+			break
+		}
+	}
+
+	return situation
+}
+
+// CanAttack checks if the Creature can make the attack of the hostile action
+// in the Situation - the attack exists, has charges left, and its usage
+// conditions hold.
+func (c *Creature) CanAttack(action act.Action, situation atk.Situation) bool {
+	attack, ok := c.Attack(action)
+	return ok && attack.Charges != 0 && attack.Usage.Allows(situation)
+}
+
+// BlastTargets returns the indexes of the enemies hit by the Creature's blast
+// attack among the candidate indexes, in their order. Candidates that are out
+// of range, out of the battle, out of the attack's reach, or not eligible for
+// the attack's usage are skipped. The area of the blast is centered on the
+// zone of the first hit enemy, and at most MaxTargets enemies are hit if it's
+// not 0.
+func (c *Creature) BlastTargets(
+	attack *atk.Attack,
+	candidates []uint,
+	enemies []Creature,
+) []uint {
+	var (
+		targets []uint
+		center  atk.Zone
+	)
+	for _, idx := range candidates {
+		if attack.MaxTargets > 0 && len(targets) >= int(attack.MaxTargets) {
+			break
+		}
+
+		if idx >= uint(len(enemies)) {
+			continue
+		}
+
+		enemy := &enemies[idx]
+		if enemy.IsOut() ||
+			!attack.Reaches(c.Zone, enemy.Zone) ||
+			(attack.Usage.Has(atk.UsageVsDetachments) && !enemy.IsDetachment) {
+			continue
+		}
+
+		if len(targets) == 0 {
+			center = enemy.Zone
+		}
+
+		if attack.Area.Includes(center, enemy.Zone, enemy.IsDetachment) {
+			targets = append(targets, idx)
+		}
+	}
+
+	return targets
+}
+
+// CanUse checks if the Creature has the support at the provided index and it
+// has charges left.
+func (c *Creature) CanUse(supportIdx uint) bool {
+	return supportIdx < uint(len(c.Supports)) &&
+		c.Supports[supportIdx].Charges != 0
+}
+
+// Receive applies the support used by an ally to the Creature. Healing
+// restores HP, but never above MaxHP, and a deprived creature can't recover
+// HP at all. Protection and enhancement grant their conditions. RNG is used
+// for the healing roll.
+func (c *Creature) Receive(support *act.Support, rng dice.RNG) {
+	switch support.Kind {
+	case act.SupportHeal:
+		maxHP := c.EffectiveMaxHP()
+		if c.IsDeprived || c.HP >= maxHP {
+			return
+		}
+		c.HP += min(support.Heal(rng), maxHP-c.HP)
+	case act.SupportProtect, act.SupportEnhance:
+		c.Inflict(support.Grants())
+	default:
+		panic(fmt.Errorf("unknown SupportKind: %d", support.Kind))
+	}
+}
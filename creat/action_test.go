@@ -0,0 +1,463 @@
+package creat
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/rozag/cabasi/act"
+	"github.com/rozag/cabasi/atk"
+	"github.com/rozag/cabasi/dice"
+	"github.com/rozag/cabasi/dice/dicetest"
+	"github.com/rozag/cabasi/spell"
+)
+
+func TestCreatureAttack(t *testing.T) {
+	noCondition := atk.Condition{Kind: atk.ConditionNone, Rounds: 0}
+	wizard := Creature{
+		ID: "player-0", Name: "Merlin",
+		Attacks: []atk.Attack{
+			{
+				Name: "Staff", TargetCharacteristic: atk.STR,
+				Dice: dice.D6, DiceCnt: 1, Charges: -1,
+				IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitBulky,
+				PairedWith: 0, Inflicts: noCondition, Recharge: nil,
+				DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+				Area: atk.AreaZone, MaxTargets: 0,
+			},
+		},
+		Spellbooks: []spell.Book{
+			{
+				Name: "Fireball",
+				Effect: atk.Attack{
+					Name: "Fireball", TargetCharacteristic: atk.STR,
+					Dice: dice.D8, DiceCnt: 1, Charges: -1,
+					IsBlast: true, Pool: atk.PoolHighest, Traits: atk.TraitMagical,
+					PairedWith: 0, Inflicts: noCondition, Recharge: nil,
+					DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+					Area: atk.AreaZone, MaxTargets: 0,
+				},
+			},
+			{
+				Name: "Fear",
+				Effect: atk.Attack{
+					Name: "Fear", TargetCharacteristic: atk.WIL,
+					Dice: dice.D4, DiceCnt: 1, Charges: -1,
+					IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitMagical,
+					PairedWith: 0, Recharge: nil, DmgMod: 0, MinDmg: 0,
+					Usage: atk.UsageAlways, Area: atk.AreaZone, MaxTargets: 0,
+					Inflicts: atk.Condition{
+						Kind: atk.ConditionFrightened, Rounds: 2,
+					},
+				},
+			},
+		},
+		Conditions: nil, Fatigue: 0,
+		STR: 8, DEX: 12, WIL: 15, HP: 4, MaxHP: 4, Scars: nil, Armor: 0,
+		IsDetachment: false, IsDeprived: false, Supports: nil, Items: nil,
+		Zone: atk.ZoneEngaged,
+	}
+	tests := []struct {
+		name        string
+		wantName    string
+		action      act.Action
+		wantOk      bool
+		wantDiceCnt uint8
+	}{
+		{
+			name: "Attack", action: act.Attack(0),
+			wantOk: true, wantName: "Staff", wantDiceCnt: 1,
+		},
+		{
+			name: "Spell", action: act.Spell(1, false),
+			wantOk: true, wantName: "Fear", wantDiceCnt: 1,
+		},
+		{
+			name: "EnhancedSpell", action: act.Spell(0, true),
+			wantOk: true, wantName: "Fireball", wantDiceCnt: 2,
+		},
+		{
+			name: "AttackOutOfRange", action: act.Attack(1),
+			wantOk: false, wantName: "", wantDiceCnt: 0,
+		},
+		{
+			name: "SpellOutOfRange", action: act.Spell(2, false),
+			wantOk: false, wantName: "", wantDiceCnt: 0,
+		},
+		{
+			name: "None", action: act.None(),
+			wantOk: false, wantName: "", wantDiceCnt: 0,
+		},
+		{
+			name: "Support", action: act.Use(0),
+			wantOk: false, wantName: "", wantDiceCnt: 0,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			attack, ok := wizard.Attack(test.action)
+			if ok != test.wantOk ||
+				attack.Name != test.wantName ||
+				attack.DiceCnt != test.wantDiceCnt {
+				t.Errorf(
+					"Creature.Attack(): want %q with %d dice and %t, got %v and %t",
+					test.wantName, test.wantDiceCnt, test.wantOk, &attack, ok,
+				)
+			}
+		})
+	}
+}
+
+func TestCreatureSituation(t *testing.T) {
+	goblin := Creature{
+		ID: "monster-0", Name: "Root Goblin", Attacks: nil,
+		STR: 8, DEX: 14, WIL: 8, HP: 4, MaxHP: 4, Scars: nil, Armor: 0,
+		IsDetachment: false, Conditions: nil,
+		Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil, Items: nil,
+		Zone: atk.ZoneEngaged,
+	}
+	bloodied := goblin
+	bloodied.HP = 0
+	out := goblin
+	out.STR = 0
+	detachment := goblin
+	detachment.IsDetachment = true
+	outDetachment := detachment
+	outDetachment.STR = 0
+	tests := []struct {
+		name            string
+		actor           Creature
+		allies, enemies []Creature
+		want            atk.Situation
+	}{
+		{
+			name: "Calm", actor: goblin,
+			allies: []Creature{goblin}, enemies: []Creature{goblin},
+			want: atk.Situation{
+				Round: 2, IsBloodied: false,
+				IsAllyOut: false, IsDetachmentEngaged: false,
+			},
+		},
+		{
+			name: "Bloodied", actor: bloodied,
+			allies: []Creature{bloodied}, enemies: []Creature{goblin},
+			want: atk.Situation{
+				Round: 2, IsBloodied: true,
+				IsAllyOut: false, IsDetachmentEngaged: false,
+			},
+		},
+		{
+			name: "AllyOut", actor: goblin,
+			allies: []Creature{goblin, out}, enemies: []Creature{goblin},
+			want: atk.Situation{
+				Round: 2, IsBloodied: false,
+				IsAllyOut: true, IsDetachmentEngaged: false,
+			},
+		},
+		{
+			name: "DetachmentEngaged", actor: goblin,
+			allies: []Creature{goblin}, enemies: []Creature{goblin, detachment},
+			want: atk.Situation{
+				Round: 2, IsBloodied: false,
+				IsAllyOut: false, IsDetachmentEngaged: true,
+			},
+		},
+		{
+			name: "DetachmentOut", actor: goblin,
+			allies: []Creature{goblin}, enemies: []Creature{goblin, outDetachment},
+			want: atk.Situation{
+				Round: 2, IsBloodied: false,
+				IsAllyOut: false, IsDetachmentEngaged: false,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := test.actor.Situation(2, test.allies, test.enemies)
+			if got != test.want {
+				t.Errorf("Creature.Situation() = %+v, want %+v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestCreatureCanAttack(t *testing.T) {
+	noCondition := atk.Condition{Kind: atk.ConditionNone, Rounds: 0}
+	staff := atk.Attack{
+		Name: "Staff", TargetCharacteristic: atk.STR,
+		Dice: dice.D6, DiceCnt: 1, Charges: -1,
+		IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitBulky,
+		PairedWith: 0, Inflicts: noCondition, Recharge: nil,
+		DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+		Area: atk.AreaZone, MaxTargets: 0,
+	}
+	lastStand := staff
+	lastStand.Name, lastStand.Usage = "Last Stand", atk.UsageBloodied
+	brokenStaff := staff
+	brokenStaff.Name, brokenStaff.Charges = "Broken Staff", 0
+	wizard := Creature{
+		ID: "player-0", Name: "Merlin",
+		Attacks: []atk.Attack{staff, lastStand, brokenStaff},
+		Spellbooks: []spell.Book{
+			{
+				Name: "Fireball",
+				Effect: atk.Attack{
+					Name: "Fireball", TargetCharacteristic: atk.STR,
+					Dice: dice.D8, DiceCnt: 1, Charges: -1,
+					IsBlast: true, Pool: atk.PoolHighest, Traits: atk.TraitMagical,
+					PairedWith: 0, Inflicts: noCondition, Recharge: nil,
+					DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+					Area: atk.AreaZone, MaxTargets: 0,
+				},
+			},
+		},
+		Conditions: nil, Fatigue: 0,
+		STR: 8, DEX: 12, WIL: 15, HP: 4, MaxHP: 4, Scars: nil, Armor: 0,
+		IsDetachment: false, IsDeprived: false, Supports: nil, Items: nil,
+		Zone: atk.ZoneEngaged,
+	}
+	calm := atk.Situation{
+		Round: 1, IsBloodied: false, IsAllyOut: false, IsDetachmentEngaged: false,
+	}
+	dire := atk.Situation{
+		Round: 1, IsBloodied: true, IsAllyOut: false, IsDetachmentEngaged: false,
+	}
+	tests := []struct {
+		name      string
+		action    act.Action
+		situation atk.Situation
+		want      bool
+	}{
+		{name: "Attack", action: act.Attack(0), situation: calm, want: true},
+		{name: "Spell", action: act.Spell(0, false), situation: calm, want: true},
+		{
+			name: "UsageNotAllowed", action: act.Attack(1),
+			situation: calm, want: false,
+		},
+		{name: "UsageAllowed", action: act.Attack(1), situation: dire, want: true},
+		{name: "NoCharges", action: act.Attack(2), situation: dire, want: false},
+		{name: "OutOfRange", action: act.Attack(3), situation: dire, want: false},
+		{name: "Support", action: act.Use(0), situation: dire, want: false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := wizard.CanAttack(test.action, test.situation)
+			if got != test.want {
+				t.Errorf("Creature.CanAttack() = %t, want %t", got, test.want)
+			}
+		})
+	}
+}
+
+func TestCreatureBlastTargets(t *testing.T) {
+	fireball := atk.Attack{
+		Name: "Fireball", TargetCharacteristic: atk.STR,
+		Dice: dice.D8, DiceCnt: 1, Charges: -1,
+		IsBlast: true, Pool: atk.PoolHighest,
+		Traits: atk.TraitMagical | atk.TraitRanged, PairedWith: 0,
+		Inflicts: atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+		Recharge: nil, DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+		Area: atk.AreaZone, MaxTargets: 0,
+	}
+	wizard := Creature{
+		ID: "player-0", Name: "Merlin", Attacks: nil,
+		STR: 8, DEX: 12, WIL: 15, HP: 4, MaxHP: 4, Scars: nil, Armor: 0,
+		IsDetachment: false, Conditions: nil,
+		Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil, Items: nil,
+		Zone: atk.ZoneEngaged,
+	}
+	engaged := Creature{
+		ID: "monster-0", Name: "Root Goblin", Attacks: nil,
+		STR: 8, DEX: 14, WIL: 8, HP: 4, MaxHP: 4, Scars: nil, Armor: 0,
+		IsDetachment: false, Conditions: nil,
+		Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil, Items: nil,
+		Zone: atk.ZoneEngaged,
+	}
+	near := engaged
+	near.Zone = atk.ZoneNear
+	detachment := engaged
+	detachment.IsDetachment = true
+	out := engaged
+	out.STR = 0
+	farDetachment := detachment
+	farDetachment.Zone = atk.ZoneFar
+	enemies := []Creature{engaged, near, detachment, out, farDetachment}
+	all := []uint{0, 1, 2, 3, 4}
+	with := func(modify func(attack *atk.Attack)) atk.Attack {
+		attack := fireball
+		modify(&attack)
+		return attack
+	}
+	tests := []struct {
+		name       string
+		attack     atk.Attack
+		candidates []uint
+		want       []uint
+	}{
+		{
+			name: "Zone", attack: fireball,
+			candidates: all, want: []uint{0, 2},
+		},
+		{
+			name: "ZoneCenteredOnFirstCandidate", attack: fireball,
+			candidates: []uint{1, 0, 2, 4}, want: []uint{1},
+		},
+		{
+			name: "All",
+			attack: with(func(attack *atk.Attack) {
+				attack.Area = atk.AreaAll
+			}),
+			candidates: all, want: []uint{0, 1, 2, 4},
+		},
+		{
+			name: "AllWithMaxTargets",
+			attack: with(func(attack *atk.Attack) {
+				attack.Area = atk.AreaAll
+				attack.MaxTargets = 2
+			}),
+			candidates: all, want: []uint{0, 1},
+		},
+		{
+			name: "Individuals",
+			attack: with(func(attack *atk.Attack) {
+				attack.Area = atk.AreaIndividuals
+			}),
+			candidates: all, want: []uint{0, 1},
+		},
+		{
+			name: "Detachments",
+			attack: with(func(attack *atk.Attack) {
+				attack.Area = atk.AreaDetachments
+			}),
+			candidates: all, want: []uint{2, 4},
+		},
+		{
+			name: "VsDetachments",
+			attack: with(func(attack *atk.Attack) {
+				attack.Usage = atk.UsageVsDetachments
+			}),
+			candidates: all, want: []uint{2},
+		},
+		{
+			name: "MeleeReachesEngagedOnly",
+			attack: with(func(attack *atk.Attack) {
+				attack.Traits = atk.TraitMagical
+				attack.Area = atk.AreaAll
+			}),
+			candidates: all, want: []uint{0, 2},
+		},
+		{
+			name: "CandidateOutOfRange", attack: fireball,
+			candidates: []uint{7, 1}, want: []uint{1},
+		},
+		{name: "NoCandidates", attack: fireball, candidates: nil, want: nil},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := wizard.BlastTargets(&test.attack, test.candidates, enemies)
+			if !slices.Equal(got, test.want) {
+				t.Errorf("Creature.BlastTargets() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestCreatureCanUse(t *testing.T) {
+	cleric := Creature{
+		ID: "player-0", Name: "Tuck", Attacks: nil,
+		STR: 12, DEX: 9, WIL: 14, HP: 6, MaxHP: 6, Scars: nil, Armor: 0,
+		IsDetachment: false, Conditions: nil,
+		Spellbooks: nil, Fatigue: 0, IsDeprived: false,
+		Supports: []act.Support{
+			{
+				Name: "Healing Potion", Kind: act.SupportHeal,
+				Dice: dice.D6, DiceCnt: 1, Rounds: 0, Charges: 1,
+			},
+			{
+				Name: "Empty Flask", Kind: act.SupportHeal,
+				Dice: dice.D6, DiceCnt: 1, Rounds: 0, Charges: 0,
+			},
+		},
+		Items: nil, Zone: atk.ZoneEngaged,
+	}
+	tests := []struct {
+		name       string
+		supportIdx uint
+		want       bool
+	}{
+		{name: "WithCharges", supportIdx: 0, want: true},
+		{name: "NoCharges", supportIdx: 1, want: false},
+		{name: "OutOfRange", supportIdx: 2, want: false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := cleric.CanUse(test.supportIdx); got != test.want {
+				t.Fatalf("Creature.CanUse() = %t, want %t", got, test.want)
+			}
+		})
+	}
+}
+
+func TestCreatureReceive(t *testing.T) {
+	heal := act.Support{
+		Name: "Cure Wounds", Kind: act.SupportHeal,
+		Dice: dice.D6, DiceCnt: 1, Rounds: 0, Charges: -1,
+	}
+	protect := act.Support{
+		Name: "Shield of Faith", Kind: act.SupportProtect,
+		Dice: 0, DiceCnt: 0, Rounds: 2, Charges: -1,
+	}
+	tests := []struct {
+		name           string
+		support        act.Support
+		wantConditions []atk.Condition
+		faces          []uint
+		hp             uint8
+		isDeprived     bool
+		wantHP         uint8
+	}{
+		{
+			name: "Heal", support: heal, faces: []uint{2},
+			hp: 1, isDeprived: false, wantHP: 3, wantConditions: nil,
+		},
+		{
+			name: "HealCappedAtMaxHP", support: heal, faces: []uint{6},
+			hp: 1, isDeprived: false, wantHP: 4, wantConditions: nil,
+		},
+		{
+			name: "DeprivedCannotHeal", support: heal, faces: nil,
+			hp: 1, isDeprived: true, wantHP: 1, wantConditions: nil,
+		},
+		{
+			name: "Protect", support: protect, faces: nil,
+			hp: 1, isDeprived: false, wantHP: 1,
+			wantConditions: []atk.Condition{
+				{Kind: atk.ConditionProtected, Rounds: 2},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			goblin := Creature{
+				ID: "monster-0", Name: "Root Goblin", Attacks: nil,
+				STR: 8, DEX: 14, WIL: 8, HP: test.hp, MaxHP: 4, Scars: nil,
+				Armor: 0, IsDetachment: false, Conditions: nil,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: test.isDeprived,
+				Supports: nil, Items: nil, Zone: atk.ZoneEngaged,
+			}
+
+			rng := dicetest.NewSequence(t, test.faces...)
+			goblin.Receive(&test.support, rng)
+			if goblin.HP != test.wantHP {
+				t.Errorf(
+					"Creature.Receive(): want HP %d, got %d", test.wantHP, goblin.HP,
+				)
+			}
+			if !slices.Equal(goblin.Conditions, test.wantConditions) {
+				t.Errorf(
+					"Creature.Receive(): want conditions %v, got %v",
+					test.wantConditions, goblin.Conditions,
+				)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package creat
+
+import (
+	"slices"
+
+	"github.com/rozag/cabasi/atk"
+)
+
+// HasCondition checks if the Creature suffers from the condition of the
+// provided kind.
+func (c *Creature) HasCondition(kind atk.ConditionKind) bool {
+	return slices.ContainsFunc(c.Conditions, func(cond atk.Condition) bool {
+		return cond.Kind == kind
+	})
+}
+
+// Inflict makes the Creature suffer from the condition. If the Creature
+// already suffers from the condition of the same kind, the longest duration
+// is kept. Conditions of kind ConditionNone are ignored.
+func (c *Creature) Inflict(condition atk.Condition) {
+	if condition.Kind == atk.ConditionNone {
+		return
+	}
+
+	idx := slices.IndexFunc(c.Conditions, func(cond atk.Condition) bool {
+		return cond.Kind == condition.Kind
+	})
+	if idx < 0 {
+		c.Conditions = append(c.Conditions, condition)
+		return
+	}
+
+	current := c.Conditions[idx].Rounds
+	if current >= 0 && (condition.Rounds < 0 || condition.Rounds > current) {
+		c.Conditions[idx].Rounds = condition.Rounds
+	}
+}
+
+// TickConditions applies the end of the round effects of the Creature's
+// harmful conditions - a poisoned creature loses 1 STR - and decreases their
+// durations. Conditions that run out are removed. Beneficial conditions are
+// left intact, see TickBenefits.
+func (c *Creature) TickConditions() {
+	if c.HasCondition(atk.ConditionPoisoned) && c.STR > 0 {
+		c.STR--
+	}
+	c.tick(false)
+}
+
+// TickBenefits decreases the durations of the Creature's beneficial
+// conditions. Conditions that run out are removed.
+func (c *Creature) TickBenefits() {
+	c.tick(true)
+}
+
+// tick decreases the durations of the Creature's conditions that are either
+// beneficial or harmful and removes the ones that run out.
+func (c *Creature) tick(isBeneficial bool) {
+	for i := range c.Conditions {
+		if c.Conditions[i].Kind.IsBeneficial() == isBeneficial &&
+			c.Conditions[i].Rounds > 0 {
+			c.Conditions[i].Rounds--
+		}
+	}
+
+	c.Conditions = slices.DeleteFunc(c.Conditions, func(cond atk.Condition) bool {
+		return cond.Rounds == 0
+	})
+}
+
+// EffectiveArmor returns the Creature's Armor with the bonus of
+// atk.ConditionProtected, never above ArmorMax.
+func (c *Creature) EffectiveArmor() uint8 {
+	if c.HasCondition(atk.ConditionProtected) && c.Armor < ArmorMax {
+		return c.Armor + 1
+	}
+	return c.Armor
+}
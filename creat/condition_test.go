@@ -0,0 +1,232 @@
+package creat
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/rozag/cabasi/atk"
+	"github.com/rozag/cabasi/dice"
+)
+
+func TestCreatureInflict(t *testing.T) {
+	tests := []struct {
+		name      string
+		current   []atk.Condition
+		condition atk.Condition
+		want      []atk.Condition
+	}{
+		{
+			name:      "New",
+			current:   []atk.Condition{{Kind: atk.ConditionBlinded, Rounds: 1}},
+			condition: atk.Condition{Kind: atk.ConditionPoisoned, Rounds: 2},
+			want: []atk.Condition{
+				{Kind: atk.ConditionBlinded, Rounds: 1},
+				{Kind: atk.ConditionPoisoned, Rounds: 2},
+			},
+		},
+		{
+			name:      "None",
+			current:   nil,
+			condition: atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+			want:      nil,
+		},
+		{
+			name:      "Longer",
+			current:   []atk.Condition{{Kind: atk.ConditionPoisoned, Rounds: 1}},
+			condition: atk.Condition{Kind: atk.ConditionPoisoned, Rounds: 3},
+			want:      []atk.Condition{{Kind: atk.ConditionPoisoned, Rounds: 3}},
+		},
+		{
+			name:      "Shorter",
+			current:   []atk.Condition{{Kind: atk.ConditionPoisoned, Rounds: 3}},
+			condition: atk.Condition{Kind: atk.ConditionPoisoned, Rounds: 1},
+			want:      []atk.Condition{{Kind: atk.ConditionPoisoned, Rounds: 3}},
+		},
+		{
+			name:      "UntilTheEndOverTimed",
+			current:   []atk.Condition{{Kind: atk.ConditionPoisoned, Rounds: 3}},
+			condition: atk.Condition{Kind: atk.ConditionPoisoned, Rounds: -1},
+			want:      []atk.Condition{{Kind: atk.ConditionPoisoned, Rounds: -1}},
+		},
+		{
+			name:      "TimedOverUntilTheEnd",
+			current:   []atk.Condition{{Kind: atk.ConditionPoisoned, Rounds: -1}},
+			condition: atk.Condition{Kind: atk.ConditionPoisoned, Rounds: 3},
+			want:      []atk.Condition{{Kind: atk.ConditionPoisoned, Rounds: -1}},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			creature := Creature{
+				ID: "monster-0", Name: "Root Goblin", Attacks: nil,
+				STR: 8, DEX: 14, WIL: 8, HP: 4, MaxHP: 4, Scars: nil, Armor: 0,
+				IsDetachment: false, Conditions: test.current,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil, Zone: atk.ZoneEngaged,
+			}
+			creature.Inflict(test.condition)
+			if !slices.Equal(creature.Conditions, test.want) {
+				t.Fatalf(
+					"Creature.Inflict(): want %v, got %v", test.want, creature.Conditions,
+				)
+			}
+		})
+	}
+}
+
+func TestCreatureTickConditions(t *testing.T) {
+	spear := atk.Attack{
+		Name: "Spear", TargetCharacteristic: atk.STR,
+		Dice: dice.D6, DiceCnt: 1, Charges: -1,
+		IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone, PairedWith: 0,
+		Inflicts: atk.Condition{Kind: atk.ConditionNone, Rounds: 0}, Recharge: nil,
+		DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+		Area: atk.AreaZone, MaxTargets: 0,
+	}
+	tests := []struct {
+		name           string
+		str            uint8
+		conditions     []atk.Condition
+		wantSTR        uint8
+		wantConditions []atk.Condition
+	}{
+		{
+			name:           "NoConditions",
+			str:            8,
+			conditions:     nil,
+			wantSTR:        8,
+			wantConditions: nil,
+		},
+		{
+			name: "DurationsDecrease",
+			str:  8,
+			conditions: []atk.Condition{
+				{Kind: atk.ConditionParalysed, Rounds: 1},
+				{Kind: atk.ConditionFrightened, Rounds: 3},
+				{Kind: atk.ConditionBlinded, Rounds: -1},
+			},
+			wantSTR: 8,
+			wantConditions: []atk.Condition{
+				{Kind: atk.ConditionFrightened, Rounds: 2},
+				{Kind: atk.ConditionBlinded, Rounds: -1},
+			},
+		},
+		{
+			name:           "PoisonedLosesSTR",
+			str:            8,
+			conditions:     []atk.Condition{{Kind: atk.ConditionPoisoned, Rounds: 1}},
+			wantSTR:        7,
+			wantConditions: nil,
+		},
+		{
+			name: "BeneficialConditionsIntact",
+			str:  8,
+			conditions: []atk.Condition{
+				{Kind: atk.ConditionProtected, Rounds: 1},
+				{Kind: atk.ConditionFrightened, Rounds: 1},
+			},
+			wantSTR: 8,
+			wantConditions: []atk.Condition{
+				{Kind: atk.ConditionProtected, Rounds: 1},
+			},
+		},
+		{
+			name: "PoisonedWithZeroSTR",
+			str:  0,
+			conditions: []atk.Condition{
+				{Kind: atk.ConditionPoisoned, Rounds: -1},
+			},
+			wantSTR: 0,
+			wantConditions: []atk.Condition{
+				{Kind: atk.ConditionPoisoned, Rounds: -1},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			creature := Creature{
+				ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
+				STR: test.str, DEX: 14, WIL: 8, HP: 4, MaxHP: 4, Scars: nil, Armor: 0,
+				IsDetachment: false, Conditions: test.conditions,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil, Zone: atk.ZoneEngaged,
+			}
+			creature.TickConditions()
+			if creature.STR != test.wantSTR {
+				t.Errorf(
+					"Creature.TickConditions(): want STR %d, got %d",
+					test.wantSTR, creature.STR,
+				)
+			}
+			if !slices.Equal(creature.Conditions, test.wantConditions) {
+				t.Errorf(
+					"Creature.TickConditions(): want conditions %v, got %v",
+					test.wantConditions, creature.Conditions,
+				)
+			}
+		})
+	}
+}
+
+func TestCreatureTickBenefits(t *testing.T) {
+	creature := Creature{
+		ID: "monster-0", Name: "Root Goblin", Attacks: nil,
+		STR: 8, DEX: 14, WIL: 8, HP: 4, MaxHP: 4, Scars: nil, Armor: 0,
+		IsDetachment: false,
+		Conditions: []atk.Condition{
+			{Kind: atk.ConditionProtected, Rounds: 1},
+			{Kind: atk.ConditionEnhanced, Rounds: 2},
+			{Kind: atk.ConditionBlinded, Rounds: 1},
+		},
+		Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+		Items: nil, Zone: atk.ZoneEngaged,
+	}
+	want := []atk.Condition{
+		{Kind: atk.ConditionEnhanced, Rounds: 1},
+		{Kind: atk.ConditionBlinded, Rounds: 1},
+	}
+
+	creature.TickBenefits()
+	if !slices.Equal(creature.Conditions, want) {
+		t.Errorf(
+			"Creature.TickBenefits(): want conditions %v, got %v",
+			want, creature.Conditions,
+		)
+	}
+}
+
+func TestCreatureEffectiveArmor(t *testing.T) {
+	tests := []struct {
+		name        string
+		conditions  []atk.Condition
+		armor, want uint8
+	}{
+		{name: "NotProtected", conditions: nil, armor: 1, want: 1},
+		{
+			name:       "Protected",
+			conditions: []atk.Condition{{Kind: atk.ConditionProtected, Rounds: 1}},
+			armor:      1,
+			want:       2,
+		},
+		{
+			name:       "ProtectedWithMaxArmor",
+			conditions: []atk.Condition{{Kind: atk.ConditionProtected, Rounds: 1}},
+			armor:      ArmorMax,
+			want:       ArmorMax,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			creature := Creature{
+				ID: "monster-0", Name: "Root Goblin", Attacks: nil,
+				STR: 8, DEX: 14, WIL: 8, HP: 4, MaxHP: 4, Scars: nil,
+				Armor: test.armor, IsDetachment: false, Conditions: test.conditions,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil, Zone: atk.ZoneEngaged,
+			}
+			if got := creature.EffectiveArmor(); got != test.want {
+				t.Fatalf("Creature.EffectiveArmor() = %d, want %d", got, test.want)
+			}
+		})
+	}
+}
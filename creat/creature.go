@@ -3,8 +3,13 @@ package creat
 import (
 	"errors"
 	"fmt"
+	"slices"
 
+	"github.com/rozag/cabasi/act"
 	"github.com/rozag/cabasi/atk"
+	"github.com/rozag/cabasi/internal/codec"
+	"github.com/rozag/cabasi/item"
+	"github.com/rozag/cabasi/spell"
 )
 
 const (
@@ -22,17 +27,54 @@ const HPMin = 1
 // ArmorMax is the maximum value of a creature's armor.
 const ArmorMax = 3
 
+// SlotsMax is the number of a creature's inventory slots. Items take a slot
+// each or two if bulky, spellbooks and Fatigue take a slot each.
+const SlotsMax = 10
+
 // Creature represents a creature in a battle - a player or a monster.
+// Conditions are the status effects the creature currently suffers from, at
+// most one per kind.
+//
+// Spellbooks let the creature cast spells, each cast adds Fatigue filling
+// an inventory slot. A deprived creature lacks a crucial need such as food,
+// water, or rest: it can't recover HP or Fatigue, takes Fatigue for every day
+// deprived, and makes critical damage saves with disadvantage, see Rest,
+// SufferDeprivation, and CriticalSave.
+//
+// Supports are the abilities and items the creature uses on its allies.
+//
+// Items are the creature's inventory. Equipped weapons and armor pieces take
+// effect through Attacks and Armor once the creature equips them, see Equip.
+// A creature with all the inventory slots taken is overloaded and has HP 0.
+//
+// Zone is how far the creature stands from the melee, it decides which
+// enemies the creature's attacks reach. Creatures start in ZoneEngaged unless
+// placed elsewhere.
+//
+// MaxHP is the HP the creature recovers to with rest, HP never exceeds it.
+// MaxHP 0 means it equals HP, see EffectiveMaxHP.
+// Scars are the lasting injuries the creature gained when its HP hit exactly
+// 0, their effects are already applied to the characteristics and MaxHP, see
+// GainScar.
 type Creature struct {
 	ID           ID
 	Name         string
 	Attacks      []atk.Attack
+	Spellbooks   []spell.Book
+	Supports     []act.Support
+	Items        []item.Item
+	Conditions   []atk.Condition
+	Scars        []Scar
 	STR          uint8
 	DEX          uint8
 	WIL          uint8
 	HP           uint8
+	MaxHP        uint8
 	Armor        uint8
+	Fatigue      uint8
+	Zone         atk.Zone
 	IsDetachment bool
+	IsDeprived   bool
 }
 
 // IsOut checks if the Creature is out of the battle - if any of its core
@@ -41,6 +83,14 @@ func (c *Creature) IsOut() bool {
 	return c.STR == 0 || c.DEX == 0 || c.WIL == 0
 }
 
+// EffectiveMaxHP returns the MaxHP of the Creature, or its HP if MaxHP is 0.
+func (c *Creature) EffectiveMaxHP() uint8 {
+	if c.MaxHP == 0 {
+		return c.HP
+	}
+	return c.MaxHP
+}
+
 // String returns the string representation of the Creature.
 func (c *Creature) String() string {
 	return fmt.Sprintf(
@@ -48,86 +98,256 @@ func (c *Creature) String() string {
 			"ID: %q"+
 			", Name: %q"+
 			", Attacks: %s"+
+			", Spellbooks: %s"+
+			", Supports: %s"+
+			", Items: %s"+
+			", Conditions: %v"+
+			", Scars: %v"+
 			", STR: %d"+
 			", DEX: %d"+
 			", WIL: %d"+
 			", HP: %d"+
+			", MaxHP: %d"+
 			", Armor: %d"+
+			", Fatigue: %d"+
+			", Zone: %s"+
 			", IsDetachment: %t"+
+			", IsDeprived: %t"+
 			"}",
 		c.ID,
 		c.Name,
 		atk.AttackSlice(c.Attacks),
+		spell.BookSlice(c.Spellbooks),
+		act.SupportSlice(c.Supports),
+		item.ItemSlice(c.Items),
+		c.Conditions,
+		c.Scars,
 		c.STR,
 		c.DEX,
 		c.WIL,
 		c.HP,
+		c.MaxHP,
 		c.Armor,
+		c.Fatigue,
+		c.Zone,
 		c.IsDetachment,
+		c.IsDeprived,
 	)
 }
 
 // Validate checks if the freshly created creature is valid. It returns an error
 // with `Unwrap() []error` method to get all the errors or `nil` if the creature
-// is valid. Validate is not meant to be used on a Creature in the middle of
-// a battle (with decreased characteristics), but rather on a freshly created
-// one.
+// is valid. The errors are reported as *codec.FieldError with the path to the
+// invalid field as encoded in JSON, e.g. "attacks[0].diceCnt". Validate is not
+// meant to be used on a Creature in the middle of a battle (with decreased
+// characteristics), but rather on a freshly created one.
 func (c *Creature) Validate() error {
 	var errs []error
 
 	if len(c.ID) == 0 {
-		errs = append(errs, errors.New("creature must have an ID"))
+		errs = append(
+			errs, codec.WrapField("id", errors.New("creature must have an ID")),
+		)
 	}
 
 	if len(c.Name) == 0 {
-		errs = append(errs, errors.New("creature must have a name"))
+		errs = append(
+			errs, codec.WrapField("name", errors.New("creature must have a name")),
+		)
 	}
 
-	if len(c.Attacks) == 0 {
-		errs = append(errs, errors.New("creature must have at least one attack"))
+	if len(c.Attacks) == 0 && len(c.Spellbooks) == 0 {
+		errs = append(errs, codec.WrapField(
+			"attacks",
+			errors.New("creature must have at least one attack or spellbook"),
+		))
 	}
 	for idx, attack := range c.Attacks {
+		path := fmt.Sprintf("attacks[%d]", idx)
 		if err := attack.Validate(); err != nil {
-			errs = append(errs, fmt.Errorf("invalid attack at idx %d: %w", idx, err))
+			errs = append(errs, codec.WrapField(path, err))
+		}
+
+		if err := c.validatePairing(idx); err != nil {
+			errs = append(errs, codec.WrapField(path+".pairedWith", err))
 		}
 	}
 
 	if c.STR < CharacteristicMin || c.STR > CharacteristicMax {
-		errs = append(errs, fmt.Errorf(
+		errs = append(errs, codec.WrapField("str", fmt.Errorf(
 			"STR must be between %d and %d, got %d",
 			CharacteristicMin, CharacteristicMax, c.STR,
-		))
+		)))
 	}
 
 	if c.DEX < CharacteristicMin || c.DEX > CharacteristicMax {
-		errs = append(errs, fmt.Errorf(
+		errs = append(errs, codec.WrapField("dex", fmt.Errorf(
 			"DEX must be between %d and %d, got %d",
 			CharacteristicMin, CharacteristicMax, c.DEX,
-		))
+		)))
 	}
 
 	if c.WIL < CharacteristicMin || c.WIL > CharacteristicMax {
-		errs = append(errs, fmt.Errorf(
+		errs = append(errs, codec.WrapField("wil", fmt.Errorf(
 			"WIL must be between %d and %d, got %d",
 			CharacteristicMin, CharacteristicMax, c.WIL,
-		))
+		)))
 	}
 
-	if c.HP < HPMin {
-		errs = append(errs, fmt.Errorf(
+	// An overloaded creature is reduced to HP 0, so HPMin doesn't apply to it.
+	slots := c.UsedSlots()
+	if c.HP < HPMin && slots != SlotsMax {
+		errs = append(errs, codec.WrapField("hp", fmt.Errorf(
 			"HP must be at least %d, got %d", HPMin, c.HP,
-		))
+		)))
+	}
+
+	if c.HP > c.EffectiveMaxHP() {
+		errs = append(errs, codec.WrapField("hp", fmt.Errorf(
+			"HP must be at most MaxHP %d, got %d", c.MaxHP, c.HP,
+		)))
 	}
 
 	if c.Armor > ArmorMax {
-		errs = append(errs, fmt.Errorf(
+		errs = append(errs, codec.WrapField("armor", fmt.Errorf(
 			"Armor must be at most %d, got %d", ArmorMax, c.Armor,
-		))
+		)))
+	}
+
+	if err := c.Zone.Validate(); err != nil {
+		errs = append(errs, codec.WrapField("zone", err))
+	}
+
+	for idx, book := range c.Spellbooks {
+		if err := book.Validate(); err != nil {
+			path := fmt.Sprintf("spellbooks[%d]", idx)
+			errs = append(errs, codec.WrapField(path, err))
+		}
+	}
+
+	attackIdxs := make(map[uint8]struct{}, len(c.Items))
+	for idx, it := range c.Items {
+		path := fmt.Sprintf("items[%d]", idx)
+		if err := it.Validate(); err != nil {
+			errs = append(errs, codec.WrapField(path, err))
+		}
+
+		if it.AttackIdx == 0 {
+			continue
+		}
+		if int(it.AttackIdx) > len(c.Attacks) {
+			errs = append(errs, codec.WrapField(path+".attackIdx", fmt.Errorf(
+				"attackIdx must be at most %d, got %d", len(c.Attacks), it.AttackIdx,
+			)))
+		}
+		if _, ok := attackIdxs[it.AttackIdx]; ok {
+			errs = append(errs, codec.WrapField(path+".attackIdx", fmt.Errorf(
+				"attack %d is already added by another item", it.AttackIdx,
+			)))
+		}
+		attackIdxs[it.AttackIdx] = struct{}{}
+	}
+
+	if slots > SlotsMax {
+		errs = append(errs, codec.WrapField("items", fmt.Errorf(
+			"items, spellbooks and Fatigue must fit %d slots, got %d",
+			SlotsMax, slots,
+		)))
+	} else if slots == SlotsMax && c.HP != 0 {
+		errs = append(errs, codec.WrapField("hp", fmt.Errorf(
+			"overloaded creature must have HP 0, got %d", c.HP,
+		)))
+	}
+
+	for idx, support := range c.Supports {
+		if err := support.Validate(); err != nil {
+			errs = append(
+				errs, codec.WrapField(fmt.Sprintf("supports[%d]", idx), err),
+			)
+		}
+	}
+
+	kinds := make(map[atk.ConditionKind]struct{}, len(c.Conditions))
+	for idx, condition := range c.Conditions {
+		path := fmt.Sprintf("conditions[%d]", idx)
+		if condition.Kind == atk.ConditionNone {
+			errs = append(errs, codec.WrapField(
+				path+".kind", errors.New("condition must not be None"),
+			))
+		} else if err := condition.Validate(); err != nil {
+			errs = append(errs, codec.WrapField(path, err))
+		} else if _, ok := kinds[condition.Kind]; ok {
+			errs = append(errs, codec.WrapField(path+".kind", fmt.Errorf(
+				"condition has non-unique kind %s", condition.Kind,
+			)))
+		} else {
+			kinds[condition.Kind] = struct{}{}
+		}
 	}
 
 	return errors.Join(errs...)
 }
 
+// validatePairing checks if the attack at the provided index is paired with
+// another attack of the Creature properly.
+func (c *Creature) validatePairing(attackIdx int) error {
+	pairedWith := int(c.Attacks[attackIdx].PairedWith)
+	if pairedWith == 0 {
+		return nil
+	}
+
+	pairedIdx := pairedWith - 1
+	if pairedIdx >= len(c.Attacks) {
+		return fmt.Errorf("paired attack idx %d is out of range", pairedIdx)
+	}
+
+	if pairedIdx == attackIdx {
+		return errors.New("attack cannot be paired with itself")
+	}
+
+	paired := c.Attacks[pairedIdx]
+	if paired.PairedWith != 0 {
+		return fmt.Errorf("paired attack at idx %d is paired itself", pairedIdx)
+	}
+
+	if paired.TargetCharacteristic != c.Attacks[attackIdx].TargetCharacteristic {
+		return fmt.Errorf(
+			"paired attack at idx %d targets %s instead of %s",
+			pairedIdx,
+			paired.TargetCharacteristic,
+			c.Attacks[attackIdx].TargetCharacteristic,
+		)
+	}
+
+	if paired.Usage != c.Attacks[attackIdx].Usage {
+		return fmt.Errorf(
+			"paired attack at idx %d is usable %s instead of %s",
+			pairedIdx, paired.Usage, c.Attacks[attackIdx].Usage,
+		)
+	}
+
+	return nil
+}
+
+// PairedAttackIdx returns the index of the attack paired with the attack at
+// the provided index if the attack is paired and the paired attack has charges
+// left. It returns false otherwise.
+func (c *Creature) PairedAttackIdx(attackIdx uint) (uint, bool) {
+	if attackIdx >= uint(len(c.Attacks)) {
+		return 0, false
+	}
+
+	pairedWith := uint(c.Attacks[attackIdx].PairedWith)
+	if pairedWith == 0 ||
+		pairedWith > uint(len(c.Attacks)) ||
+		pairedWith-1 == attackIdx ||
+		c.Attacks[pairedWith-1].Charges == 0 {
+		return 0, false
+	}
+
+	return pairedWith - 1, true
+}
+
 // Equals checks if the Creature is equal to the other Creature.
 func (c *Creature) Equals(other *Creature) bool {
 	return c.ID == other.ID &&
@@ -136,8 +356,17 @@ func (c *Creature) Equals(other *Creature) bool {
 		c.DEX == other.DEX &&
 		c.WIL == other.WIL &&
 		c.HP == other.HP &&
+		c.MaxHP == other.MaxHP &&
 		c.Armor == other.Armor &&
+		c.Fatigue == other.Fatigue &&
+		c.Zone == other.Zone &&
 		c.IsDetachment == other.IsDetachment &&
+		c.IsDeprived == other.IsDeprived &&
+		spell.BookSlice(c.Spellbooks).Equals(spell.BookSlice(other.Spellbooks)) &&
+		act.SupportSlice(c.Supports).Equals(act.SupportSlice(other.Supports)) &&
+		item.ItemSlice(c.Items).Equals(item.ItemSlice(other.Items)) &&
+		slices.Equal(c.Conditions, other.Conditions) &&
+		slices.Equal(c.Scars, other.Scars) &&
 		atk.AttackSlice(c.Attacks).Equals(atk.AttackSlice(other.Attacks))
 }
 
@@ -148,15 +377,38 @@ func (c *Creature) DeepCopy() Creature {
 		copied := attack.DeepCopy()
 		attacks[i] = copied
 	}
+	var spellbooks []spell.Book
+	if c.Spellbooks != nil {
+		spellbooks = make([]spell.Book, len(c.Spellbooks))
+		for i, book := range c.Spellbooks {
+			spellbooks[i] = book.DeepCopy()
+		}
+	}
+	var items []item.Item
+	if c.Items != nil {
+		items = make([]item.Item, len(c.Items))
+		for i, it := range c.Items {
+			items[i] = it.DeepCopy()
+		}
+	}
 	return Creature{
 		ID:           c.ID,
 		Name:         c.Name,
 		Attacks:      attacks,
+		Spellbooks:   spellbooks,
+		Supports:     slices.Clone(c.Supports),
+		Items:        items,
+		Conditions:   slices.Clone(c.Conditions),
+		Scars:        slices.Clone(c.Scars),
 		STR:          c.STR,
 		DEX:          c.DEX,
 		WIL:          c.WIL,
 		HP:           c.HP,
+		MaxHP:        c.MaxHP,
 		Armor:        c.Armor,
+		Fatigue:      c.Fatigue,
+		Zone:         c.Zone,
 		IsDetachment: c.IsDetachment,
+		IsDeprived:   c.IsDeprived,
 	}
 }
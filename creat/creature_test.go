@@ -1,17 +1,24 @@
 package creat
 
 import (
+	"slices"
 	"testing"
 
+	"github.com/rozag/cabasi/act"
 	"github.com/rozag/cabasi/atk"
 	"github.com/rozag/cabasi/dice"
+	"github.com/rozag/cabasi/item"
+	"github.com/rozag/cabasi/spell"
 )
 
 func TestCreatureValidate(t *testing.T) {
 	spear := atk.Attack{
 		Name: "Spear", TargetCharacteristic: atk.STR,
 		Dice: dice.D6, DiceCnt: 1, Charges: -1,
-		IsBlast: false,
+		IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone, PairedWith: 0,
+		Inflicts: atk.Condition{Kind: atk.ConditionNone, Rounds: 0}, Recharge: nil,
+		DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+		Area: atk.AreaZone, MaxTargets: 0,
 	}
 	tests := []struct {
 		name       string
@@ -22,8 +29,10 @@ func TestCreatureValidate(t *testing.T) {
 			name: "ValidCreature",
 			creature: Creature{
 				ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
-				STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-				IsDetachment: false,
+				STR: 8, DEX: 14, WIL: 8, HP: 4, MaxHP: 4, Scars: nil, Armor: 0,
+				IsDetachment: false, Conditions: nil,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil, Zone: atk.ZoneEngaged,
 			},
 			wantErrCnt: 0,
 		},
@@ -31,8 +40,10 @@ func TestCreatureValidate(t *testing.T) {
 			name: "EmptyID",
 			creature: Creature{
 				ID: "", Name: "Root Goblin", Attacks: []atk.Attack{spear},
-				STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-				IsDetachment: false,
+				STR: 8, DEX: 14, WIL: 8, HP: 4, MaxHP: 4, Scars: nil, Armor: 0,
+				IsDetachment: false, Conditions: nil,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil, Zone: atk.ZoneEngaged,
 			},
 			wantErrCnt: 1,
 		},
@@ -40,8 +51,10 @@ func TestCreatureValidate(t *testing.T) {
 			name: "EmptyName",
 			creature: Creature{
 				ID: "monster-0", Name: "", Attacks: []atk.Attack{spear},
-				STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-				IsDetachment: false,
+				STR: 8, DEX: 14, WIL: 8, HP: 4, MaxHP: 4, Scars: nil, Armor: 0,
+				IsDetachment: false, Conditions: nil,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil, Zone: atk.ZoneEngaged,
 			},
 			wantErrCnt: 1,
 		},
@@ -49,8 +62,10 @@ func TestCreatureValidate(t *testing.T) {
 			name: "NilAttacks",
 			creature: Creature{
 				ID: "monster-0", Name: "Root Goblin", Attacks: nil,
-				STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-				IsDetachment: false,
+				STR: 8, DEX: 14, WIL: 8, HP: 4, MaxHP: 4, Scars: nil, Armor: 0,
+				IsDetachment: false, Conditions: nil,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil, Zone: atk.ZoneEngaged,
 			},
 			wantErrCnt: 1,
 		},
@@ -58,8 +73,10 @@ func TestCreatureValidate(t *testing.T) {
 			name: "EmptyAttacks",
 			creature: Creature{
 				ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{},
-				STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-				IsDetachment: false,
+				STR: 8, DEX: 14, WIL: 8, HP: 4, MaxHP: 4, Scars: nil, Armor: 0,
+				IsDetachment: false, Conditions: nil,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil, Zone: atk.ZoneEngaged,
 			},
 			wantErrCnt: 1,
 		},
@@ -71,11 +88,21 @@ func TestCreatureValidate(t *testing.T) {
 					{
 						Name: "", TargetCharacteristic: atk.STR,
 						Dice: dice.D6, DiceCnt: 1, Charges: -1,
-						IsBlast: false,
+						IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+						PairedWith: 0,
+						Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+						Recharge:   nil,
+						DmgMod:     0,
+						MinDmg:     0,
+						Usage:      atk.UsageAlways,
+						Area:       atk.AreaZone,
+						MaxTargets: 0,
 					},
 				},
-				STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-				IsDetachment: false,
+				STR: 8, DEX: 14, WIL: 8, HP: 4, MaxHP: 4, Scars: nil, Armor: 0,
+				IsDetachment: false, Conditions: nil,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil, Zone: atk.ZoneEngaged,
 			},
 			wantErrCnt: 1,
 		},
@@ -83,8 +110,10 @@ func TestCreatureValidate(t *testing.T) {
 			name: "STRTooLow",
 			creature: Creature{
 				ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
-				STR: 0, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-				IsDetachment: false,
+				STR: 0, DEX: 14, WIL: 8, HP: 4, MaxHP: 4, Scars: nil, Armor: 0,
+				IsDetachment: false, Conditions: nil,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil, Zone: atk.ZoneEngaged,
 			},
 			wantErrCnt: 1,
 		},
@@ -92,8 +121,10 @@ func TestCreatureValidate(t *testing.T) {
 			name: "STRTooHigh",
 			creature: Creature{
 				ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
-				STR: 21, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-				IsDetachment: false,
+				STR: 21, DEX: 14, WIL: 8, HP: 4, MaxHP: 4, Scars: nil, Armor: 0,
+				IsDetachment: false, Conditions: nil,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil, Zone: atk.ZoneEngaged,
 			},
 			wantErrCnt: 1,
 		},
@@ -101,8 +132,10 @@ func TestCreatureValidate(t *testing.T) {
 			name: "DEXTooLow",
 			creature: Creature{
 				ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
-				STR: 8, DEX: 0, WIL: 8, HP: 4, Armor: 0,
-				IsDetachment: false,
+				STR: 8, DEX: 0, WIL: 8, HP: 4, MaxHP: 4, Scars: nil, Armor: 0,
+				IsDetachment: false, Conditions: nil,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil, Zone: atk.ZoneEngaged,
 			},
 			wantErrCnt: 1,
 		},
@@ -110,8 +143,10 @@ func TestCreatureValidate(t *testing.T) {
 			name: "DEXTooHigh",
 			creature: Creature{
 				ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
-				STR: 8, DEX: 21, WIL: 8, HP: 4, Armor: 0,
-				IsDetachment: false,
+				STR: 8, DEX: 21, WIL: 8, HP: 4, MaxHP: 4, Scars: nil, Armor: 0,
+				IsDetachment: false, Conditions: nil,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil, Zone: atk.ZoneEngaged,
 			},
 			wantErrCnt: 1,
 		},
@@ -119,8 +154,10 @@ func TestCreatureValidate(t *testing.T) {
 			name: "WILTooLow",
 			creature: Creature{
 				ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
-				STR: 8, DEX: 14, WIL: 0, HP: 4, Armor: 0,
-				IsDetachment: false,
+				STR: 8, DEX: 14, WIL: 0, HP: 4, MaxHP: 4, Scars: nil, Armor: 0,
+				IsDetachment: false, Conditions: nil,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil, Zone: atk.ZoneEngaged,
 			},
 			wantErrCnt: 1,
 		},
@@ -128,8 +165,10 @@ func TestCreatureValidate(t *testing.T) {
 			name: "WILTooHigh",
 			creature: Creature{
 				ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
-				STR: 8, DEX: 14, WIL: 21, HP: 4, Armor: 0,
-				IsDetachment: false,
+				STR: 8, DEX: 14, WIL: 21, HP: 4, MaxHP: 4, Scars: nil, Armor: 0,
+				IsDetachment: false, Conditions: nil,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil, Zone: atk.ZoneEngaged,
 			},
 			wantErrCnt: 1,
 		},
@@ -137,17 +176,335 @@ func TestCreatureValidate(t *testing.T) {
 			name: "HPTooLow",
 			creature: Creature{
 				ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
-				STR: 8, DEX: 14, WIL: 8, HP: 0, Armor: 0,
-				IsDetachment: false,
+				STR: 8, DEX: 14, WIL: 8, HP: 0, MaxHP: 0, Scars: nil, Armor: 0,
+				IsDetachment: false, Conditions: nil,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil, Zone: atk.ZoneEngaged,
 			},
 			wantErrCnt: 1,
 		},
+		{
+			name: "HPAboveMaxHP",
+			creature: Creature{
+				ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
+				STR: 8, DEX: 14, WIL: 8, HP: 4, MaxHP: 3, Scars: nil, Armor: 0,
+				IsDetachment: false, Conditions: nil,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil, Zone: atk.ZoneEngaged,
+			},
+			wantErrCnt: 1,
+		},
+		{
+			name: "MaxHPNotSet",
+			creature: Creature{
+				ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
+				STR: 8, DEX: 14, WIL: 8, HP: 4, MaxHP: 0, Scars: nil, Armor: 0,
+				IsDetachment: false, Conditions: nil,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil, Zone: atk.ZoneEngaged,
+			},
+			wantErrCnt: 0,
+		},
 		{
 			name: "ArmorTooHigh",
 			creature: Creature{
 				ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
-				STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 4,
-				IsDetachment: false,
+				STR: 8, DEX: 14, WIL: 8, HP: 4, MaxHP: 4, Scars: nil, Armor: 4,
+				IsDetachment: false, Conditions: nil,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil, Zone: atk.ZoneEngaged,
+			},
+			wantErrCnt: 1,
+		},
+		{
+			name: "ValidPairedAttacks",
+			creature: Creature{
+				ID: "monster-0", Name: "Root Goblin",
+				Attacks: []atk.Attack{
+					{
+						Name: "Sword", TargetCharacteristic: atk.STR,
+						Dice: dice.D8, DiceCnt: 1, Charges: -1,
+						IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+						PairedWith: 2,
+						Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+						Recharge:   nil,
+						DmgMod:     0,
+						MinDmg:     0,
+						Usage:      atk.UsageAlways,
+						Area:       atk.AreaZone,
+						MaxTargets: 0,
+					},
+					{
+						Name: "Dagger", TargetCharacteristic: atk.STR,
+						Dice: dice.D4, DiceCnt: 1, Charges: -1,
+						IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+						PairedWith: 0,
+						Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+						Recharge:   nil,
+						DmgMod:     0,
+						MinDmg:     0,
+						Usage:      atk.UsageAlways,
+						Area:       atk.AreaZone,
+						MaxTargets: 0,
+					},
+				},
+				STR: 8, DEX: 14, WIL: 8, HP: 4, MaxHP: 4, Scars: nil, Armor: 0,
+				IsDetachment: false, Conditions: nil,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil, Zone: atk.ZoneEngaged,
+			},
+			wantErrCnt: 0,
+		},
+		{
+			name: "PairedWithOutOfRange",
+			creature: Creature{
+				ID: "monster-0", Name: "Root Goblin",
+				Attacks: []atk.Attack{
+					{
+						Name: "Sword", TargetCharacteristic: atk.STR,
+						Dice: dice.D8, DiceCnt: 1, Charges: -1,
+						IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+						PairedWith: 3,
+						Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+						Recharge:   nil,
+						DmgMod:     0,
+						MinDmg:     0,
+						Usage:      atk.UsageAlways,
+						Area:       atk.AreaZone,
+						MaxTargets: 0,
+					},
+					{
+						Name: "Dagger", TargetCharacteristic: atk.STR,
+						Dice: dice.D4, DiceCnt: 1, Charges: -1,
+						IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+						PairedWith: 0,
+						Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+						Recharge:   nil,
+						DmgMod:     0,
+						MinDmg:     0,
+						Usage:      atk.UsageAlways,
+						Area:       atk.AreaZone,
+						MaxTargets: 0,
+					},
+				},
+				STR: 8, DEX: 14, WIL: 8, HP: 4, MaxHP: 4, Scars: nil, Armor: 0,
+				IsDetachment: false, Conditions: nil,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil, Zone: atk.ZoneEngaged,
+			},
+			wantErrCnt: 1,
+		},
+		{
+			name: "PairedWithItself",
+			creature: Creature{
+				ID: "monster-0", Name: "Root Goblin",
+				Attacks: []atk.Attack{
+					{
+						Name: "Sword", TargetCharacteristic: atk.STR,
+						Dice: dice.D8, DiceCnt: 1, Charges: -1,
+						IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+						PairedWith: 1,
+						Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+						Recharge:   nil,
+						DmgMod:     0,
+						MinDmg:     0,
+						Usage:      atk.UsageAlways,
+						Area:       atk.AreaZone,
+						MaxTargets: 0,
+					},
+					{
+						Name: "Dagger", TargetCharacteristic: atk.STR,
+						Dice: dice.D4, DiceCnt: 1, Charges: -1,
+						IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+						PairedWith: 0,
+						Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+						Recharge:   nil,
+						DmgMod:     0,
+						MinDmg:     0,
+						Usage:      atk.UsageAlways,
+						Area:       atk.AreaZone,
+						MaxTargets: 0,
+					},
+				},
+				STR: 8, DEX: 14, WIL: 8, HP: 4, MaxHP: 4, Scars: nil, Armor: 0,
+				IsDetachment: false, Conditions: nil,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil, Zone: atk.ZoneEngaged,
+			},
+			wantErrCnt: 1,
+		},
+		{
+			name: "PairedWithPairedAttack",
+			creature: Creature{
+				ID: "monster-0", Name: "Root Goblin",
+				Attacks: []atk.Attack{
+					{
+						Name: "Sword", TargetCharacteristic: atk.STR,
+						Dice: dice.D8, DiceCnt: 1, Charges: -1,
+						IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+						PairedWith: 2,
+						Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+						Recharge:   nil,
+						DmgMod:     0,
+						MinDmg:     0,
+						Usage:      atk.UsageAlways,
+						Area:       atk.AreaZone,
+						MaxTargets: 0,
+					},
+					{
+						Name: "Dagger", TargetCharacteristic: atk.STR,
+						Dice: dice.D4, DiceCnt: 1, Charges: -1,
+						IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+						PairedWith: 1,
+						Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+						Recharge:   nil,
+						DmgMod:     0,
+						MinDmg:     0,
+						Usage:      atk.UsageAlways,
+						Area:       atk.AreaZone,
+						MaxTargets: 0,
+					},
+				},
+				STR: 8, DEX: 14, WIL: 8, HP: 4, MaxHP: 4, Scars: nil, Armor: 0,
+				IsDetachment: false, Conditions: nil,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil, Zone: atk.ZoneEngaged,
+			},
+			wantErrCnt: 2,
+		},
+		{
+			name: "PairedWithDifferentCharacteristic",
+			creature: Creature{
+				ID: "monster-0", Name: "Root Goblin",
+				Attacks: []atk.Attack{
+					{
+						Name: "Sword", TargetCharacteristic: atk.STR,
+						Dice: dice.D8, DiceCnt: 1, Charges: -1,
+						IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+						PairedWith: 2,
+						Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+						Recharge:   nil,
+						DmgMod:     0,
+						MinDmg:     0,
+						Usage:      atk.UsageAlways,
+						Area:       atk.AreaZone,
+						MaxTargets: 0,
+					},
+					{
+						Name: "Hex", TargetCharacteristic: atk.WIL,
+						Dice: dice.D4, DiceCnt: 1, Charges: -1,
+						IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+						PairedWith: 0,
+						Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+						Recharge:   nil,
+						DmgMod:     0,
+						MinDmg:     0,
+						Usage:      atk.UsageAlways,
+						Area:       atk.AreaZone,
+						MaxTargets: 0,
+					},
+				},
+				STR: 8, DEX: 14, WIL: 8, HP: 4, MaxHP: 4, Scars: nil, Armor: 0,
+				IsDetachment: false, Conditions: nil,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil, Zone: atk.ZoneEngaged,
+			},
+			wantErrCnt: 1,
+		},
+		{
+			name: "PairedWithDifferentUsage",
+			creature: Creature{
+				ID: "monster-0", Name: "Root Goblin",
+				Attacks: []atk.Attack{
+					{
+						Name: "Sword", TargetCharacteristic: atk.STR,
+						Dice: dice.D8, DiceCnt: 1, Charges: -1,
+						IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+						PairedWith: 2,
+						Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+						Recharge:   nil,
+						DmgMod:     0,
+						MinDmg:     0,
+						Usage:      atk.UsageAlways,
+						Area:       atk.AreaZone,
+						MaxTargets: 0,
+					},
+					{
+						Name: "Desperate Stab", TargetCharacteristic: atk.STR,
+						Dice: dice.D6, DiceCnt: 1, Charges: -1,
+						IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+						PairedWith: 0,
+						Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+						Recharge:   nil,
+						DmgMod:     0,
+						MinDmg:     0,
+						Usage:      atk.UsageBloodied,
+						Area:       atk.AreaZone,
+						MaxTargets: 0,
+					},
+				},
+				STR: 8, DEX: 14, WIL: 8, HP: 4, MaxHP: 4, Scars: nil, Armor: 0,
+				IsDetachment: false, Conditions: nil,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil, Zone: atk.ZoneEngaged,
+			},
+			wantErrCnt: 1,
+		},
+		{
+			name: "ValidConditions",
+			creature: Creature{
+				ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
+				STR: 8, DEX: 14, WIL: 8, HP: 4, MaxHP: 4, Scars: nil, Armor: 0,
+				IsDetachment: false, Spellbooks: nil, Fatigue: 0, IsDeprived: false,
+				Supports: nil, Items: nil,
+				Zone: atk.ZoneEngaged,
+				Conditions: []atk.Condition{
+					{Kind: atk.ConditionPoisoned, Rounds: 2},
+					{Kind: atk.ConditionBlinded, Rounds: -1},
+				},
+			},
+			wantErrCnt: 0,
+		},
+		{
+			name: "NoneCondition",
+			creature: Creature{
+				ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
+				STR: 8, DEX: 14, WIL: 8, HP: 4, MaxHP: 4, Scars: nil, Armor: 0,
+				IsDetachment: false, Spellbooks: nil, Fatigue: 0, IsDeprived: false,
+				Supports: nil, Items: nil,
+				Zone: atk.ZoneEngaged,
+				Conditions: []atk.Condition{
+					{Kind: atk.ConditionNone, Rounds: 0},
+				},
+			},
+			wantErrCnt: 1,
+		},
+		{
+			name: "InvalidCondition",
+			creature: Creature{
+				ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
+				STR: 8, DEX: 14, WIL: 8, HP: 4, MaxHP: 4, Scars: nil, Armor: 0,
+				IsDetachment: false, Spellbooks: nil, Fatigue: 0, IsDeprived: false,
+				Supports: nil, Items: nil,
+				Zone: atk.ZoneEngaged,
+				Conditions: []atk.Condition{
+					{Kind: atk.ConditionParalysed, Rounds: 0},
+				},
+			},
+			wantErrCnt: 1,
+		},
+		{
+			name: "NonUniqueConditionKind",
+			creature: Creature{
+				ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
+				STR: 8, DEX: 14, WIL: 8, HP: 4, MaxHP: 4, Scars: nil, Armor: 0,
+				IsDetachment: false, Spellbooks: nil, Fatigue: 0, IsDeprived: false,
+				Supports: nil, Items: nil,
+				Zone: atk.ZoneEngaged,
+				Conditions: []atk.Condition{
+					{Kind: atk.ConditionPoisoned, Rounds: 2},
+					{Kind: atk.ConditionPoisoned, Rounds: 1},
+				},
 			},
 			wantErrCnt: 1,
 		},
@@ -155,8 +512,10 @@ func TestCreatureValidate(t *testing.T) {
 			name: "MultipleErrors",
 			creature: Creature{
 				ID: "", Name: "", Attacks: []atk.Attack{},
-				STR: 21, DEX: 0, WIL: 21, HP: 0, Armor: 21,
-				IsDetachment: true,
+				STR: 21, DEX: 0, WIL: 21, HP: 0, MaxHP: 0, Scars: nil, Armor: 21,
+				IsDetachment: true, Conditions: nil,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil, Zone: atk.ZoneEngaged,
 			},
 			wantErrCnt: 8,
 		},
@@ -199,7 +558,10 @@ func TestCreatureEquals(t *testing.T) {
 	spear := atk.Attack{
 		Name: "Spear", TargetCharacteristic: atk.STR,
 		Dice: dice.D6, DiceCnt: 1, Charges: -1,
-		IsBlast: false,
+		IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone, PairedWith: 0,
+		Inflicts: atk.Condition{Kind: atk.ConditionNone, Rounds: 0}, Recharge: nil,
+		DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+		Area: atk.AreaZone, MaxTargets: 0,
 	}
 	tests := []struct {
 		name        string
@@ -210,13 +572,17 @@ func TestCreatureEquals(t *testing.T) {
 			name: "EqualCreatures",
 			this: Creature{
 				ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
-				STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-				IsDetachment: false,
+				STR: 8, DEX: 14, WIL: 8, HP: 4, MaxHP: 4, Scars: nil, Armor: 0,
+				IsDetachment: false, Conditions: nil,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil, Zone: atk.ZoneEngaged,
 			},
 			other: Creature{
 				ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
-				STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-				IsDetachment: false,
+				STR: 8, DEX: 14, WIL: 8, HP: 4, MaxHP: 4, Scars: nil, Armor: 0,
+				IsDetachment: false, Conditions: nil,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil, Zone: atk.ZoneEngaged,
 			},
 			want: true,
 		},
@@ -224,13 +590,17 @@ func TestCreatureEquals(t *testing.T) {
 			name: "DifferentID",
 			this: Creature{
 				ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
-				STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-				IsDetachment: false,
+				STR: 8, DEX: 14, WIL: 8, HP: 4, MaxHP: 4, Scars: nil, Armor: 0,
+				IsDetachment: false, Conditions: nil,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil, Zone: atk.ZoneEngaged,
 			},
 			other: Creature{
 				ID: "monster-1", Name: "Root Goblin", Attacks: []atk.Attack{spear},
-				STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-				IsDetachment: false,
+				STR: 8, DEX: 14, WIL: 8, HP: 4, MaxHP: 4, Scars: nil, Armor: 0,
+				IsDetachment: false, Conditions: nil,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil, Zone: atk.ZoneEngaged,
 			},
 			want: false,
 		},
@@ -238,13 +608,17 @@ func TestCreatureEquals(t *testing.T) {
 			name: "DifferentName",
 			this: Creature{
 				ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
-				STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-				IsDetachment: false,
+				STR: 8, DEX: 14, WIL: 8, HP: 4, MaxHP: 4, Scars: nil, Armor: 0,
+				IsDetachment: false, Conditions: nil,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil, Zone: atk.ZoneEngaged,
 			},
 			other: Creature{
 				ID: "monster-0", Name: "Boot Goblin", Attacks: []atk.Attack{spear},
-				STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-				IsDetachment: false,
+				STR: 8, DEX: 14, WIL: 8, HP: 4, MaxHP: 4, Scars: nil, Armor: 0,
+				IsDetachment: false, Conditions: nil,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil, Zone: atk.ZoneEngaged,
 			},
 			want: false,
 		},
@@ -256,11 +630,21 @@ func TestCreatureEquals(t *testing.T) {
 					{
 						Name: "Spear", TargetCharacteristic: atk.STR,
 						Dice: dice.D6, DiceCnt: 1, Charges: -1,
-						IsBlast: false,
+						IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+						PairedWith: 0,
+						Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+						Recharge:   nil,
+						DmgMod:     0,
+						MinDmg:     0,
+						Usage:      atk.UsageAlways,
+						Area:       atk.AreaZone,
+						MaxTargets: 0,
 					},
 				},
-				STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-				IsDetachment: false,
+				STR: 8, DEX: 14, WIL: 8, HP: 4, MaxHP: 4, Scars: nil, Armor: 0,
+				IsDetachment: false, Conditions: nil,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil, Zone: atk.ZoneEngaged,
 			},
 			other: Creature{
 				ID: "monster-0", Name: "Root Goblin",
@@ -268,11 +652,21 @@ func TestCreatureEquals(t *testing.T) {
 					{
 						Name: "Sword", TargetCharacteristic: atk.STR,
 						Dice: dice.D6, DiceCnt: 1, Charges: -1,
-						IsBlast: false,
+						IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+						PairedWith: 0,
+						Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+						Recharge:   nil,
+						DmgMod:     0,
+						MinDmg:     0,
+						Usage:      atk.UsageAlways,
+						Area:       atk.AreaZone,
+						MaxTargets: 0,
 					},
 				},
-				STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-				IsDetachment: false,
+				STR: 8, DEX: 14, WIL: 8, HP: 4, MaxHP: 4, Scars: nil, Armor: 0,
+				IsDetachment: false, Conditions: nil,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil, Zone: atk.ZoneEngaged,
 			},
 			want: false,
 		},
@@ -280,13 +674,17 @@ func TestCreatureEquals(t *testing.T) {
 			name: "DifferentSTR",
 			this: Creature{
 				ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
-				STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-				IsDetachment: false,
+				STR: 8, DEX: 14, WIL: 8, HP: 4, MaxHP: 4, Scars: nil, Armor: 0,
+				IsDetachment: false, Conditions: nil,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil, Zone: atk.ZoneEngaged,
 			},
 			other: Creature{
 				ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
-				STR: 9, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-				IsDetachment: false,
+				STR: 9, DEX: 14, WIL: 8, HP: 4, MaxHP: 4, Scars: nil, Armor: 0,
+				IsDetachment: false, Conditions: nil,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil, Zone: atk.ZoneEngaged,
 			},
 			want: false,
 		},
@@ -294,13 +692,17 @@ func TestCreatureEquals(t *testing.T) {
 			name: "DifferentDEX",
 			this: Creature{
 				ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
-				STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-				IsDetachment: false,
+				STR: 8, DEX: 14, WIL: 8, HP: 4, MaxHP: 4, Scars: nil, Armor: 0,
+				IsDetachment: false, Conditions: nil,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil, Zone: atk.ZoneEngaged,
 			},
 			other: Creature{
 				ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
-				STR: 8, DEX: 15, WIL: 8, HP: 4, Armor: 0,
-				IsDetachment: false,
+				STR: 8, DEX: 15, WIL: 8, HP: 4, MaxHP: 4, Scars: nil, Armor: 0,
+				IsDetachment: false, Conditions: nil,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil, Zone: atk.ZoneEngaged,
 			},
 			want: false,
 		},
@@ -308,13 +710,17 @@ func TestCreatureEquals(t *testing.T) {
 			name: "DifferentWIL",
 			this: Creature{
 				ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
-				STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-				IsDetachment: false,
+				STR: 8, DEX: 14, WIL: 8, HP: 4, MaxHP: 4, Scars: nil, Armor: 0,
+				IsDetachment: false, Conditions: nil,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil, Zone: atk.ZoneEngaged,
 			},
 			other: Creature{
 				ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
-				STR: 8, DEX: 14, WIL: 9, HP: 4, Armor: 0,
-				IsDetachment: false,
+				STR: 8, DEX: 14, WIL: 9, HP: 4, MaxHP: 4, Scars: nil, Armor: 0,
+				IsDetachment: false, Conditions: nil,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil, Zone: atk.ZoneEngaged,
 			},
 			want: false,
 		},
@@ -322,13 +728,17 @@ func TestCreatureEquals(t *testing.T) {
 			name: "DifferentHP",
 			this: Creature{
 				ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
-				STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-				IsDetachment: false,
+				STR: 8, DEX: 14, WIL: 8, HP: 4, MaxHP: 4, Scars: nil, Armor: 0,
+				IsDetachment: false, Conditions: nil,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil, Zone: atk.ZoneEngaged,
 			},
 			other: Creature{
 				ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
-				STR: 8, DEX: 14, WIL: 8, HP: 5, Armor: 0,
-				IsDetachment: false,
+				STR: 8, DEX: 14, WIL: 8, HP: 5, MaxHP: 5, Scars: nil, Armor: 0,
+				IsDetachment: false, Conditions: nil,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil, Zone: atk.ZoneEngaged,
 			},
 			want: false,
 		},
@@ -336,13 +746,17 @@ func TestCreatureEquals(t *testing.T) {
 			name: "DifferentArmor",
 			this: Creature{
 				ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
-				STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-				IsDetachment: false,
+				STR: 8, DEX: 14, WIL: 8, HP: 4, MaxHP: 4, Scars: nil, Armor: 0,
+				IsDetachment: false, Conditions: nil,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil, Zone: atk.ZoneEngaged,
 			},
 			other: Creature{
 				ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
-				STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 1,
-				IsDetachment: false,
+				STR: 8, DEX: 14, WIL: 8, HP: 4, MaxHP: 4, Scars: nil, Armor: 1,
+				IsDetachment: false, Conditions: nil,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil, Zone: atk.ZoneEngaged,
 			},
 			want: false,
 		},
@@ -350,13 +764,41 @@ func TestCreatureEquals(t *testing.T) {
 			name: "DifferentIsDetachment",
 			this: Creature{
 				ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
-				STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-				IsDetachment: false,
+				STR: 8, DEX: 14, WIL: 8, HP: 4, MaxHP: 4, Scars: nil, Armor: 0,
+				IsDetachment: false, Conditions: nil,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil, Zone: atk.ZoneEngaged,
+			},
+			other: Creature{
+				ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
+				STR: 8, DEX: 14, WIL: 8, HP: 4, MaxHP: 4, Scars: nil, Armor: 0,
+				IsDetachment: true, Conditions: nil,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil, Zone: atk.ZoneEngaged,
+			},
+			want: false,
+		},
+		{
+			name: "DifferentConditions",
+			this: Creature{
+				ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
+				STR: 8, DEX: 14, WIL: 8, HP: 4, MaxHP: 4, Scars: nil, Armor: 0,
+				IsDetachment: false, Spellbooks: nil, Fatigue: 0, IsDeprived: false,
+				Supports: nil, Items: nil,
+				Zone: atk.ZoneEngaged,
+				Conditions: []atk.Condition{
+					{Kind: atk.ConditionPoisoned, Rounds: 2},
+				},
 			},
 			other: Creature{
 				ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
-				STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-				IsDetachment: true,
+				STR: 8, DEX: 14, WIL: 8, HP: 4, MaxHP: 4, Scars: nil, Armor: 0,
+				IsDetachment: false, Spellbooks: nil, Fatigue: 0, IsDeprived: false,
+				Supports: nil, Items: nil,
+				Zone: atk.ZoneEngaged,
+				Conditions: []atk.Condition{
+					{Kind: atk.ConditionPoisoned, Rounds: 1},
+				},
 			},
 			want: false,
 		},
@@ -374,12 +816,23 @@ func TestCreatureDeepCopy(t *testing.T) {
 	spear := atk.Attack{
 		Name: "Spear", TargetCharacteristic: atk.STR,
 		Dice: dice.D6, DiceCnt: 1, Charges: -1,
-		IsBlast: false,
+		IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone, PairedWith: 0,
+		Inflicts: atk.Condition{Kind: atk.ConditionNone, Rounds: 0}, Recharge: nil,
+		DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+		Area: atk.AreaZone, MaxTargets: 0,
 	}
 	original := Creature{
 		ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
-		STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-		IsDetachment: false,
+		STR: 8, DEX: 14, WIL: 8, HP: 4, MaxHP: 4, Scars: nil, Armor: 0,
+		IsDetachment: false, Spellbooks: nil, Supports: nil, Zone: atk.ZoneEngaged,
+		Fatigue: 0, IsDeprived: false,
+		Conditions: []atk.Condition{{Kind: atk.ConditionPoisoned, Rounds: 2}},
+		Items: []item.Item{
+			{
+				Name: "Spear", Weapon: &spear, Kind: item.KindWeapon, Armor: 0,
+				IsBulky: false, IsEquipped: true, AttackIdx: 0,
+			},
+		},
 	}
 	copied := original.DeepCopy()
 
@@ -396,6 +849,10 @@ func TestCreatureDeepCopy(t *testing.T) {
 	copied.HP = 5
 	copied.Armor = 1
 	copied.IsDetachment = true
+	copied.Fatigue = 1
+	copied.IsDeprived = true
+	copied.Conditions[0].Rounds = 1
+	copied.Items[0].Weapon.Charges = 1
 
 	if original.Equals(&copied) {
 		t.Errorf("modifying the copy affected the original: %v", original)
@@ -428,13 +885,128 @@ func TestCreatureDeepCopy(t *testing.T) {
 	if original.IsDetachment == copied.IsDetachment {
 		t.Errorf("original.IsDetachment == copied.IsDetachment")
 	}
+	if original.Fatigue == copied.Fatigue {
+		t.Errorf("original.Fatigue == copied.Fatigue")
+	}
+	if original.IsDeprived == copied.IsDeprived {
+		t.Errorf("original.IsDeprived == copied.IsDeprived")
+	}
+	if slices.Equal(original.Conditions, copied.Conditions) {
+		t.Errorf("original.Conditions == copied.Conditions")
+	}
+	if item.ItemSlice(original.Items).Equals(item.ItemSlice(copied.Items)) {
+		t.Errorf("original.Items == copied.Items")
+	}
+}
+
+func TestCreaturePairedAttackIdx(t *testing.T) {
+	creature := Creature{
+		ID: "player-0", Name: "John Appleseed",
+		Attacks: []atk.Attack{
+			{
+				Name: "Sword", TargetCharacteristic: atk.STR,
+				Dice: dice.D8, DiceCnt: 1, Charges: -1,
+				IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+				PairedWith: 2,
+				Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+				Recharge:   nil,
+				DmgMod:     0,
+				MinDmg:     0,
+				Usage:      atk.UsageAlways,
+				Area:       atk.AreaZone,
+				MaxTargets: 0,
+			},
+			{
+				Name: "Dagger", TargetCharacteristic: atk.STR,
+				Dice: dice.D4, DiceCnt: 1, Charges: 0,
+				IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+				PairedWith: 0,
+				Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+				Recharge:   nil,
+				DmgMod:     0,
+				MinDmg:     0,
+				Usage:      atk.UsageAlways,
+				Area:       atk.AreaZone,
+				MaxTargets: 0,
+			},
+			{
+				Name: "Axe", TargetCharacteristic: atk.STR,
+				Dice: dice.D6, DiceCnt: 1, Charges: -1,
+				IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+				PairedWith: 4,
+				Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+				Recharge:   nil,
+				DmgMod:     0,
+				MinDmg:     0,
+				Usage:      atk.UsageAlways,
+				Area:       atk.AreaZone,
+				MaxTargets: 0,
+			},
+			{
+				Name: "Club", TargetCharacteristic: atk.STR,
+				Dice: dice.D6, DiceCnt: 1, Charges: -1,
+				IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+				PairedWith: 0,
+				Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+				Recharge:   nil,
+				DmgMod:     0,
+				MinDmg:     0,
+				Usage:      atk.UsageAlways,
+				Area:       atk.AreaZone,
+				MaxTargets: 0,
+			},
+			{
+				Name: "Whip", TargetCharacteristic: atk.STR,
+				Dice: dice.D4, DiceCnt: 1, Charges: -1,
+				IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+				PairedWith: 8,
+				Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+				Recharge:   nil,
+				DmgMod:     0,
+				MinDmg:     0,
+				Usage:      atk.UsageAlways,
+				Area:       atk.AreaZone,
+				MaxTargets: 0,
+			},
+		},
+		STR: 8, DEX: 14, WIL: 8, HP: 4, MaxHP: 4, Scars: nil, Armor: 0,
+		IsDetachment: false, Conditions: nil,
+		Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil, Items: nil,
+		Zone: atk.ZoneEngaged,
+	}
+	tests := []struct {
+		name      string
+		attackIdx uint
+		wantIdx   uint
+		wantOk    bool
+	}{
+		{name: "PairedWithNoCharges", attackIdx: 0, wantIdx: 0, wantOk: false},
+		{name: "NotPaired", attackIdx: 1, wantIdx: 0, wantOk: false},
+		{name: "Paired", attackIdx: 2, wantIdx: 3, wantOk: true},
+		{name: "InvalidPairedWith", attackIdx: 4, wantIdx: 0, wantOk: false},
+		{name: "InvalidAttackIdx", attackIdx: 5, wantIdx: 0, wantOk: false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			gotIdx, gotOk := creature.PairedAttackIdx(test.attackIdx)
+			if gotIdx != test.wantIdx || gotOk != test.wantOk {
+				t.Fatalf(
+					"Creature.PairedAttackIdx() = (%d, %t), want (%d, %t)",
+					gotIdx, gotOk, test.wantIdx, test.wantOk,
+				)
+			}
+		})
+	}
 }
 
 func TestCreatureIsOut(t *testing.T) {
 	spear := atk.Attack{
 		Name: "Spear", TargetCharacteristic: atk.STR,
 		Dice: dice.D6, DiceCnt: 1, Charges: -1,
-		IsBlast: false,
+		IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone, PairedWith: 0,
+		Inflicts: atk.Condition{Kind: atk.ConditionNone, Rounds: 0}, Recharge: nil,
+		DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+		Area: atk.AreaZone, MaxTargets: 0,
 	}
 	tests := []struct {
 		name     string
@@ -445,8 +1017,10 @@ func TestCreatureIsOut(t *testing.T) {
 			name: "AliveCreature",
 			creature: Creature{
 				ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
-				STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-				IsDetachment: false,
+				STR: 8, DEX: 14, WIL: 8, HP: 4, MaxHP: 4, Scars: nil, Armor: 0,
+				IsDetachment: false, Conditions: nil,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil, Zone: atk.ZoneEngaged,
 			},
 			want: false,
 		},
@@ -454,8 +1028,10 @@ func TestCreatureIsOut(t *testing.T) {
 			name: "DeadCreature",
 			creature: Creature{
 				ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
-				STR: 0, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-				IsDetachment: false,
+				STR: 0, DEX: 14, WIL: 8, HP: 4, MaxHP: 4, Scars: nil, Armor: 0,
+				IsDetachment: false, Conditions: nil,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil, Zone: atk.ZoneEngaged,
 			},
 			want: true,
 		},
@@ -463,8 +1039,10 @@ func TestCreatureIsOut(t *testing.T) {
 			name: "ParalyzedCreature",
 			creature: Creature{
 				ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
-				STR: 8, DEX: 0, WIL: 8, HP: 4, Armor: 0,
-				IsDetachment: false,
+				STR: 8, DEX: 0, WIL: 8, HP: 4, MaxHP: 4, Scars: nil, Armor: 0,
+				IsDetachment: false, Conditions: nil,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil, Zone: atk.ZoneEngaged,
 			},
 			want: true,
 		},
@@ -472,8 +1050,10 @@ func TestCreatureIsOut(t *testing.T) {
 			name: "DeliriousCreature",
 			creature: Creature{
 				ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
-				STR: 8, DEX: 14, WIL: 0, HP: 4, Armor: 0,
-				IsDetachment: false,
+				STR: 8, DEX: 14, WIL: 0, HP: 4, MaxHP: 4, Scars: nil, Armor: 0,
+				IsDetachment: false, Conditions: nil,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil, Zone: atk.ZoneEngaged,
 			},
 			want: true,
 		},
@@ -481,8 +1061,10 @@ func TestCreatureIsOut(t *testing.T) {
 			name: "All0Creature",
 			creature: Creature{
 				ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
-				STR: 0, DEX: 0, WIL: 0, HP: 4, Armor: 0,
-				IsDetachment: false,
+				STR: 0, DEX: 0, WIL: 0, HP: 4, MaxHP: 4, Scars: nil, Armor: 0,
+				IsDetachment: false, Conditions: nil,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil, Zone: atk.ZoneEngaged,
 			},
 			want: true,
 		},
@@ -495,3 +1077,552 @@ func TestCreatureIsOut(t *testing.T) {
 		})
 	}
 }
+
+func TestCreatureEffectiveMaxHP(t *testing.T) {
+	tests := []struct {
+		name      string
+		hp, maxHP uint8
+		want      uint8
+	}{
+		{name: "MaxHPSet", hp: 2, maxHP: 5, want: 5},
+		{name: "MaxHPNotSet", hp: 2, maxHP: 0, want: 2},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := Creature{
+				ID: "monster-0", Name: "Root Goblin", Attacks: nil,
+				STR: 8, DEX: 14, WIL: 8, HP: test.hp, MaxHP: test.maxHP, Scars: nil,
+				Armor: 0, IsDetachment: false, Conditions: nil,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil, Zone: atk.ZoneEngaged,
+			}
+			if got := c.EffectiveMaxHP(); got != test.want {
+				t.Fatalf("Creature.EffectiveMaxHP() = %d, want %d", got, test.want)
+			}
+		})
+	}
+}
+
+func TestCreatureValidateSpellbooks(t *testing.T) {
+	noCondition := atk.Condition{Kind: atk.ConditionNone, Rounds: 0}
+	wizard := Creature{
+		ID: "player-0", Name: "Merlin",
+		Attacks: []atk.Attack{
+			{
+				Name: "Staff", TargetCharacteristic: atk.STR,
+				Dice: dice.D6, DiceCnt: 1, Charges: -1,
+				IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitBulky,
+				PairedWith: 0, Inflicts: noCondition, Recharge: nil,
+				DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+				Area: atk.AreaZone, MaxTargets: 0,
+			},
+		},
+		Spellbooks: []spell.Book{
+			{
+				Name: "Fireball",
+				Effect: atk.Attack{
+					Name: "Fireball", TargetCharacteristic: atk.STR,
+					Dice: dice.D8, DiceCnt: 1, Charges: -1,
+					IsBlast: true, Pool: atk.PoolHighest, Traits: atk.TraitMagical,
+					PairedWith: 0, Inflicts: noCondition, Recharge: nil,
+					DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+					Area: atk.AreaZone, MaxTargets: 0,
+				},
+			},
+			{
+				Name: "Fear",
+				Effect: atk.Attack{
+					Name: "Fear", TargetCharacteristic: atk.WIL,
+					Dice: dice.D4, DiceCnt: 1, Charges: -1,
+					IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitMagical,
+					PairedWith: 0, Recharge: nil, DmgMod: 0, MinDmg: 0,
+					Usage: atk.UsageAlways, Area: atk.AreaZone, MaxTargets: 0,
+					Inflicts: atk.Condition{
+						Kind: atk.ConditionFrightened, Rounds: 2,
+					},
+				},
+			},
+		},
+		Conditions: nil, Fatigue: 0,
+		STR: 8, DEX: 12, WIL: 15, HP: 4, MaxHP: 4, Scars: nil, Armor: 0,
+		IsDetachment: false, IsDeprived: false, Supports: nil, Items: nil,
+		Zone: atk.ZoneEngaged,
+	}
+	tests := []struct {
+		name       string
+		modify     func(c *Creature)
+		wantErrCnt int
+	}{
+		{name: "Valid", modify: func(*Creature) {}, wantErrCnt: 0},
+		{
+			name:       "OnlySpellbooks",
+			modify:     func(c *Creature) { c.Attacks = nil },
+			wantErrCnt: 0,
+		},
+		{
+			name:       "InvalidSpellbook",
+			modify:     func(c *Creature) { c.Spellbooks[1].Name = "" },
+			wantErrCnt: 1,
+		},
+		{
+			name:       "FatigueExceedsSlots",
+			modify:     func(c *Creature) { c.Fatigue = 9 },
+			wantErrCnt: 1,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			creature := wizard.DeepCopy()
+			test.modify(&creature)
+			err := creature.Validate()
+
+			errCnt := 0
+			if jointErr, ok := err.(interface{ Unwrap() []error }); ok {
+				errCnt = len(jointErr.Unwrap())
+			}
+			if errCnt != test.wantErrCnt {
+				t.Fatalf(
+					"Creature.Validate(): want %d errors, got %d: %v",
+					test.wantErrCnt, errCnt, err,
+				)
+			}
+		})
+	}
+}
+
+func TestCreatureValidateItems(t *testing.T) {
+	lancelot := Creature{
+		ID: "player-0", Name: "Lancelot",
+		Attacks: nil,
+		Items: []item.Item{
+			{
+				Name: "Longsword", Kind: item.KindWeapon, Armor: 0,
+				Weapon: &atk.Attack{
+					Name: "Longsword", TargetCharacteristic: atk.STR,
+					Dice: dice.D10, DiceCnt: 1, Charges: -1,
+					IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitBulky,
+					PairedWith: 0,
+					Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+					Recharge:   nil, DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+					Area: atk.AreaZone, MaxTargets: 0,
+				},
+				IsBulky: true, IsEquipped: true, AttackIdx: 0,
+			},
+			{
+				Name: "Dagger", Kind: item.KindWeapon, Armor: 0,
+				Weapon: &atk.Attack{
+					Name: "Dagger", TargetCharacteristic: atk.STR,
+					Dice: dice.D6, DiceCnt: 1, Charges: -1,
+					IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+					PairedWith: 0,
+					Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+					Recharge:   nil, DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+					Area: atk.AreaZone, MaxTargets: 0,
+				},
+				IsBulky: false, IsEquipped: false, AttackIdx: 0,
+			},
+			{
+				Name: "Brigandine", Weapon: nil, Kind: item.KindArmor, Armor: 1,
+				IsBulky: false, IsEquipped: true, AttackIdx: 0,
+			},
+			{
+				Name: "Shield", Weapon: nil, Kind: item.KindShield, Armor: 1,
+				IsBulky: false, IsEquipped: true, AttackIdx: 0,
+			},
+			{
+				Name: "Helmet", Weapon: nil, Kind: item.KindHelmet, Armor: 1,
+				IsBulky: false, IsEquipped: true, AttackIdx: 0,
+			},
+			{
+				Name: "Rope", Weapon: nil, Kind: item.KindGear, Armor: 0,
+				IsBulky: false, IsEquipped: false, AttackIdx: 0,
+			},
+		},
+		STR: 14, DEX: 10, WIL: 9, HP: 5, MaxHP: 5, Scars: nil, Armor: 0,
+		Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+		IsDetachment: false, Conditions: nil, Zone: atk.ZoneEngaged,
+	}
+	tests := []struct {
+		name       string
+		modify     func(c *Creature)
+		wantErrCnt int
+	}{
+		{name: "Valid", modify: func(c *Creature) { c.Equip() }, wantErrCnt: 0},
+		{
+			name:       "OnlyItemsWithoutAttacks",
+			modify:     func(*Creature) {},
+			wantErrCnt: 1,
+		},
+		{
+			name: "InvalidItem",
+			modify: func(c *Creature) {
+				c.Equip()
+				c.Items[5].Name = ""
+			},
+			wantErrCnt: 1,
+		},
+		{
+			name: "ItemsExceedSlots",
+			modify: func(c *Creature) {
+				c.Equip()
+				c.Fatigue = 4
+			},
+			wantErrCnt: 1,
+		},
+		{
+			name: "Overloaded",
+			modify: func(c *Creature) {
+				c.Equip()
+				c.Fatigue = 3
+			},
+			wantErrCnt: 1,
+		},
+		{
+			name: "OverloadedWithHP0",
+			modify: func(c *Creature) {
+				c.Equip()
+				c.Fatigue, c.HP = 3, 0
+			},
+			wantErrCnt: 0,
+		},
+		{
+			name: "ItemsExceedSlotsWithHP0",
+			modify: func(c *Creature) {
+				c.Equip()
+				c.Fatigue, c.HP = 4, 0
+			},
+			wantErrCnt: 2,
+		},
+		{
+			name: "AttackIdxOutOfRange",
+			modify: func(c *Creature) {
+				c.Equip()
+				c.Items[0].AttackIdx = 2
+			},
+			wantErrCnt: 1,
+		},
+		{
+			name: "AttackIdxAddedByAnotherItem",
+			modify: func(c *Creature) {
+				c.Equip()
+				c.Items[1].AttackIdx = 1
+			},
+			wantErrCnt: 1,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fighter := lancelot.DeepCopy()
+			test.modify(&fighter)
+			err := fighter.Validate()
+
+			errCnt := 0
+			if jointErr, ok := err.(interface{ Unwrap() []error }); ok {
+				errCnt = len(jointErr.Unwrap())
+			}
+			if errCnt != test.wantErrCnt {
+				t.Fatalf(
+					"Creature.Validate(): want %d errors, got %d: %v",
+					test.wantErrCnt, errCnt, err,
+				)
+			}
+		})
+	}
+}
+
+func TestCreatureEqualsItems(t *testing.T) {
+	lancelot := Creature{
+		ID: "player-0", Name: "Lancelot",
+		Attacks: nil,
+		Items: []item.Item{
+			{
+				Name: "Longsword", Kind: item.KindWeapon, Armor: 0,
+				Weapon: &atk.Attack{
+					Name: "Longsword", TargetCharacteristic: atk.STR,
+					Dice: dice.D10, DiceCnt: 1, Charges: -1,
+					IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitBulky,
+					PairedWith: 0,
+					Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+					Recharge:   nil, DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+					Area: atk.AreaZone, MaxTargets: 0,
+				},
+				IsBulky: true, IsEquipped: true, AttackIdx: 0,
+			},
+			{
+				Name: "Dagger", Kind: item.KindWeapon, Armor: 0,
+				Weapon: &atk.Attack{
+					Name: "Dagger", TargetCharacteristic: atk.STR,
+					Dice: dice.D6, DiceCnt: 1, Charges: -1,
+					IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+					PairedWith: 0,
+					Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+					Recharge:   nil, DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+					Area: atk.AreaZone, MaxTargets: 0,
+				},
+				IsBulky: false, IsEquipped: false, AttackIdx: 0,
+			},
+			{
+				Name: "Brigandine", Weapon: nil, Kind: item.KindArmor, Armor: 1,
+				IsBulky: false, IsEquipped: true, AttackIdx: 0,
+			},
+			{
+				Name: "Shield", Weapon: nil, Kind: item.KindShield, Armor: 1,
+				IsBulky: false, IsEquipped: true, AttackIdx: 0,
+			},
+			{
+				Name: "Helmet", Weapon: nil, Kind: item.KindHelmet, Armor: 1,
+				IsBulky: false, IsEquipped: true, AttackIdx: 0,
+			},
+			{
+				Name: "Rope", Weapon: nil, Kind: item.KindGear, Armor: 0,
+				IsBulky: false, IsEquipped: false, AttackIdx: 0,
+			},
+		},
+		STR: 14, DEX: 10, WIL: 9, HP: 5, MaxHP: 5, Scars: nil, Armor: 0,
+		Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+		IsDetachment: false, Conditions: nil, Zone: atk.ZoneEngaged,
+	}
+	tests := []struct {
+		name   string
+		modify func(c *Creature)
+	}{
+		{name: "NilItems", modify: func(c *Creature) { c.Items = nil }},
+		{
+			name:   "DifferentItems",
+			modify: func(c *Creature) { c.Items[0].IsEquipped = false },
+		},
+		{
+			name:   "DifferentWeapon",
+			modify: func(c *Creature) { c.Items[0].Weapon.Dice = dice.D12 },
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			this, other := lancelot.DeepCopy(), lancelot.DeepCopy()
+			test.modify(&other)
+			if this.Equals(&other) {
+				t.Fatalf("Creature.Equals() = true, want false")
+			}
+		})
+	}
+}
+
+func TestCreatureEqualsSpellcasting(t *testing.T) {
+	noCondition := atk.Condition{Kind: atk.ConditionNone, Rounds: 0}
+	wizard := Creature{
+		ID: "player-0", Name: "Merlin",
+		Attacks: []atk.Attack{
+			{
+				Name: "Staff", TargetCharacteristic: atk.STR,
+				Dice: dice.D6, DiceCnt: 1, Charges: -1,
+				IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitBulky,
+				PairedWith: 0, Inflicts: noCondition, Recharge: nil,
+				DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+				Area: atk.AreaZone, MaxTargets: 0,
+			},
+		},
+		Spellbooks: []spell.Book{
+			{
+				Name: "Fireball",
+				Effect: atk.Attack{
+					Name: "Fireball", TargetCharacteristic: atk.STR,
+					Dice: dice.D8, DiceCnt: 1, Charges: -1,
+					IsBlast: true, Pool: atk.PoolHighest, Traits: atk.TraitMagical,
+					PairedWith: 0, Inflicts: noCondition, Recharge: nil,
+					DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+					Area: atk.AreaZone, MaxTargets: 0,
+				},
+			},
+			{
+				Name: "Fear",
+				Effect: atk.Attack{
+					Name: "Fear", TargetCharacteristic: atk.WIL,
+					Dice: dice.D4, DiceCnt: 1, Charges: -1,
+					IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitMagical,
+					PairedWith: 0, Recharge: nil, DmgMod: 0, MinDmg: 0,
+					Usage: atk.UsageAlways, Area: atk.AreaZone, MaxTargets: 0,
+					Inflicts: atk.Condition{
+						Kind: atk.ConditionFrightened, Rounds: 2,
+					},
+				},
+			},
+		},
+		Conditions: nil, Fatigue: 0,
+		STR: 8, DEX: 12, WIL: 15, HP: 4, MaxHP: 4, Scars: nil, Armor: 0,
+		IsDetachment: false, IsDeprived: false, Supports: nil, Items: nil,
+		Zone: atk.ZoneEngaged,
+	}
+	tests := []struct {
+		name   string
+		modify func(c *Creature)
+	}{
+		{
+			name:   "DifferentSpellbooks",
+			modify: func(c *Creature) { c.Spellbooks[0].Effect.Dice = dice.D10 },
+		},
+		{name: "DifferentFatigue", modify: func(c *Creature) { c.Fatigue = 1 }},
+		{
+			name:   "DifferentIsDeprived",
+			modify: func(c *Creature) { c.IsDeprived = true },
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			other := wizard.DeepCopy()
+			test.modify(&other)
+			if wizard.Equals(&other) {
+				t.Fatalf("Creature.Equals() = true, want false")
+			}
+		})
+	}
+}
+
+func TestCreatureDeepCopySpellbooks(t *testing.T) {
+	noCondition := atk.Condition{Kind: atk.ConditionNone, Rounds: 0}
+	original := Creature{
+		ID: "player-0", Name: "Merlin",
+		Attacks: []atk.Attack{
+			{
+				Name: "Staff", TargetCharacteristic: atk.STR,
+				Dice: dice.D6, DiceCnt: 1, Charges: -1,
+				IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitBulky,
+				PairedWith: 0, Inflicts: noCondition, Recharge: nil,
+				DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+				Area: atk.AreaZone, MaxTargets: 0,
+			},
+		},
+		Spellbooks: []spell.Book{
+			{
+				Name: "Fireball",
+				Effect: atk.Attack{
+					Name: "Fireball", TargetCharacteristic: atk.STR,
+					Dice: dice.D8, DiceCnt: 1, Charges: -1,
+					IsBlast: true, Pool: atk.PoolHighest, Traits: atk.TraitMagical,
+					PairedWith: 0, Inflicts: noCondition, Recharge: nil,
+					DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+					Area: atk.AreaZone, MaxTargets: 0,
+				},
+			},
+			{
+				Name: "Fear",
+				Effect: atk.Attack{
+					Name: "Fear", TargetCharacteristic: atk.WIL,
+					Dice: dice.D4, DiceCnt: 1, Charges: -1,
+					IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitMagical,
+					PairedWith: 0, Recharge: nil, DmgMod: 0, MinDmg: 0,
+					Usage: atk.UsageAlways, Area: atk.AreaZone, MaxTargets: 0,
+					Inflicts: atk.Condition{
+						Kind: atk.ConditionFrightened, Rounds: 2,
+					},
+				},
+			},
+		},
+		Conditions: nil, Fatigue: 0,
+		STR: 8, DEX: 12, WIL: 15, HP: 4, MaxHP: 4, Scars: nil, Armor: 0,
+		IsDetachment: false, IsDeprived: false, Supports: nil, Items: nil,
+		Zone: atk.ZoneEngaged,
+	}
+	copied := original.DeepCopy()
+
+	if !original.Equals(&copied) {
+		t.Fatalf("Creature.DeepCopy() = %v, want %v", &copied, &original)
+	}
+
+	copied.Spellbooks[0].Effect.Name = "Lightning Bolt"
+	if original.Spellbooks[0].Effect.Name == copied.Spellbooks[0].Effect.Name {
+		t.Errorf("original.Spellbooks == copied.Spellbooks")
+	}
+}
+
+func TestCreatureSupports(t *testing.T) {
+	cleric := Creature{
+		ID: "player-0", Name: "Tuck",
+		Attacks: []atk.Attack{
+			{
+				Name: "Staff", TargetCharacteristic: atk.STR,
+				Dice: dice.D6, DiceCnt: 1, Charges: -1,
+				IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitBulky,
+				PairedWith: 0,
+				Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+				Recharge:   nil, DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+				Area: atk.AreaZone, MaxTargets: 0,
+			},
+		},
+		Spellbooks: nil,
+		Supports: []act.Support{
+			{
+				Name: "Cure Wounds", Kind: act.SupportHeal,
+				Dice: dice.D6, DiceCnt: 1, Rounds: 0, Charges: -1,
+			},
+			{
+				Name: "Shield of Faith", Kind: act.SupportProtect,
+				Dice: 0, DiceCnt: 0, Rounds: 1, Charges: 2,
+			},
+		},
+		Conditions: nil, Fatigue: 0,
+		STR: 8, DEX: 12, WIL: 15, HP: 4, MaxHP: 4, Scars: nil, Armor: 0,
+		IsDetachment: false, IsDeprived: false, Items: nil,
+		Zone: atk.ZoneEngaged,
+	}
+	original := cleric.DeepCopy()
+	if err := cleric.Validate(); err != nil {
+		t.Fatalf("Creature.Validate(): want nil, got %v", err)
+	}
+
+	cleric.Supports[1].Rounds = 0
+	if err := cleric.Validate(); err == nil {
+		t.Fatalf("Creature.Validate(): want error for invalid support, got nil")
+	}
+
+	copied := original.DeepCopy()
+	if !original.Equals(&copied) {
+		t.Fatalf("Creature.DeepCopy() = %v, want %v", &copied, &original)
+	}
+
+	copied.Supports[1].Charges--
+	if original.Equals(&copied) {
+		t.Fatalf("Creature.Equals() = true for different supports, want false")
+	}
+	if original.Supports[1].Charges != 2 {
+		t.Errorf("original.Supports == copied.Supports")
+	}
+}
+
+func TestCreatureZone(t *testing.T) {
+	archer := Creature{
+		ID: "player-0", Name: "Robin",
+		Attacks: []atk.Attack{
+			{
+				Name: "Longbow", TargetCharacteristic: atk.STR,
+				Dice: dice.D8, DiceCnt: 1, Charges: -1,
+				IsBlast: false, Pool: atk.PoolHighest,
+				Traits: atk.TraitRanged | atk.TraitBulky, PairedWith: 0,
+				Inflicts: atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+				Recharge: nil, DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+				Area: atk.AreaZone, MaxTargets: 0,
+			},
+		},
+		STR: 10, DEX: 14, WIL: 9, HP: 5, MaxHP: 5, Scars: nil, Armor: 0,
+		IsDetachment: false, Conditions: nil,
+		Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil, Items: nil,
+		Zone: atk.ZoneFar,
+	}
+	if err := archer.Validate(); err != nil {
+		t.Fatalf("Creature.Validate(): want nil, got %v", err)
+	}
+
+	copied := archer.DeepCopy()
+	if copied.Zone != atk.ZoneFar || !archer.Equals(&copied) {
+		t.Fatalf("Creature.DeepCopy() = %v, want %v", &copied, &archer)
+	}
+
+	copied.Zone = atk.ZoneNear
+	if archer.Equals(&copied) {
+		t.Fatalf("Creature.Equals() = true for different zones, want false")
+	}
+
+	archer.Zone = atk.Zone(42)
+	if err := archer.Validate(); err == nil {
+		t.Fatalf("Creature.Validate(): want error for unknown zone, got nil")
+	}
+}
@@ -0,0 +1,34 @@
+package creat
+
+import (
+	"github.com/rozag/cabasi/atk"
+	"github.com/rozag/cabasi/dice"
+)
+
+// DeprivationFatigue is the Fatigue a deprived creature takes for each day it
+// stays deprived.
+const DeprivationFatigue = 1
+
+// SufferDeprivation makes the Creature endure a day of deprivation: a
+// deprived creature takes DeprivationFatigue, see TakeFatigue. It returns
+// true if the Fatigue was taken and false if the Creature isn't deprived or
+// has no free slots left for it.
+func (c *Creature) SufferDeprivation() bool {
+	if !c.IsDeprived {
+		return false
+	}
+	return c.TakeFatigue(DeprivationFatigue)
+}
+
+// CriticalSave makes a STR save against critical damage. A deprived creature
+// is weakened and makes the save with disadvantage.
+func (c *Creature) CriticalSave(rng dice.RNG) SaveResult {
+	mode := SaveNormal
+	if c.IsDeprived {
+		mode = SaveDisadvantage
+	}
+	return c.SaveWith(
+		atk.STR, rng,
+		SaveOpts{Reason: "critical damage", Mode: mode, Modifier: 0},
+	)
+}
@@ -0,0 +1,192 @@
+package creat
+
+import (
+	"testing"
+
+	"github.com/rozag/cabasi/atk"
+	"github.com/rozag/cabasi/dice"
+	"github.com/rozag/cabasi/dice/dicetest"
+	"github.com/rozag/cabasi/item"
+)
+
+func TestCreatureSufferDeprivation(t *testing.T) {
+	lancelot := Creature{
+		ID: "player-0", Name: "Lancelot",
+		Attacks: nil,
+		Items: []item.Item{
+			{
+				Name: "Longsword", Kind: item.KindWeapon, Armor: 0,
+				Weapon: &atk.Attack{
+					Name: "Longsword", TargetCharacteristic: atk.STR,
+					Dice: dice.D10, DiceCnt: 1, Charges: -1,
+					IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitBulky,
+					PairedWith: 0,
+					Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+					Recharge:   nil, DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+					Area: atk.AreaZone, MaxTargets: 0,
+				},
+				IsBulky: true, IsEquipped: true, AttackIdx: 0,
+			},
+			{
+				Name: "Dagger", Kind: item.KindWeapon, Armor: 0,
+				Weapon: &atk.Attack{
+					Name: "Dagger", TargetCharacteristic: atk.STR,
+					Dice: dice.D6, DiceCnt: 1, Charges: -1,
+					IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+					PairedWith: 0,
+					Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+					Recharge:   nil, DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+					Area: atk.AreaZone, MaxTargets: 0,
+				},
+				IsBulky: false, IsEquipped: false, AttackIdx: 0,
+			},
+			{
+				Name: "Brigandine", Weapon: nil, Kind: item.KindArmor, Armor: 1,
+				IsBulky: false, IsEquipped: true, AttackIdx: 0,
+			},
+			{
+				Name: "Shield", Weapon: nil, Kind: item.KindShield, Armor: 1,
+				IsBulky: false, IsEquipped: true, AttackIdx: 0,
+			},
+			{
+				Name: "Helmet", Weapon: nil, Kind: item.KindHelmet, Armor: 1,
+				IsBulky: false, IsEquipped: true, AttackIdx: 0,
+			},
+			{
+				Name: "Rope", Weapon: nil, Kind: item.KindGear, Armor: 0,
+				IsBulky: false, IsEquipped: false, AttackIdx: 0,
+			},
+		},
+		STR: 14, DEX: 10, WIL: 9, HP: 5, MaxHP: 5, Scars: nil, Armor: 0,
+		Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+		IsDetachment: false, Conditions: nil, Zone: atk.ZoneEngaged,
+	}
+	tests := []struct {
+		name        string
+		fatigue     uint8
+		isDeprived  bool
+		want        bool
+		wantFatigue uint8
+		wantHP      uint8
+	}{
+		{
+			name: "NotDeprived", fatigue: 0, isDeprived: false,
+			want: false, wantFatigue: 0, wantHP: 5,
+		},
+		{
+			name: "Deprived", fatigue: 0, isDeprived: true,
+			want: true, wantFatigue: 1, wantHP: 5,
+		},
+		{
+			name: "DeprivedTakesLastSlot", fatigue: 2, isDeprived: true,
+			want: true, wantFatigue: 3, wantHP: 0,
+		},
+		{
+			name: "DeprivedNoFreeSlots", fatigue: 3, isDeprived: true,
+			want: false, wantFatigue: 3, wantHP: 5,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fighter := lancelot.DeepCopy()
+			fighter.Fatigue = test.fatigue
+			fighter.IsDeprived = test.isDeprived
+			got := fighter.SufferDeprivation()
+			if got != test.want ||
+				fighter.Fatigue != test.wantFatigue ||
+				fighter.HP != test.wantHP {
+				t.Errorf(
+					"Creature.SufferDeprivation() = %t with Fatigue %d and HP %d, "+
+						"want %t with Fatigue %d and HP %d",
+					got, fighter.Fatigue, fighter.HP,
+					test.want, test.wantFatigue, test.wantHP,
+				)
+			}
+		})
+	}
+}
+
+func TestCreatureCriticalSave(t *testing.T) {
+	lancelot := Creature{
+		ID: "player-0", Name: "Lancelot",
+		Attacks: nil,
+		Items: []item.Item{
+			{
+				Name: "Longsword", Kind: item.KindWeapon, Armor: 0,
+				Weapon: &atk.Attack{
+					Name: "Longsword", TargetCharacteristic: atk.STR,
+					Dice: dice.D10, DiceCnt: 1, Charges: -1,
+					IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitBulky,
+					PairedWith: 0,
+					Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+					Recharge:   nil, DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+					Area: atk.AreaZone, MaxTargets: 0,
+				},
+				IsBulky: true, IsEquipped: true, AttackIdx: 0,
+			},
+			{
+				Name: "Dagger", Kind: item.KindWeapon, Armor: 0,
+				Weapon: &atk.Attack{
+					Name: "Dagger", TargetCharacteristic: atk.STR,
+					Dice: dice.D6, DiceCnt: 1, Charges: -1,
+					IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+					PairedWith: 0,
+					Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+					Recharge:   nil, DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+					Area: atk.AreaZone, MaxTargets: 0,
+				},
+				IsBulky: false, IsEquipped: false, AttackIdx: 0,
+			},
+			{
+				Name: "Brigandine", Weapon: nil, Kind: item.KindArmor, Armor: 1,
+				IsBulky: false, IsEquipped: true, AttackIdx: 0,
+			},
+			{
+				Name: "Shield", Weapon: nil, Kind: item.KindShield, Armor: 1,
+				IsBulky: false, IsEquipped: true, AttackIdx: 0,
+			},
+			{
+				Name: "Helmet", Weapon: nil, Kind: item.KindHelmet, Armor: 1,
+				IsBulky: false, IsEquipped: true, AttackIdx: 0,
+			},
+			{
+				Name: "Rope", Weapon: nil, Kind: item.KindGear, Armor: 0,
+				IsBulky: false, IsEquipped: false, AttackIdx: 0,
+			},
+		},
+		STR: 14, DEX: 10, WIL: 9, HP: 5, MaxHP: 5, Scars: nil, Armor: 0,
+		Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+		IsDetachment: false, Conditions: nil, Zone: atk.ZoneEngaged,
+	}
+	tests := []struct {
+		name       string
+		isDeprived bool
+		faces      []uint
+		want       SaveResult
+	}{
+		{
+			name: "Normal", isDeprived: false, faces: []uint{3},
+			want: SaveResult{
+				Characteristic: atk.STR, Mode: SaveNormal,
+				Rolled: 3, Discarded: 0, Target: 14, Success: true,
+			},
+		},
+		{
+			name: "DeprivedWithDisadvantage", isDeprived: true, faces: []uint{3, 15},
+			want: SaveResult{
+				Characteristic: atk.STR, Mode: SaveDisadvantage,
+				Rolled: 15, Discarded: 3, Target: 14, Success: false,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fighter := lancelot.DeepCopy()
+			fighter.IsDeprived = test.isDeprived
+			got := fighter.CriticalSave(dicetest.NewSequence(t, test.faces...))
+			if got != test.want {
+				t.Fatalf("Creature.CriticalSave() = %s, want %s", got, test.want)
+			}
+		})
+	}
+}
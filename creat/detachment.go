@@ -0,0 +1,180 @@
+package creat
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+
+	"github.com/rozag/cabasi/atk"
+)
+
+// DetachmentMembersMin is the minimum number of individuals forming a
+// detachment.
+const DetachmentMembersMin = 2
+
+// Detachment is a large group of similar individuals fighting together as a
+// single creature. Creature is the detachment as it fights in a battle,
+// Members are the individuals it's made of as they were when it was formed.
+type Detachment struct {
+	Creature Creature
+	Members  []Creature
+}
+
+// NewDetachment forms a detachment with the provided ID and name from the
+// individuals. The detachment's HP is the combined HP of the members, its
+// STR, DEX, WIL, and Armor are the members' averages rounded down, and it
+// makes the members' attacks, which must be the same for all of them. It
+// stands in the first member's zone and has no spellbooks, supports, items,
+// or conditions. It returns an error if the members can't form a valid
+// detachment.
+func NewDetachment(id ID, name string, members []Creature) (Detachment, error) {
+	var errs []error
+
+	if len(members) < DetachmentMembersMin {
+		errs = append(errs, fmt.Errorf(
+			"detachment must have at least %d members, got %d",
+			DetachmentMembersMin, len(members),
+		))
+	}
+
+	hp := uint(0)
+	for idx, member := range members {
+		if member.IsDetachment {
+			errs = append(errs, fmt.Errorf("member at idx %d is a detachment", idx))
+		}
+
+		if member.IsOut() {
+			errs = append(
+				errs, fmt.Errorf("member at idx %d is out of the battle", idx),
+			)
+		}
+
+		if !atk.AttackSlice(member.Attacks).Equals(members[0].Attacks) {
+			errs = append(errs, fmt.Errorf(
+				"member at idx %d must have the same attacks as the first one", idx,
+			))
+		}
+
+		hp += uint(member.HP)
+	}
+
+	if hp > math.MaxUint8 {
+		errs = append(errs, fmt.Errorf(
+			"combined HP must be at most %d, got %d", math.MaxUint8, hp,
+		))
+	}
+
+	if len(errs) > 0 {
+		var none Detachment
+		return none, errors.Join(errs...)
+	}
+
+	first := members[0].DeepCopy()
+	detachment := Detachment{
+		Creature: Creature{
+			ID: id, Name: name, Attacks: first.Attacks,
+			Spellbooks: nil, Supports: nil, Items: nil, Conditions: nil, Scars: nil,
+			STR:   average(members, func(c *Creature) uint8 { return c.STR }),
+			DEX:   average(members, func(c *Creature) uint8 { return c.DEX }),
+			WIL:   average(members, func(c *Creature) uint8 { return c.WIL }),
+			Armor: average(members, func(c *Creature) uint8 { return c.Armor }),
+			// Suppressing gosec "G115: integer overflow conversion uint -> uint8"
+			// because the combined HP is checked to fit uint8 above.
+			HP: uint8(hp), MaxHP: uint8(hp), // nolint:gosec
+			Fatigue: 0, Zone: first.Zone, IsDetachment: true, IsDeprived: false,
+		},
+		Members: make([]Creature, 0, len(members)),
+	}
+	for _, member := range members {
+		detachment.Members = append(detachment.Members, member.DeepCopy())
+	}
+
+	if err := detachment.Creature.Validate(); err != nil {
+		var none Detachment
+		return none, fmt.Errorf("invalid detachment: %w", err)
+	}
+
+	return detachment, nil
+}
+
+// Break breaks the reduced detachment back into the individuals. The
+// detachment's HP left is handed out to the members in order, each getting
+// at most its own HP, and the members getting none are lost. A detachment
+// with no HP left that is still in the battle leaves its first member with
+// HP 0, and a detachment out of the battle leaves no one. The survivors lose
+// the STR, DEX, and WIL the detachment lost since it was formed, but no less
+// than 1 is left, and they share the detachment's zone, conditions, and
+// deprivation. The survivors' attacks are left with the charges the
+// detachment's attacks have, so the charges it spent are spent by all of
+// them.
+func (d *Detachment) Break() []Creature {
+	if d.Creature.IsOut() || len(d.Members) == 0 {
+		return nil
+	}
+
+	strLoss := lost(d.Members, d.Creature.STR, func(c *Creature) uint8 {
+		return c.STR
+	})
+	dexLoss := lost(d.Members, d.Creature.DEX, func(c *Creature) uint8 {
+		return c.DEX
+	})
+	wilLoss := lost(d.Members, d.Creature.WIL, func(c *Creature) uint8 {
+		return c.WIL
+	})
+
+	survivors := make([]Creature, 0, len(d.Members))
+	hpLeft := d.Creature.HP
+	for idx, member := range d.Members {
+		if idx > 0 && hpLeft == 0 {
+			break
+		}
+		hp := min(member.HP, hpLeft)
+		hpLeft -= hp
+
+		survivor := member.DeepCopy()
+		survivor.HP = hp
+		survivor.STR -= min(strLoss, survivor.STR-CharacteristicMin)
+		survivor.DEX -= min(dexLoss, survivor.DEX-CharacteristicMin)
+		survivor.WIL -= min(wilLoss, survivor.WIL-CharacteristicMin)
+		survivor.Zone = d.Creature.Zone
+		survivor.Conditions = slices.Clone(d.Creature.Conditions)
+		survivor.IsDeprived = d.Creature.IsDeprived
+		copyCharges(survivor.Attacks, d.Creature.Attacks)
+		survivors = append(survivors, survivor)
+	}
+	return survivors
+}
+
+// copyCharges sets the charges of the attacks and their recharge progress to
+// the ones of the same attacks of the detachment.
+func copyCharges(attacks, detachment []atk.Attack) {
+	for idx := range min(len(attacks), len(detachment)) {
+		attacks[idx].Charges = detachment[idx].Charges
+		if attacks[idx].Recharge != nil && detachment[idx].Recharge != nil {
+			attacks[idx].Recharge.Elapsed = detachment[idx].Recharge.Elapsed
+		}
+	}
+}
+
+// average returns the average of the creatures' values rounded down.
+func average(creatures []Creature, value func(c *Creature) uint8) uint8 {
+	sum := uint(0)
+	for i := range creatures {
+		sum += uint(value(&creatures[i]))
+	}
+	// Suppressing gosec "G115: integer overflow conversion uint -> uint8"
+	// because the average of uint8 values fits uint8.
+	return uint8(sum / uint(len(creatures))) // nolint:gosec
+}
+
+// lost returns how much the current value is below the members' average.
+func lost(
+	members []Creature, current uint8, value func(c *Creature) uint8,
+) uint8 {
+	formed := average(members, value)
+	if current >= formed {
+		return 0
+	}
+	return formed - current
+}
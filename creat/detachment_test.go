@@ -0,0 +1,366 @@
+package creat
+
+import (
+	"testing"
+
+	"github.com/rozag/cabasi/atk"
+	"github.com/rozag/cabasi/dice"
+	"github.com/rozag/cabasi/dice/dicetest"
+)
+
+func TestNewDetachment(t *testing.T) {
+	tmpl := Template{
+		Creature: Creature{
+			ID: "goblin", Name: "Goblin",
+			Attacks: []atk.Attack{
+				{
+					Name: "Spear", TargetCharacteristic: atk.STR,
+					Dice: dice.D6, DiceCnt: 1, Charges: -1,
+					IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitReach,
+					PairedWith: 0, Recharge: nil, DmgMod: 0, MinDmg: 0,
+					Usage: atk.UsageAlways, Area: atk.AreaZone, MaxTargets: 0,
+					Inflicts: atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+				},
+			},
+			Spellbooks: nil, Supports: nil, Items: nil, Conditions: nil,
+			STR: 8, DEX: 14, WIL: 8, HP: 3,
+			MaxHP: 3, Scars: nil, Armor: 0, Fatigue: 0,
+			Zone: atk.ZoneEngaged, IsDetachment: false, IsDeprived: false,
+		},
+		HPDice: 0, HPDiceCnt: 0,
+	}
+	goblins := tmpl.Spawn(NewRegistry(), 4, dicetest.Min{})
+	goblins[1].STR, goblins[1].Armor = 12, 1
+	goblins[2].DEX, goblins[2].HP = 10, 5
+	detachment, err := NewDetachment("goblins-1", "Goblin Band", goblins)
+	if err != nil {
+		t.Fatalf("NewDetachment(): want nil error, got %v", err)
+	}
+	got := detachment.Creature
+
+	if err := got.Validate(); err != nil {
+		t.Fatalf("NewDetachment(): want a valid detachment, got %v", err)
+	}
+	if got.ID != "goblins-1" || got.Name != "Goblin Band" || !got.IsDetachment {
+		t.Fatalf("NewDetachment() = %v, want the goblins-1 detachment", &got)
+	}
+	if got.HP != 3+3+5+3 {
+		t.Errorf("NewDetachment(): want combined HP 14, got %d", got.HP)
+	}
+	if got.STR != 9 || got.DEX != 13 || got.WIL != 8 || got.Armor != 0 {
+		t.Errorf(
+			"NewDetachment(): want STR 9, DEX 13, WIL 8, Armor 0, got %d, %d, %d, %d",
+			got.STR, got.DEX, got.WIL, got.Armor,
+		)
+	}
+	if !atk.AttackSlice(got.Attacks).Equals(detachment.Members[0].Attacks) {
+		t.Errorf("NewDetachment(): want the members' attacks, got %v", got.Attacks)
+	}
+	if len(detachment.Members) != 4 {
+		t.Fatalf(
+			"NewDetachment(): want 4 members, got %d", len(detachment.Members),
+		)
+	}
+
+	got.Attacks[0].Name = "Pitchfork"
+	if detachment.Members[0].Attacks[0].Name != "Spear" {
+		t.Errorf("modifying the detachment affected its members")
+	}
+}
+
+func TestNewDetachmentErrors(t *testing.T) {
+	tmpl := Template{
+		Creature: Creature{
+			ID: "goblin", Name: "Goblin",
+			Attacks: []atk.Attack{
+				{
+					Name: "Spear", TargetCharacteristic: atk.STR,
+					Dice: dice.D6, DiceCnt: 1, Charges: -1,
+					IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitReach,
+					PairedWith: 0, Recharge: nil, DmgMod: 0, MinDmg: 0,
+					Usage: atk.UsageAlways, Area: atk.AreaZone, MaxTargets: 0,
+					Inflicts: atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+				},
+			},
+			Spellbooks: nil, Supports: nil, Items: nil, Conditions: nil,
+			STR: 8, DEX: 14, WIL: 8, HP: 3,
+			MaxHP: 3, Scars: nil, Armor: 0, Fatigue: 0,
+			Zone: atk.ZoneEngaged, IsDetachment: false, IsDeprived: false,
+		},
+		HPDice: 0, HPDiceCnt: 0,
+	}
+	tests := []struct {
+		modify     func(members []Creature) []Creature
+		name       string
+		wantErrCnt int
+	}{
+		{
+			name:       "TooFewMembers",
+			modify:     func(members []Creature) []Creature { return members[:1] },
+			wantErrCnt: 1,
+		},
+		{
+			name: "DetachmentMember",
+			modify: func(members []Creature) []Creature {
+				members[1].IsDetachment = true
+				return members
+			},
+			wantErrCnt: 1,
+		},
+		{
+			name: "OutMember",
+			modify: func(members []Creature) []Creature {
+				members[2].STR = 0
+				return members
+			},
+			wantErrCnt: 1,
+		},
+		{
+			name: "DifferentAttacks",
+			modify: func(members []Creature) []Creature {
+				members[1].Attacks[0].Dice = dice.D8
+				return members
+			},
+			wantErrCnt: 1,
+		},
+		{
+			name: "TooMuchHP",
+			modify: func(members []Creature) []Creature {
+				members[0].HP, members[1].HP = 200, 200
+				return members
+			},
+			wantErrCnt: 1,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			members := test.modify(
+				tmpl.Spawn(NewRegistry(), 3, dicetest.Min{}),
+			)
+			_, err := NewDetachment("goblins-1", "Goblin Band", members)
+			jointErr, ok := err.(interface{ Unwrap() []error })
+			if !ok {
+				t.Fatalf(
+					"NewDetachment(): error must have `Unwrap() []error` method, got %v",
+					err,
+				)
+			}
+			if errs := jointErr.Unwrap(); len(errs) != test.wantErrCnt {
+				t.Fatalf(
+					"NewDetachment(): want %d errors, got %d: %v",
+					test.wantErrCnt, len(errs), err,
+				)
+			}
+		})
+	}
+
+	goblins := tmpl.Spawn(NewRegistry(), 2, dicetest.Min{})
+	if _, err := NewDetachment("", "Goblin Band", goblins); err == nil {
+		t.Fatalf("NewDetachment(): want error for an empty ID, got nil")
+	}
+}
+
+func TestDetachmentBreak(t *testing.T) {
+	tmpl := Template{
+		Creature: Creature{
+			ID: "goblin", Name: "Goblin",
+			Attacks: []atk.Attack{
+				{
+					Name: "Spear", TargetCharacteristic: atk.STR,
+					Dice: dice.D6, DiceCnt: 1, Charges: -1,
+					IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitReach,
+					PairedWith: 0, Recharge: nil, DmgMod: 0, MinDmg: 0,
+					Usage: atk.UsageAlways, Area: atk.AreaZone, MaxTargets: 0,
+					Inflicts: atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+				},
+			},
+			Spellbooks: nil, Supports: nil, Items: nil, Conditions: nil,
+			STR: 8, DEX: 14, WIL: 8, HP: 3,
+			MaxHP: 3, Scars: nil, Armor: 0, Fatigue: 0,
+			Zone: atk.ZoneEngaged, IsDetachment: false, IsDeprived: false,
+		},
+		HPDice: 0, HPDiceCnt: 0,
+	}
+	tests := []struct {
+		modify    func(d *Creature)
+		name      string
+		wantHP    []uint8
+		wantSTR   []uint8
+		wantFirst ID
+	}{
+		{
+			name:      "Unharmed",
+			modify:    func(*Creature) {},
+			wantHP:    []uint8{3, 3, 5, 3},
+			wantSTR:   []uint8{8, 12, 8, 8},
+			wantFirst: "goblin-1",
+		},
+		{
+			name:      "Reduced",
+			modify:    func(d *Creature) { d.HP = 7 },
+			wantHP:    []uint8{3, 3, 1},
+			wantSTR:   []uint8{8, 12, 8},
+			wantFirst: "goblin-1",
+		},
+		{
+			name:      "NoHPLeft",
+			modify:    func(d *Creature) { d.HP = 0 },
+			wantHP:    []uint8{0},
+			wantSTR:   []uint8{8},
+			wantFirst: "goblin-1",
+		},
+		{
+			name:      "LostSTR",
+			modify:    func(d *Creature) { d.HP, d.STR = 3, 1 },
+			wantHP:    []uint8{3},
+			wantSTR:   []uint8{1},
+			wantFirst: "goblin-1",
+		},
+		{
+			name:      "Out",
+			modify:    func(d *Creature) { d.HP, d.STR = 0, 0 },
+			wantHP:    nil,
+			wantSTR:   nil,
+			wantFirst: "",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			goblins := tmpl.Spawn(NewRegistry(), 4, dicetest.Min{})
+			goblins[1].STR, goblins[1].Armor = 12, 1
+			goblins[2].DEX, goblins[2].HP = 10, 5
+			detachment, err := NewDetachment("goblins-1", "Goblin Band", goblins)
+			if err != nil {
+				t.Fatalf("NewDetachment(): want nil error, got %v", err)
+			}
+			test.modify(&detachment.Creature)
+
+			survivors := detachment.Break()
+			if len(survivors) != len(test.wantHP) {
+				t.Fatalf(
+					"Detachment.Break(): want %d survivors, got %d",
+					len(test.wantHP), len(survivors),
+				)
+			}
+			for idx, survivor := range survivors {
+				if survivor.HP != test.wantHP[idx] ||
+					survivor.STR != test.wantSTR[idx] {
+					t.Errorf(
+						"Detachment.Break()[%d]: want HP %d and STR %d, got %d and %d",
+						idx, test.wantHP[idx], test.wantSTR[idx], survivor.HP, survivor.STR,
+					)
+				}
+				if survivor.IsDetachment {
+					t.Errorf("Detachment.Break()[%d]: want an individual", idx)
+				}
+			}
+			if len(survivors) > 0 && survivors[0].ID != test.wantFirst {
+				t.Errorf(
+					"Detachment.Break(): want first survivor %s, got %s",
+					test.wantFirst, survivors[0].ID,
+				)
+			}
+		})
+	}
+}
+
+func TestDetachmentBreakSharesState(t *testing.T) {
+	tmpl := Template{
+		Creature: Creature{
+			ID: "goblin", Name: "Goblin",
+			Attacks: []atk.Attack{
+				{
+					Name: "Spear", TargetCharacteristic: atk.STR,
+					Dice: dice.D6, DiceCnt: 1, Charges: -1,
+					IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitReach,
+					PairedWith: 0, Recharge: nil, DmgMod: 0, MinDmg: 0,
+					Usage: atk.UsageAlways, Area: atk.AreaZone, MaxTargets: 0,
+					Inflicts: atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+				},
+			},
+			Spellbooks: nil, Supports: nil, Items: nil, Conditions: nil,
+			STR: 8, DEX: 14, WIL: 8, HP: 3,
+			MaxHP: 3, Scars: nil, Armor: 0, Fatigue: 0,
+			Zone: atk.ZoneEngaged, IsDetachment: false, IsDeprived: false,
+		},
+		HPDice: 0, HPDiceCnt: 0,
+	}
+	goblins := tmpl.Spawn(NewRegistry(), 4, dicetest.Min{})
+	goblins[1].STR, goblins[1].Armor = 12, 1
+	goblins[2].DEX, goblins[2].HP = 10, 5
+	detachment, err := NewDetachment("goblins-1", "Goblin Band", goblins)
+	if err != nil {
+		t.Fatalf("NewDetachment(): want nil error, got %v", err)
+	}
+	detachment.Creature.Zone = atk.ZoneNear
+	detachment.Creature.IsDeprived = true
+	detachment.Creature.Conditions = []atk.Condition{
+		{Kind: atk.ConditionFrightened, Rounds: 2},
+	}
+
+	survivors := detachment.Break()
+	for idx, survivor := range survivors {
+		if survivor.Zone != atk.ZoneNear || !survivor.IsDeprived ||
+			len(survivor.Conditions) != 1 {
+			t.Fatalf(
+				"Detachment.Break()[%d] = %v, want the detachment's state",
+				idx, &survivor,
+			)
+		}
+	}
+
+	survivors[0].Conditions[0].Rounds = 1
+	if detachment.Creature.Conditions[0].Rounds != 2 {
+		t.Fatalf("modifying a survivor affected the detachment")
+	}
+}
+
+func TestDetachmentBreakCopiesCharges(t *testing.T) {
+	tmpl := Template{
+		Creature: Creature{
+			ID: "goblin", Name: "Goblin",
+			Attacks: []atk.Attack{
+				{
+					Name: "Javelin", TargetCharacteristic: atk.STR,
+					Dice: dice.D6, DiceCnt: 1, Charges: 3,
+					IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+					PairedWith: 0, DmgMod: 0, MinDmg: 0,
+					Recharge: &atk.Recharge{
+						Kind: atk.RechargeEveryNRounds, Rounds: 3, Dice: 0,
+						Threshold: 0, MaxCharges: 3, Elapsed: 0,
+					},
+					Usage: atk.UsageAlways, Area: atk.AreaZone, MaxTargets: 0,
+					Inflicts: atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+				},
+			},
+			Spellbooks: nil, Supports: nil, Items: nil, Conditions: nil,
+			STR: 8, DEX: 14, WIL: 8, HP: 3,
+			MaxHP: 3, Scars: nil, Armor: 0, Fatigue: 0,
+			Zone: atk.ZoneEngaged, IsDetachment: false, IsDeprived: false,
+		},
+		HPDice: 0, HPDiceCnt: 0,
+	}
+	goblins := tmpl.Spawn(NewRegistry(), 3, dicetest.Min{})
+	detachment, err := NewDetachment("goblins-1", "Goblin Band", goblins)
+	if err != nil {
+		t.Fatalf("NewDetachment(): want nil error, got %v", err)
+	}
+	detachment.Creature.Attacks[0].Charges = 1
+	detachment.Creature.Attacks[0].Recharge.Elapsed = 2
+
+	survivors := detachment.Break()
+	for idx, survivor := range survivors {
+		attack := survivor.Attacks[0]
+		if attack.Charges != 1 || attack.Recharge.Elapsed != 2 {
+			t.Errorf(
+				"Detachment.Break()[%d]: want 1 charge and 2 rounds elapsed, got %v",
+				idx, &attack,
+			)
+		}
+	}
+
+	survivors[0].Attacks[0].Recharge.Elapsed = 0
+	if detachment.Creature.Attacks[0].Recharge.Elapsed != 2 {
+		t.Fatalf("modifying a survivor affected the detachment")
+	}
+}
@@ -0,0 +1,336 @@
+package creat
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/rozag/cabasi/atk"
+)
+
+// OutReason represents why a creature is out of the battle, see IsOut.
+type OutReason uint8
+
+const (
+	// OutNone means the creature is still in the battle.
+	OutNone OutReason = iota
+	// OutKilled means the creature has STR 0: it's killed or has fled.
+	OutKilled
+	// OutParalyzed means the creature has DEX 0.
+	OutParalyzed
+	// OutDelirious means the creature has WIL 0.
+	OutDelirious
+)
+
+// String returns the string representation of the OutReason.
+func (r OutReason) String() string {
+	switch r {
+	case OutNone:
+		return "None"
+	case OutKilled:
+		return "Killed"
+	case OutParalyzed:
+		return "Paralyzed"
+	case OutDelirious:
+		return "Delirious"
+	default:
+		panic(fmt.Errorf("unknown OutReason: %d", r))
+	}
+}
+
+// OutReason returns why the Creature is out of the battle or OutNone if it's
+// not. STR is checked first, then DEX, then WIL.
+func (c *Creature) OutReason() OutReason {
+	switch {
+	case c.STR == 0:
+		return OutKilled
+	case c.DEX == 0:
+		return OutParalyzed
+	case c.WIL == 0:
+		return OutDelirious
+	default:
+		return OutNone
+	}
+}
+
+// ChargesDiff is the change of an attack's charges.
+type ChargesDiff struct {
+	// Name is the attack's name.
+	Name string
+	// Idx is the attack's index in the creature's Attacks.
+	Idx uint
+	// Spent is the number of charges spent, negative if the attack regained
+	// charges.
+	Spent int16
+}
+
+// String returns the string representation of the ChargesDiff.
+func (d *ChargesDiff) String() string {
+	return fmt.Sprintf(
+		"ChargesDiff{Name: %q, Idx: %d, Spent: %d}", d.Name, d.Idx, d.Spent,
+	)
+}
+
+// Diff is the difference between a creature before and after a battle, see
+// Creature.Diff. STR, DEX, WIL, HP, MaxHP, and Fatigue are the changes of the
+// values, negative for losses. MaxHP compares the EffectiveMaxHP of both.
+type Diff struct {
+	// ID is the creature's ID.
+	ID ID
+	// Name is the creature's name after the battle.
+	Name string
+	// Charges are the changes of the attacks with limited charges, attacks
+	// with unchanged charges are left out.
+	Charges []ChargesDiff
+	// Gained are the conditions of kinds the creature didn't suffer from
+	// before.
+	Gained []atk.Condition
+	// Changed are the conditions the creature still suffers from, but for a
+	// different number of rounds, as they are after the battle.
+	Changed []atk.Condition
+	// Ended are the kinds of the conditions the creature no longer suffers
+	// from.
+	Ended []atk.ConditionKind
+	// Scars are the scars the creature gained.
+	Scars          []Scar
+	STR            int16
+	DEX            int16
+	WIL            int16
+	HP             int16
+	MaxHP          int16
+	Fatigue        int16
+	Out            OutReason
+	BecameDeprived bool
+}
+
+// Diff compares the Creature before a battle with the same creature after
+// the battle. It builds on Equals: equal creatures make a Diff with nothing
+// changed, and only the attacks and conditions Equals finds changed are
+// compared further. Attacks are matched by index, conditions by kind, and the
+// scars after the ones the Creature had are new. It panics if after is
+// another creature, i.e. its ID differs.
+func (c *Creature) Diff(after *Creature) Diff {
+	if c.ID != after.ID {
+		panic(fmt.Errorf("can't diff creature %q with %q", c.ID, after.ID))
+	}
+
+	diff := Diff{
+		ID:             after.ID,
+		Name:           after.Name,
+		Charges:        nil,
+		Gained:         nil,
+		Changed:        nil,
+		Ended:          nil,
+		Scars:          nil,
+		STR:            0,
+		DEX:            0,
+		WIL:            0,
+		HP:             0,
+		MaxHP:          0,
+		Fatigue:        0,
+		Out:            after.OutReason(),
+		BecameDeprived: false,
+	}
+	if c.Equals(after) {
+		return diff
+	}
+
+	diff.STR = int16(after.STR) - int16(c.STR)
+	diff.DEX = int16(after.DEX) - int16(c.DEX)
+	diff.WIL = int16(after.WIL) - int16(c.WIL)
+	diff.HP = int16(after.HP) - int16(c.HP)
+	diff.MaxHP = int16(after.EffectiveMaxHP()) - int16(c.EffectiveMaxHP())
+	diff.Fatigue = int16(after.Fatigue) - int16(c.Fatigue)
+	diff.BecameDeprived = !c.IsDeprived && after.IsDeprived
+
+	if !atk.AttackSlice(c.Attacks).Equals(atk.AttackSlice(after.Attacks)) {
+		diff.Charges = chargesDiffs(c.Attacks, after.Attacks)
+	}
+
+	if !slices.Equal(c.Conditions, after.Conditions) {
+		for _, condition := range after.Conditions {
+			idx := slices.IndexFunc(c.Conditions, func(cond atk.Condition) bool {
+				return cond.Kind == condition.Kind
+			})
+			switch {
+			case idx < 0:
+				diff.Gained = append(diff.Gained, condition)
+			case c.Conditions[idx].Rounds != condition.Rounds:
+				diff.Changed = append(diff.Changed, condition)
+			}
+		}
+		for _, condition := range c.Conditions {
+			if !after.HasCondition(condition.Kind) {
+				diff.Ended = append(diff.Ended, condition.Kind)
+			}
+		}
+	}
+
+	if len(after.Scars) > len(c.Scars) {
+		diff.Scars = append(diff.Scars, after.Scars[len(c.Scars):]...)
+	}
+
+	return diff
+}
+
+// chargesDiffs returns the changes of the charges of the attacks matched by
+// index, the attacks with unlimited or unchanged charges are left out.
+func chargesDiffs(before, after []atk.Attack) []ChargesDiff {
+	var diffs []ChargesDiff
+	for idx := range min(len(before), len(after)) {
+		was, now := before[idx].Charges, after[idx].Charges
+		if was < 0 || now < 0 || was == now {
+			continue
+		}
+		diffs = append(diffs, ChargesDiff{
+			Name: after[idx].Name, Idx: uint(idx),
+			Spent: int16(was) - int16(now),
+		})
+	}
+	return diffs
+}
+
+// IsEmpty checks if nothing in the Diff has changed and the creature is
+// still in the battle.
+func (d *Diff) IsEmpty() bool {
+	return len(d.Charges) == 0 && len(d.Gained) == 0 && len(d.Changed) == 0 &&
+		len(d.Ended) == 0 && len(d.Scars) == 0 && d.STR == 0 && d.DEX == 0 &&
+		d.WIL == 0 && d.HP == 0 && d.MaxHP == 0 && d.Fatigue == 0 &&
+		d.Out == OutNone && !d.BecameDeprived
+}
+
+// String returns the string representation of the Diff.
+func (d *Diff) String() string {
+	return fmt.Sprintf(
+		"Diff{"+
+			"ID: %q"+
+			", Name: %q"+
+			", Charges: %s"+
+			", Gained: %v"+
+			", Changed: %v"+
+			", Ended: %v"+
+			", Scars: %v"+
+			", STR: %d"+
+			", DEX: %d"+
+			", WIL: %d"+
+			", HP: %d"+
+			", MaxHP: %d"+
+			", Fatigue: %d"+
+			", Out: %s"+
+			", BecameDeprived: %t"+
+			"}",
+		d.ID,
+		d.Name,
+		chargesString(d.Charges),
+		d.Gained,
+		d.Changed,
+		d.Ended,
+		d.Scars,
+		d.STR,
+		d.DEX,
+		d.WIL,
+		d.HP,
+		d.MaxHP,
+		d.Fatigue,
+		d.Out,
+		d.BecameDeprived,
+	)
+}
+
+// chargesString returns the string representation of the charges changes.
+func chargesString(charges []ChargesDiff) string {
+	var sb strings.Builder
+	sb.WriteString("[]ChargesDiff{")
+	for i := range charges {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(charges[i].String())
+	}
+	sb.WriteString("}")
+	return sb.String()
+}
+
+// lasting returns how long the condition lasts for the Diff's summary, e.g.
+// " for 2 rounds".
+func lasting(condition atk.Condition) string {
+	switch {
+	case condition.Rounds < 0:
+		return " until the end of the battle"
+	case condition.Rounds == 1:
+		return " for 1 round"
+	default:
+		return fmt.Sprintf(" for %d rounds", condition.Rounds)
+	}
+}
+
+// Summary returns the human-readable summary of the Diff for the players,
+// e.g. "Root Goblin: lost 4 HP, lost 3 STR, now Poisoned, out: Killed".
+func (d *Diff) Summary() string {
+	var parts []string
+
+	for _, value := range []struct {
+		name   string
+		change int16
+	}{
+		{"HP", d.HP}, {"STR", d.STR}, {"DEX", d.DEX}, {"WIL", d.WIL},
+		{"max HP", d.MaxHP}, {"Fatigue", d.Fatigue},
+	} {
+		switch {
+		case value.change < 0:
+			parts = append(
+				parts, fmt.Sprintf("lost %d %s", -value.change, value.name),
+			)
+		case value.change > 0:
+			parts = append(
+				parts, fmt.Sprintf("gained %d %s", value.change, value.name),
+			)
+		}
+	}
+
+	for _, charges := range d.Charges {
+		verb, cnt := "spent", charges.Spent
+		if cnt < 0 {
+			verb, cnt = "regained", -cnt
+		}
+		noun := "charges"
+		if cnt == 1 {
+			noun = "charge"
+		}
+		parts = append(
+			parts, fmt.Sprintf("%s %d %s of %s", verb, cnt, noun, charges.Name),
+		)
+	}
+
+	for _, condition := range d.Gained {
+		parts = append(parts, "now "+condition.Kind.String())
+	}
+	for _, condition := range d.Changed {
+		parts = append(parts, "still "+condition.Kind.String()+lasting(condition))
+	}
+	for _, kind := range d.Ended {
+		parts = append(parts, "no longer "+kind.String())
+	}
+
+	for _, scar := range d.Scars {
+		if len(scar.Detail) > 0 {
+			parts = append(
+				parts, fmt.Sprintf("scarred: %s (%s)", scar.Kind, scar.Detail),
+			)
+		} else {
+			parts = append(parts, "scarred: "+scar.Kind.String())
+		}
+	}
+
+	if d.BecameDeprived {
+		parts = append(parts, "deprived")
+	}
+	if d.Out != OutNone {
+		parts = append(parts, "out: "+d.Out.String())
+	}
+
+	if len(parts) == 0 {
+		return d.Name + ": unchanged"
+	}
+	return d.Name + ": " + strings.Join(parts, ", ")
+}
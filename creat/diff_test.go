@@ -0,0 +1,254 @@
+package creat
+
+import (
+	"testing"
+
+	"github.com/rozag/cabasi/atk"
+	"github.com/rozag/cabasi/dice"
+)
+
+func TestCreatureOutReason(t *testing.T) {
+	tests := []struct {
+		name          string
+		str, dex, wil uint8
+		want          OutReason
+	}{
+		{name: "InBattle", str: 8, dex: 14, wil: 8, want: OutNone},
+		{name: "Killed", str: 0, dex: 14, wil: 8, want: OutKilled},
+		{name: "Paralyzed", str: 8, dex: 0, wil: 8, want: OutParalyzed},
+		{name: "Delirious", str: 8, dex: 14, wil: 0, want: OutDelirious},
+		{name: "KilledFirst", str: 0, dex: 0, wil: 0, want: OutKilled},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			creature := Creature{
+				ID: "monster-0", Name: "Root Goblin", Attacks: nil,
+				STR: test.str, DEX: test.dex, WIL: test.wil, HP: 4, MaxHP: 4,
+				Scars: nil, Armor: 0, IsDetachment: false, Conditions: nil,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil, Zone: atk.ZoneEngaged,
+			}
+			if got := creature.OutReason(); got != test.want {
+				t.Fatalf("Creature.OutReason() = %s, want %s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestCreatureDiff(t *testing.T) {
+	noCondition := atk.Condition{Kind: atk.ConditionNone, Rounds: 0}
+	before := Creature{
+		ID: "monster-0", Name: "Red Dragon",
+		Attacks: []atk.Attack{
+			{
+				Name: "Claws", TargetCharacteristic: atk.STR,
+				Dice: dice.D8, DiceCnt: 1, Charges: -1,
+				IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+				PairedWith: 0, Inflicts: noCondition, Recharge: nil,
+				DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+				Area: atk.AreaZone, MaxTargets: 0,
+			},
+			{
+				Name: "Fire Breath", TargetCharacteristic: atk.STR,
+				Dice: dice.D12, DiceCnt: 1, Charges: 0,
+				IsBlast: true, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+				PairedWith: 0, Inflicts: noCondition,
+				Recharge: &atk.Recharge{
+					Kind: atk.RechargeOnRoll, Rounds: 0, Dice: dice.D6, Threshold: 5,
+					MaxCharges: 1, Elapsed: 0,
+				},
+				DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+				Area: atk.AreaZone, MaxTargets: 0,
+			},
+			{
+				Name: "Frightful Presence", TargetCharacteristic: atk.WIL,
+				Dice: dice.D6, DiceCnt: 1, Charges: 0,
+				IsBlast: true, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+				PairedWith: 0, Inflicts: noCondition,
+				Recharge: &atk.Recharge{
+					Kind: atk.RechargeOnRest, Rounds: 0, Dice: 0, Threshold: 0,
+					MaxCharges: 1, Elapsed: 0,
+				},
+				DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+				Area: atk.AreaZone, MaxTargets: 0,
+			},
+		},
+		STR: 18, DEX: 12, WIL: 16, HP: 20, MaxHP: 20, Scars: nil, Armor: 3,
+		IsDetachment: false, Conditions: nil,
+		Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil, Items: nil,
+		Zone: atk.ZoneEngaged,
+	}
+	before.Attacks[1].Charges = 1
+	before.Attacks[2].Charges = 1
+	before.Conditions = []atk.Condition{
+		{Kind: atk.ConditionProtected, Rounds: 2},
+		{Kind: atk.ConditionEnhanced, Rounds: 3},
+	}
+
+	after := before.DeepCopy()
+	after.HP = 0
+	after.STR = 0
+	after.MaxHP = 22
+	after.Fatigue = 1
+	after.Attacks[1].Charges = 0
+	after.Conditions = []atk.Condition{
+		{Kind: atk.ConditionEnhanced, Rounds: 1},
+		{Kind: atk.ConditionPoisoned, Rounds: 2},
+	}
+	after.Scars = []Scar{{Kind: ScarBrokenLimb, Detail: "Arm"}}
+	after.IsDeprived = true
+
+	got := before.Diff(&after)
+	want := Diff{
+		ID:             "monster-0",
+		Name:           "Red Dragon",
+		Charges:        []ChargesDiff{{Name: "Fire Breath", Idx: 1, Spent: 1}},
+		Gained:         []atk.Condition{{Kind: atk.ConditionPoisoned, Rounds: 2}},
+		Changed:        []atk.Condition{{Kind: atk.ConditionEnhanced, Rounds: 1}},
+		Ended:          []atk.ConditionKind{atk.ConditionProtected},
+		Scars:          []Scar{{Kind: ScarBrokenLimb, Detail: "Arm"}},
+		STR:            -18,
+		DEX:            0,
+		WIL:            0,
+		HP:             -20,
+		MaxHP:          2,
+		Fatigue:        1,
+		Out:            OutKilled,
+		BecameDeprived: true,
+	}
+	if got.String() != want.String() {
+		t.Fatalf("Creature.Diff() = %s, want %s", got.String(), want.String())
+	}
+	if got.IsEmpty() {
+		t.Errorf("Diff.IsEmpty() = true, want false")
+	}
+
+	wantSummary := "Red Dragon: lost 20 HP, lost 18 STR, gained 2 max HP, " +
+		"gained 1 Fatigue, spent 1 charge of Fire Breath, now Poisoned, " +
+		"still Enhanced for 1 round, no longer Protected, " +
+		"scarred: BrokenLimb (Arm), deprived, out: Killed"
+	if summary := got.Summary(); summary != wantSummary {
+		t.Errorf("Diff.Summary() = %q, want %q", summary, wantSummary)
+	}
+}
+
+func TestCreatureDiffUnchanged(t *testing.T) {
+	before := Creature{
+		ID: "monster-0", Name: "Root Goblin", Attacks: nil,
+		STR: 8, DEX: 14, WIL: 8, HP: 4, MaxHP: 4, Scars: nil, Armor: 0,
+		IsDetachment: false, Fatigue: 1, IsDeprived: false,
+		Conditions: []atk.Condition{{Kind: atk.ConditionPoisoned, Rounds: 2}},
+		Spellbooks: nil, Supports: nil, Items: nil, Zone: atk.ZoneEngaged,
+	}
+	after := before.DeepCopy()
+
+	got := before.Diff(&after)
+	if !got.IsEmpty() {
+		t.Fatalf("Creature.Diff() = %s, want empty", got.String())
+	}
+	if summary := got.Summary(); summary != "Root Goblin: unchanged" {
+		t.Errorf("Diff.Summary() = %q, want %q", summary, "Root Goblin: unchanged")
+	}
+}
+
+func TestCreatureDiffAnotherCreaturePanics(t *testing.T) {
+	before := Creature{
+		ID: "monster-0", Name: "Root Goblin", Attacks: nil,
+		STR: 8, DEX: 14, WIL: 8, HP: 4, MaxHP: 4, Scars: nil, Armor: 0,
+		IsDetachment: false, Fatigue: 0, IsDeprived: false, Conditions: nil,
+		Spellbooks: nil, Supports: nil, Items: nil, Zone: atk.ZoneEngaged,
+	}
+	after := before.DeepCopy()
+	after.ID = "monster-1"
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Fatalf("Creature.Diff(): want panic for another creature, got none")
+		}
+	}()
+	_ = before.Diff(&after)
+}
+
+func TestDiffSummary(t *testing.T) {
+	tests := []struct {
+		name string
+		diff Diff
+		want string
+	}{
+		{
+			name: "GainsAndRegainedCharges",
+			diff: Diff{
+				ID:             "player-0",
+				Name:           "Tuck",
+				Charges:        []ChargesDiff{{Name: "Smite", Idx: 0, Spent: -2}},
+				Gained:         nil,
+				Changed:        nil,
+				Ended:          nil,
+				Scars:          nil,
+				STR:            0,
+				DEX:            0,
+				WIL:            3,
+				HP:             2,
+				MaxHP:          0,
+				Fatigue:        -1,
+				Out:            OutNone,
+				BecameDeprived: false,
+			},
+			want: "Tuck: gained 2 HP, gained 3 WIL, lost 1 Fatigue, " +
+				"regained 2 charges of Smite",
+		},
+		{
+			name: "ScarWithoutDetail",
+			diff: Diff{
+				ID:             "player-0",
+				Name:           "Tuck",
+				Charges:        nil,
+				Gained:         nil,
+				Changed:        nil,
+				Ended:          nil,
+				Scars:          []Scar{{Kind: ScarDoomed, Detail: ""}},
+				STR:            0,
+				DEX:            -1,
+				WIL:            0,
+				HP:             0,
+				MaxHP:          0,
+				Fatigue:        0,
+				Out:            OutParalyzed,
+				BecameDeprived: false,
+			},
+			want: "Tuck: lost 1 DEX, scarred: Doomed, out: Paralyzed",
+		},
+		{
+			name: "ChangedConditions",
+			diff: Diff{
+				ID:      "player-0",
+				Name:    "Tuck",
+				Charges: nil,
+				Gained:  nil,
+				Changed: []atk.Condition{
+					{Kind: atk.ConditionProtected, Rounds: 2},
+					{Kind: atk.ConditionEnhanced, Rounds: -1},
+				},
+				Ended:          nil,
+				Scars:          nil,
+				STR:            0,
+				DEX:            0,
+				WIL:            0,
+				HP:             0,
+				MaxHP:          0,
+				Fatigue:        0,
+				Out:            OutNone,
+				BecameDeprived: false,
+			},
+			want: "Tuck: still Protected for 2 rounds, " +
+				"still Enhanced until the end of the battle",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.diff.Summary(); got != test.want {
+				t.Fatalf("Diff.Summary() = %q, want %q", got, test.want)
+			}
+		})
+	}
+}
@@ -1,5 +1,10 @@
 package creat
 
+import (
+	"errors"
+	"fmt"
+)
+
 // ID is a unique identifier of a creature.
 type ID string
 
@@ -14,3 +19,49 @@ func (id ID) CompareTo(other ID) int {
 	}
 	return 0
 }
+
+// Registry hands out unique IDs to the creatures of a battle, no matter the
+// side. IDs are either reserved as they are, e.g. the IDs of rolled
+// characters, or generated from a prefix, e.g. "goblin-1" for "goblin".
+type Registry struct {
+	used    map[ID]struct{}
+	nextNum map[string]uint
+}
+
+// NewRegistry creates a new Registry with no IDs taken.
+func NewRegistry() *Registry {
+	return &Registry{used: make(map[ID]struct{}), nextNum: make(map[string]uint)}
+}
+
+// Reserve takes the provided ID. It returns an error if the ID is empty or
+// already taken.
+func (r *Registry) Reserve(id ID) error {
+	if len(id) == 0 {
+		return errors.New("ID must not be empty")
+	}
+	if r.Has(id) {
+		return fmt.Errorf("ID %q is already taken", id)
+	}
+	r.used[id] = struct{}{}
+	return nil
+}
+
+// Has checks if the ID is taken.
+func (r *Registry) Has(id ID) bool {
+	_, ok := r.used[id]
+	return ok
+}
+
+// Next takes and returns a new ID made of the prefix and the next 1-based
+// number, e.g. "goblin-1", "goblin-2", and so on. Numbers of the IDs taken
+// otherwise are skipped.
+func (r *Registry) Next(prefix string) ID {
+	for {
+		r.nextNum[prefix]++
+		id := ID(fmt.Sprintf("%s-%d", prefix, r.nextNum[prefix]))
+		if !r.Has(id) {
+			r.used[id] = struct{}{}
+			return id
+		}
+	}
+}
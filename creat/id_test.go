@@ -36,3 +36,41 @@ func TestIDCompareTo(t *testing.T) {
 		})
 	}
 }
+
+func TestRegistryReserve(t *testing.T) {
+	ids := NewRegistry()
+	if err := ids.Reserve("player-0"); err != nil {
+		t.Fatalf("Registry.Reserve(): want nil, got %v", err)
+	}
+	if !ids.Has("player-0") {
+		t.Fatalf("Registry.Has(): want true for a reserved ID")
+	}
+	if ids.Has("player-1") {
+		t.Fatalf("Registry.Has(): want false for a free ID")
+	}
+	if err := ids.Reserve("player-0"); err == nil {
+		t.Fatalf("Registry.Reserve(): want error for a taken ID, got nil")
+	}
+	if err := ids.Reserve(""); err == nil {
+		t.Fatalf("Registry.Reserve(): want error for an empty ID, got nil")
+	}
+}
+
+func TestRegistryNext(t *testing.T) {
+	ids := NewRegistry()
+	if err := ids.Reserve("goblin-2"); err != nil {
+		t.Fatalf("Registry.Reserve(): want nil, got %v", err)
+	}
+
+	got := []ID{ids.Next("goblin"), ids.Next("goblin"), ids.Next("wolf")}
+	want := []ID{"goblin-1", "goblin-3", "wolf-1"}
+	for idx := range want {
+		if got[idx] != want[idx] {
+			t.Fatalf("Registry.Next(): want %v, got %v", want, got)
+		}
+	}
+
+	if err := ids.Reserve("goblin-3"); err == nil {
+		t.Fatalf("Registry.Reserve(): want error for a generated ID, got nil")
+	}
+}
@@ -0,0 +1,141 @@
+package creat
+
+import (
+	"math"
+
+	"github.com/rozag/cabasi/atk"
+	"github.com/rozag/cabasi/item"
+)
+
+// UsedSlots returns the number of the Creature's inventory slots taken by
+// items, spellbooks, and Fatigue.
+func (c *Creature) UsedSlots() uint {
+	used := uint(len(c.Spellbooks)) + uint(c.Fatigue)
+	for i := range c.Items {
+		used += uint(c.Items[i].Slots())
+	}
+	return used
+}
+
+// IsOverloaded checks if all the Creature's inventory slots are taken. An
+// overloaded creature is reduced to HP 0.
+func (c *Creature) IsOverloaded() bool {
+	return c.UsedSlots() >= SlotsMax
+}
+
+// Carry puts the item into the Creature's inventory and returns true if the
+// Creature has enough free slots for it, otherwise it returns false. If the
+// item takes the last free slot, the Creature's HP is reduced to 0.
+func (c *Creature) Carry(it item.Item) bool {
+	if c.FreeSlots() < it.Slots() {
+		return false
+	}
+
+	c.Items = append(c.Items, it)
+	if c.IsOverloaded() {
+		c.HP = 0
+	}
+	return true
+}
+
+// TakeFatigue adds cnt Fatigue to the Creature's inventory and returns true
+// if the Creature has enough free slots for it, otherwise it returns false
+// and takes none. If the Fatigue takes the last free slot, the Creature's HP
+// is reduced to 0.
+func (c *Creature) TakeFatigue(cnt uint8) bool {
+	if c.FreeSlots() < cnt {
+		return false
+	}
+
+	c.Fatigue += cnt
+	if c.IsOverloaded() {
+		c.HP = 0
+	}
+	return true
+}
+
+// ItemAttacks returns the attacks of the Creature's equipped weapons.
+func (c *Creature) ItemAttacks() []atk.Attack {
+	var attacks []atk.Attack
+	for i := range c.Items {
+		if c.Items[i].IsEquipped && c.Items[i].Weapon != nil {
+			attacks = append(attacks, c.Items[i].Weapon.DeepCopy())
+		}
+	}
+	return attacks
+}
+
+// ItemArmor returns the armor of the Creature's equipped armor pieces summed
+// up and capped at ArmorMax.
+func (c *Creature) ItemArmor() uint8 {
+	armor := uint(0)
+	for i := range c.Items {
+		if c.Items[i].IsEquipped && c.Items[i].Kind.IsProtective() {
+			armor += uint(c.Items[i].Armor)
+		}
+	}
+	// Suppressing gosec "G115: integer overflow conversion uint -> uint8"
+	// because the result is in [0,ArmorMax].
+	return uint8(min(armor, ArmorMax)) //nolint:gosec
+}
+
+// Equip sets the Creature's Attacks to its other attacks followed by the
+// attacks of its equipped weapons and its Armor to the armor of its equipped
+// armor pieces. It's meant for characters whose attacks and armor come from
+// their inventory, e.g. after changing the equipped items.
+//
+// The attacks the items added with the previous Equip, see item.Item's
+// AttackIdx, are replaced, all the other attacks are kept. The kept attacks'
+// PairedWith is remapped to the new indices, the attacks paired with a
+// replaced one and the item attacks are not paired. The equipped weapons'
+// AttackIdx is set to their new attacks.
+func (c *Creature) Equip() {
+	added := make(map[int]struct{}, len(c.Items))
+	for i := range c.Items {
+		if c.Items[i].AttackIdx > 0 {
+			added[int(c.Items[i].AttackIdx)-1] = struct{}{}
+		}
+	}
+
+	// pairedWith maps the old attack indices to the new PairedWith values of
+	// the kept attacks, 0 for the replaced ones.
+	pairedWith := make([]uint8, len(c.Attacks))
+	var attacks []atk.Attack
+	for i := range c.Attacks {
+		if _, ok := added[i]; ok {
+			continue
+		}
+		attacks = append(attacks, c.Attacks[i])
+		// Suppressing gosec "G115: integer overflow conversion int -> uint8"
+		// because PairedWith only refers to the first math.MaxUint8 attacks and
+		// their new indices are never greater than the old ones.
+		pairedWith[i] = uint8(len(attacks)) //nolint:gosec
+	}
+	for i := range attacks {
+		if idx := int(attacks[i].PairedWith) - 1; idx >= 0 {
+			attacks[i].PairedWith = 0
+			if idx < len(pairedWith) {
+				attacks[i].PairedWith = pairedWith[idx]
+			}
+		}
+	}
+
+	for i := range c.Items {
+		c.Items[i].AttackIdx = 0
+		if !c.Items[i].IsEquipped || c.Items[i].Weapon == nil {
+			continue
+		}
+
+		attack := c.Items[i].Weapon.DeepCopy()
+		attack.PairedWith = 0
+		attacks = append(attacks, attack)
+		if len(attacks) <= math.MaxUint8 {
+			// Suppressing gosec "G115: integer overflow conversion int -> uint8"
+			// because the number of attacks is checked to be in [1,MaxUint8].
+			c.Items[i].AttackIdx = uint8(len(attacks)) //nolint:gosec
+		}
+	}
+
+	c.Attacks = attacks
+	c.Armor = c.ItemArmor()
+}
@@ -0,0 +1,679 @@
+package creat
+
+import (
+	"testing"
+
+	"github.com/rozag/cabasi/atk"
+	"github.com/rozag/cabasi/dice"
+	"github.com/rozag/cabasi/item"
+	"github.com/rozag/cabasi/spell"
+)
+
+func TestCreatureUsedSlots(t *testing.T) {
+	fighter := Creature{
+		ID: "player-0", Name: "Lancelot",
+		Attacks: nil,
+		Items: []item.Item{
+			{
+				Name: "Longsword", Kind: item.KindWeapon, Armor: 0,
+				Weapon: &atk.Attack{
+					Name: "Longsword", TargetCharacteristic: atk.STR,
+					Dice: dice.D10, DiceCnt: 1, Charges: -1,
+					IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitBulky,
+					PairedWith: 0,
+					Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+					Recharge:   nil, DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+					Area: atk.AreaZone, MaxTargets: 0,
+				},
+				IsBulky: true, IsEquipped: true, AttackIdx: 0,
+			},
+			{
+				Name: "Dagger", Kind: item.KindWeapon, Armor: 0,
+				Weapon: &atk.Attack{
+					Name: "Dagger", TargetCharacteristic: atk.STR,
+					Dice: dice.D6, DiceCnt: 1, Charges: -1,
+					IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+					PairedWith: 0,
+					Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+					Recharge:   nil, DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+					Area: atk.AreaZone, MaxTargets: 0,
+				},
+				IsBulky: false, IsEquipped: false, AttackIdx: 0,
+			},
+			{
+				Name: "Brigandine", Weapon: nil, Kind: item.KindArmor, Armor: 1,
+				IsBulky: false, IsEquipped: true, AttackIdx: 0,
+			},
+			{
+				Name: "Shield", Weapon: nil, Kind: item.KindShield, Armor: 1,
+				IsBulky: false, IsEquipped: true, AttackIdx: 0,
+			},
+			{
+				Name: "Helmet", Weapon: nil, Kind: item.KindHelmet, Armor: 1,
+				IsBulky: false, IsEquipped: true, AttackIdx: 0,
+			},
+			{
+				Name: "Rope", Weapon: nil, Kind: item.KindGear, Armor: 0,
+				IsBulky: false, IsEquipped: false, AttackIdx: 0,
+			},
+		},
+		STR: 14, DEX: 10, WIL: 9, HP: 5, MaxHP: 5, Scars: nil, Armor: 0,
+		Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+		IsDetachment: false, Conditions: nil, Zone: atk.ZoneEngaged,
+	}
+	if got := fighter.UsedSlots(); got != 7 {
+		t.Errorf("Creature.UsedSlots(): want 7, got %d", got)
+	}
+
+	fighter.Fatigue = 2
+	if got := fighter.UsedSlots(); got != 9 {
+		t.Errorf("Creature.UsedSlots(): want 9 with Fatigue, got %d", got)
+	}
+
+	noCondition := atk.Condition{Kind: atk.ConditionNone, Rounds: 0}
+	wizard := Creature{
+		ID: "player-0", Name: "Merlin",
+		Attacks: []atk.Attack{
+			{
+				Name: "Staff", TargetCharacteristic: atk.STR,
+				Dice: dice.D6, DiceCnt: 1, Charges: -1,
+				IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitBulky,
+				PairedWith: 0, Inflicts: noCondition, Recharge: nil,
+				DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+				Area: atk.AreaZone, MaxTargets: 0,
+			},
+		},
+		Spellbooks: []spell.Book{
+			{
+				Name: "Fireball",
+				Effect: atk.Attack{
+					Name: "Fireball", TargetCharacteristic: atk.STR,
+					Dice: dice.D8, DiceCnt: 1, Charges: -1,
+					IsBlast: true, Pool: atk.PoolHighest, Traits: atk.TraitMagical,
+					PairedWith: 0, Inflicts: noCondition, Recharge: nil,
+					DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+					Area: atk.AreaZone, MaxTargets: 0,
+				},
+			},
+			{
+				Name: "Fear",
+				Effect: atk.Attack{
+					Name: "Fear", TargetCharacteristic: atk.WIL,
+					Dice: dice.D4, DiceCnt: 1, Charges: -1,
+					IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitMagical,
+					PairedWith: 0, Recharge: nil, DmgMod: 0, MinDmg: 0,
+					Usage: atk.UsageAlways, Area: atk.AreaZone, MaxTargets: 0,
+					Inflicts: atk.Condition{
+						Kind: atk.ConditionFrightened, Rounds: 2,
+					},
+				},
+			},
+		},
+		Conditions: nil, Fatigue: 0,
+		STR: 8, DEX: 12, WIL: 15, HP: 4, MaxHP: 4, Scars: nil, Armor: 0,
+		IsDetachment: false, IsDeprived: false, Supports: nil, Items: nil,
+		Zone: atk.ZoneEngaged,
+	}
+	if got := wizard.UsedSlots(); got != 2 {
+		t.Errorf("Creature.UsedSlots(): want 2 for spellbooks, got %d", got)
+	}
+}
+
+func TestCreatureIsOverloaded(t *testing.T) {
+	fighter := Creature{
+		ID: "player-0", Name: "Lancelot",
+		Attacks: nil,
+		Items: []item.Item{
+			{
+				Name: "Longsword", Kind: item.KindWeapon, Armor: 0,
+				Weapon: &atk.Attack{
+					Name: "Longsword", TargetCharacteristic: atk.STR,
+					Dice: dice.D10, DiceCnt: 1, Charges: -1,
+					IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitBulky,
+					PairedWith: 0,
+					Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+					Recharge:   nil, DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+					Area: atk.AreaZone, MaxTargets: 0,
+				},
+				IsBulky: true, IsEquipped: true, AttackIdx: 0,
+			},
+			{
+				Name: "Dagger", Kind: item.KindWeapon, Armor: 0,
+				Weapon: &atk.Attack{
+					Name: "Dagger", TargetCharacteristic: atk.STR,
+					Dice: dice.D6, DiceCnt: 1, Charges: -1,
+					IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+					PairedWith: 0,
+					Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+					Recharge:   nil, DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+					Area: atk.AreaZone, MaxTargets: 0,
+				},
+				IsBulky: false, IsEquipped: false, AttackIdx: 0,
+			},
+			{
+				Name: "Brigandine", Weapon: nil, Kind: item.KindArmor, Armor: 1,
+				IsBulky: false, IsEquipped: true, AttackIdx: 0,
+			},
+			{
+				Name: "Shield", Weapon: nil, Kind: item.KindShield, Armor: 1,
+				IsBulky: false, IsEquipped: true, AttackIdx: 0,
+			},
+			{
+				Name: "Helmet", Weapon: nil, Kind: item.KindHelmet, Armor: 1,
+				IsBulky: false, IsEquipped: true, AttackIdx: 0,
+			},
+			{
+				Name: "Rope", Weapon: nil, Kind: item.KindGear, Armor: 0,
+				IsBulky: false, IsEquipped: false, AttackIdx: 0,
+			},
+		},
+		STR: 14, DEX: 10, WIL: 9, HP: 5, MaxHP: 5, Scars: nil, Armor: 0,
+		Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+		IsDetachment: false, Conditions: nil, Zone: atk.ZoneEngaged,
+	}
+	if fighter.IsOverloaded() {
+		t.Errorf("Creature.IsOverloaded(): want false, got true")
+	}
+
+	fighter.Fatigue = 3
+	if !fighter.IsOverloaded() {
+		t.Errorf("Creature.IsOverloaded(): want true for full slots, got false")
+	}
+}
+
+func TestCreatureCarry(t *testing.T) {
+	lancelot := Creature{
+		ID: "player-0", Name: "Lancelot",
+		Attacks: nil,
+		Items: []item.Item{
+			{
+				Name: "Longsword", Kind: item.KindWeapon, Armor: 0,
+				Weapon: &atk.Attack{
+					Name: "Longsword", TargetCharacteristic: atk.STR,
+					Dice: dice.D10, DiceCnt: 1, Charges: -1,
+					IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitBulky,
+					PairedWith: 0,
+					Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+					Recharge:   nil, DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+					Area: atk.AreaZone, MaxTargets: 0,
+				},
+				IsBulky: true, IsEquipped: true, AttackIdx: 0,
+			},
+			{
+				Name: "Dagger", Kind: item.KindWeapon, Armor: 0,
+				Weapon: &atk.Attack{
+					Name: "Dagger", TargetCharacteristic: atk.STR,
+					Dice: dice.D6, DiceCnt: 1, Charges: -1,
+					IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+					PairedWith: 0,
+					Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+					Recharge:   nil, DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+					Area: atk.AreaZone, MaxTargets: 0,
+				},
+				IsBulky: false, IsEquipped: false, AttackIdx: 0,
+			},
+			{
+				Name: "Brigandine", Weapon: nil, Kind: item.KindArmor, Armor: 1,
+				IsBulky: false, IsEquipped: true, AttackIdx: 0,
+			},
+			{
+				Name: "Shield", Weapon: nil, Kind: item.KindShield, Armor: 1,
+				IsBulky: false, IsEquipped: true, AttackIdx: 0,
+			},
+			{
+				Name: "Helmet", Weapon: nil, Kind: item.KindHelmet, Armor: 1,
+				IsBulky: false, IsEquipped: true, AttackIdx: 0,
+			},
+			{
+				Name: "Rope", Weapon: nil, Kind: item.KindGear, Armor: 0,
+				IsBulky: false, IsEquipped: false, AttackIdx: 0,
+			},
+		},
+		STR: 14, DEX: 10, WIL: 9, HP: 5, MaxHP: 5, Scars: nil, Armor: 0,
+		Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+		IsDetachment: false, Conditions: nil, Zone: atk.ZoneEngaged,
+	}
+	torch := item.Item{
+		Name: "Torch", Weapon: nil, Kind: item.KindGear, Armor: 0,
+		IsBulky: false, IsEquipped: false, AttackIdx: 0,
+	}
+	tent := item.Item{
+		Name: "Tent", Weapon: nil, Kind: item.KindGear, Armor: 0,
+		IsBulky: true, IsEquipped: false, AttackIdx: 0,
+	}
+	tests := []struct {
+		name         string
+		item         item.Item
+		fatigue      uint8
+		want         bool
+		wantItemsCnt int
+		wantHP       uint8
+	}{
+		{
+			name: "Fits", item: torch, fatigue: 0,
+			want: true, wantItemsCnt: 7, wantHP: 5,
+		},
+		{
+			name: "BulkyFits", item: tent, fatigue: 0,
+			want: true, wantItemsCnt: 7, wantHP: 5,
+		},
+		{
+			name: "TakesLastSlot", item: torch, fatigue: 2,
+			want: true, wantItemsCnt: 7, wantHP: 0,
+		},
+		{
+			name: "BulkyDoesNotFit", item: tent, fatigue: 2,
+			want: false, wantItemsCnt: 6, wantHP: 5,
+		},
+		{
+			name: "NoFreeSlots", item: torch, fatigue: 3,
+			want: false, wantItemsCnt: 6, wantHP: 5,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fighter := lancelot.DeepCopy()
+			fighter.Fatigue = test.fatigue
+			got := fighter.Carry(test.item)
+			if got != test.want ||
+				len(fighter.Items) != test.wantItemsCnt ||
+				fighter.HP != test.wantHP {
+				t.Errorf(
+					"Creature.Carry() = %t with %d items and HP %d, "+
+						"want %t with %d items and HP %d",
+					got, len(fighter.Items), fighter.HP,
+					test.want, test.wantItemsCnt, test.wantHP,
+				)
+			}
+		})
+	}
+}
+
+func TestCreatureTakeFatigue(t *testing.T) {
+	lancelot := Creature{
+		ID: "player-0", Name: "Lancelot",
+		Attacks: nil,
+		Items: []item.Item{
+			{
+				Name: "Longsword", Kind: item.KindWeapon, Armor: 0,
+				Weapon: &atk.Attack{
+					Name: "Longsword", TargetCharacteristic: atk.STR,
+					Dice: dice.D10, DiceCnt: 1, Charges: -1,
+					IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitBulky,
+					PairedWith: 0,
+					Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+					Recharge:   nil, DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+					Area: atk.AreaZone, MaxTargets: 0,
+				},
+				IsBulky: true, IsEquipped: true, AttackIdx: 0,
+			},
+			{
+				Name: "Dagger", Kind: item.KindWeapon, Armor: 0,
+				Weapon: &atk.Attack{
+					Name: "Dagger", TargetCharacteristic: atk.STR,
+					Dice: dice.D6, DiceCnt: 1, Charges: -1,
+					IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+					PairedWith: 0,
+					Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+					Recharge:   nil, DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+					Area: atk.AreaZone, MaxTargets: 0,
+				},
+				IsBulky: false, IsEquipped: false, AttackIdx: 0,
+			},
+			{
+				Name: "Brigandine", Weapon: nil, Kind: item.KindArmor, Armor: 1,
+				IsBulky: false, IsEquipped: true, AttackIdx: 0,
+			},
+			{
+				Name: "Shield", Weapon: nil, Kind: item.KindShield, Armor: 1,
+				IsBulky: false, IsEquipped: true, AttackIdx: 0,
+			},
+			{
+				Name: "Helmet", Weapon: nil, Kind: item.KindHelmet, Armor: 1,
+				IsBulky: false, IsEquipped: true, AttackIdx: 0,
+			},
+			{
+				Name: "Rope", Weapon: nil, Kind: item.KindGear, Armor: 0,
+				IsBulky: false, IsEquipped: false, AttackIdx: 0,
+			},
+		},
+		STR: 14, DEX: 10, WIL: 9, HP: 5, MaxHP: 5, Scars: nil, Armor: 0,
+		Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+		IsDetachment: false, Conditions: nil, Zone: atk.ZoneEngaged,
+	}
+	tests := []struct {
+		name        string
+		cnt         uint8
+		fatigue     uint8
+		want        bool
+		wantFatigue uint8
+		wantHP      uint8
+	}{
+		{
+			name: "Fits", cnt: 1, fatigue: 0,
+			want: true, wantFatigue: 1, wantHP: 5,
+		},
+		{
+			name: "TakesLastSlot", cnt: 2, fatigue: 1,
+			want: true, wantFatigue: 3, wantHP: 0,
+		},
+		{
+			name: "DoesNotFit", cnt: 2, fatigue: 2,
+			want: false, wantFatigue: 2, wantHP: 5,
+		},
+		{
+			name: "NoFreeSlots", cnt: 1, fatigue: 3,
+			want: false, wantFatigue: 3, wantHP: 5,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fighter := lancelot.DeepCopy()
+			fighter.Fatigue = test.fatigue
+			got := fighter.TakeFatigue(test.cnt)
+			if got != test.want ||
+				fighter.Fatigue != test.wantFatigue ||
+				fighter.HP != test.wantHP {
+				t.Errorf(
+					"Creature.TakeFatigue() = %t with Fatigue %d and HP %d, "+
+						"want %t with Fatigue %d and HP %d",
+					got, fighter.Fatigue, fighter.HP,
+					test.want, test.wantFatigue, test.wantHP,
+				)
+			}
+		})
+	}
+}
+
+func TestCreatureItemAttacks(t *testing.T) {
+	fighter := Creature{
+		ID: "player-0", Name: "Lancelot",
+		Attacks: nil,
+		Items: []item.Item{
+			{
+				Name: "Longsword", Kind: item.KindWeapon, Armor: 0,
+				Weapon: &atk.Attack{
+					Name: "Longsword", TargetCharacteristic: atk.STR,
+					Dice: dice.D10, DiceCnt: 1, Charges: -1,
+					IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitBulky,
+					PairedWith: 0,
+					Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+					Recharge:   nil, DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+					Area: atk.AreaZone, MaxTargets: 0,
+				},
+				IsBulky: true, IsEquipped: true, AttackIdx: 0,
+			},
+			{
+				Name: "Dagger", Kind: item.KindWeapon, Armor: 0,
+				Weapon: &atk.Attack{
+					Name: "Dagger", TargetCharacteristic: atk.STR,
+					Dice: dice.D6, DiceCnt: 1, Charges: -1,
+					IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+					PairedWith: 0,
+					Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+					Recharge:   nil, DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+					Area: atk.AreaZone, MaxTargets: 0,
+				},
+				IsBulky: false, IsEquipped: false, AttackIdx: 0,
+			},
+			{
+				Name: "Brigandine", Weapon: nil, Kind: item.KindArmor, Armor: 1,
+				IsBulky: false, IsEquipped: true, AttackIdx: 0,
+			},
+			{
+				Name: "Shield", Weapon: nil, Kind: item.KindShield, Armor: 1,
+				IsBulky: false, IsEquipped: true, AttackIdx: 0,
+			},
+			{
+				Name: "Helmet", Weapon: nil, Kind: item.KindHelmet, Armor: 1,
+				IsBulky: false, IsEquipped: true, AttackIdx: 0,
+			},
+			{
+				Name: "Rope", Weapon: nil, Kind: item.KindGear, Armor: 0,
+				IsBulky: false, IsEquipped: false, AttackIdx: 0,
+			},
+		},
+		STR: 14, DEX: 10, WIL: 9, HP: 5, MaxHP: 5, Scars: nil, Armor: 0,
+		Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+		IsDetachment: false, Conditions: nil, Zone: atk.ZoneEngaged,
+	}
+	attacks := fighter.ItemAttacks()
+	if len(attacks) != 1 || attacks[0].Name != "Longsword" {
+		t.Fatalf(
+			"Creature.ItemAttacks(): want the equipped Longsword only, got %v",
+			atk.AttackSlice(attacks),
+		)
+	}
+
+	attacks[0].Charges = 1
+	if fighter.Items[0].Weapon.Charges != -1 {
+		t.Errorf("modifying the attack affected the weapon: %v", fighter.Items[0])
+	}
+
+	fighter.Items[0].IsEquipped = false
+	if attacks := fighter.ItemAttacks(); attacks != nil {
+		t.Errorf(
+			"Creature.ItemAttacks(): want nil without equipped weapons, got %v",
+			atk.AttackSlice(attacks),
+		)
+	}
+}
+
+func TestCreatureItemArmor(t *testing.T) {
+	lancelot := Creature{
+		ID: "player-0", Name: "Lancelot",
+		Attacks: nil,
+		Items: []item.Item{
+			{
+				Name: "Longsword", Kind: item.KindWeapon, Armor: 0,
+				Weapon: &atk.Attack{
+					Name: "Longsword", TargetCharacteristic: atk.STR,
+					Dice: dice.D10, DiceCnt: 1, Charges: -1,
+					IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitBulky,
+					PairedWith: 0,
+					Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+					Recharge:   nil, DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+					Area: atk.AreaZone, MaxTargets: 0,
+				},
+				IsBulky: true, IsEquipped: true, AttackIdx: 0,
+			},
+			{
+				Name: "Dagger", Kind: item.KindWeapon, Armor: 0,
+				Weapon: &atk.Attack{
+					Name: "Dagger", TargetCharacteristic: atk.STR,
+					Dice: dice.D6, DiceCnt: 1, Charges: -1,
+					IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+					PairedWith: 0,
+					Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+					Recharge:   nil, DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+					Area: atk.AreaZone, MaxTargets: 0,
+				},
+				IsBulky: false, IsEquipped: false, AttackIdx: 0,
+			},
+			{
+				Name: "Brigandine", Weapon: nil, Kind: item.KindArmor, Armor: 1,
+				IsBulky: false, IsEquipped: true, AttackIdx: 0,
+			},
+			{
+				Name: "Shield", Weapon: nil, Kind: item.KindShield, Armor: 1,
+				IsBulky: false, IsEquipped: true, AttackIdx: 0,
+			},
+			{
+				Name: "Helmet", Weapon: nil, Kind: item.KindHelmet, Armor: 1,
+				IsBulky: false, IsEquipped: true, AttackIdx: 0,
+			},
+			{
+				Name: "Rope", Weapon: nil, Kind: item.KindGear, Armor: 0,
+				IsBulky: false, IsEquipped: false, AttackIdx: 0,
+			},
+		},
+		STR: 14, DEX: 10, WIL: 9, HP: 5, MaxHP: 5, Scars: nil, Armor: 0,
+		Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+		IsDetachment: false, Conditions: nil, Zone: atk.ZoneEngaged,
+	}
+	tests := []struct {
+		name   string
+		modify func(c *Creature)
+		want   uint8
+	}{
+		{name: "AllPieces", modify: func(*Creature) {}, want: 3},
+		{
+			name:   "UnequippedShield",
+			modify: func(c *Creature) { c.Items[3].IsEquipped = false },
+			want:   2,
+		},
+		{
+			name:   "CappedAtArmorMax",
+			modify: func(c *Creature) { c.Items[2].Armor = 3 },
+			want:   ArmorMax,
+		},
+		{name: "NoItems", modify: func(c *Creature) { c.Items = nil }, want: 0},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fighter := lancelot.DeepCopy()
+			test.modify(&fighter)
+			if got := fighter.ItemArmor(); got != test.want {
+				t.Errorf("Creature.ItemArmor() = %d, want %d", got, test.want)
+			}
+		})
+	}
+}
+
+func TestCreatureEquip(t *testing.T) {
+	fighter := Creature{
+		ID: "player-0", Name: "Lancelot",
+		Attacks: nil,
+		Items: []item.Item{
+			{
+				Name: "Longsword", Kind: item.KindWeapon, Armor: 0,
+				Weapon: &atk.Attack{
+					Name: "Longsword", TargetCharacteristic: atk.STR,
+					Dice: dice.D10, DiceCnt: 1, Charges: -1,
+					IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitBulky,
+					PairedWith: 0,
+					Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+					Recharge:   nil, DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+					Area: atk.AreaZone, MaxTargets: 0,
+				},
+				IsBulky: true, IsEquipped: true, AttackIdx: 0,
+			},
+			{
+				Name: "Dagger", Kind: item.KindWeapon, Armor: 0,
+				Weapon: &atk.Attack{
+					Name: "Dagger", TargetCharacteristic: atk.STR,
+					Dice: dice.D6, DiceCnt: 1, Charges: -1,
+					IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+					PairedWith: 0,
+					Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+					Recharge:   nil, DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+					Area: atk.AreaZone, MaxTargets: 0,
+				},
+				IsBulky: false, IsEquipped: false, AttackIdx: 0,
+			},
+			{
+				Name: "Brigandine", Weapon: nil, Kind: item.KindArmor, Armor: 1,
+				IsBulky: false, IsEquipped: true, AttackIdx: 0,
+			},
+			{
+				Name: "Shield", Weapon: nil, Kind: item.KindShield, Armor: 1,
+				IsBulky: false, IsEquipped: true, AttackIdx: 0,
+			},
+			{
+				Name: "Helmet", Weapon: nil, Kind: item.KindHelmet, Armor: 1,
+				IsBulky: false, IsEquipped: true, AttackIdx: 0,
+			},
+			{
+				Name: "Rope", Weapon: nil, Kind: item.KindGear, Armor: 0,
+				IsBulky: false, IsEquipped: false, AttackIdx: 0,
+			},
+		},
+		STR: 14, DEX: 10, WIL: 9, HP: 5, MaxHP: 5, Scars: nil, Armor: 0,
+		Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+		IsDetachment: false, Conditions: nil, Zone: atk.ZoneEngaged,
+	}
+	fighter.Equip()
+
+	if len(fighter.Attacks) != 1 ||
+		!fighter.Attacks[0].Equals(fighter.Items[0].Weapon) {
+		t.Errorf(
+			"Creature.Equip(): want the Longsword attack, got %v",
+			atk.AttackSlice(fighter.Attacks),
+		)
+	}
+	if fighter.Armor != 3 {
+		t.Errorf("Creature.Equip(): want Armor 3, got %d", fighter.Armor)
+	}
+	if err := fighter.Validate(); err != nil {
+		t.Errorf("Creature.Equip(): want valid creature, got %v", err)
+	}
+}
+
+func TestCreatureEquipKeepsOtherAttacks(t *testing.T) {
+	punch := atk.Attack{
+		Name: "Punch", TargetCharacteristic: atk.STR,
+		Dice: dice.D4, DiceCnt: 1, Charges: -1,
+		IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+		PairedWith: 0,
+		Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+		Recharge:   nil, DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+		Area: atk.AreaZone, MaxTargets: 0,
+	}
+	longsword, dagger, kick, knife := punch, punch, punch, punch
+	longsword.Name, longsword.Dice = "Longsword", dice.D10
+	longsword.Traits, longsword.PairedWith = atk.TraitBulky, 2
+	dagger.Name, dagger.Dice = "Dagger", dice.D6
+	kick.Name, kick.PairedWith = "Kick", 5
+	// The knife is an innate attack named after a weapon, it must be kept.
+	knife.Name = "Dagger"
+	punch.PairedWith = 1
+	brawler := Creature{
+		ID: "player-0", Name: "Little John",
+		Attacks: []atk.Attack{longsword, punch, dagger, kick, knife},
+		Items: []item.Item{
+			{
+				Name: "Longsword", Kind: item.KindWeapon, Armor: 0,
+				Weapon: &longsword, IsBulky: true, IsEquipped: true, AttackIdx: 1,
+			},
+			{
+				Name: "Dagger", Kind: item.KindWeapon, Armor: 0,
+				Weapon: &dagger, IsBulky: false, IsEquipped: false, AttackIdx: 3,
+			},
+		},
+		STR: 14, DEX: 10, WIL: 9, HP: 5, MaxHP: 5, Scars: nil, Armor: 0,
+		Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+		IsDetachment: false, Conditions: nil, Zone: atk.ZoneEngaged,
+	}
+
+	brawler.Equip()
+
+	wantNames := []string{"Punch", "Kick", "Dagger", "Longsword"}
+	wantPairedWith := []uint8{0, 3, 0, 0}
+	if len(brawler.Attacks) != len(wantNames) {
+		t.Fatalf(
+			"Creature.Equip(): want attacks %v, got %v",
+			wantNames, atk.AttackSlice(brawler.Attacks),
+		)
+	}
+	for idx, attack := range brawler.Attacks {
+		if attack.Name != wantNames[idx] ||
+			attack.PairedWith != wantPairedWith[idx] {
+			t.Errorf(
+				"Creature.Equip(): want %s paired with %d at idx %d, got %v",
+				wantNames[idx], wantPairedWith[idx], idx, &attack,
+			)
+		}
+	}
+	if brawler.Items[0].AttackIdx != 4 || brawler.Items[1].AttackIdx != 0 {
+		t.Errorf(
+			"Creature.Equip(): want items' attack indices 4 and 0, got %d and %d",
+			brawler.Items[0].AttackIdx, brawler.Items[1].AttackIdx,
+		)
+	}
+	if brawler.Items[0].Weapon.PairedWith != 2 {
+		t.Errorf("Creature.Equip(): modified the equipped weapon")
+	}
+	if err := brawler.Validate(); err != nil {
+		t.Errorf("Creature.Equip(): want valid creature, got %v", err)
+	}
+}
@@ -0,0 +1,229 @@
+package creat
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/rozag/cabasi/act"
+	"github.com/rozag/cabasi/atk"
+	"github.com/rozag/cabasi/internal/codec"
+	"github.com/rozag/cabasi/item"
+	"github.com/rozag/cabasi/spell"
+)
+
+// creatureJSON is the JSON encoding of a Creature. Empty spellbooks,
+// supports, items, conditions and scars are omitted.
+type creatureJSON struct {
+	ID           string            `json:"id"`
+	Name         string            `json:"name"`
+	Zone         string            `json:"zone"`
+	Attacks      []json.RawMessage `json:"attacks"`
+	Spellbooks   []json.RawMessage `json:"spellbooks,omitempty"`
+	Supports     []json.RawMessage `json:"supports,omitempty"`
+	Items        []json.RawMessage `json:"items,omitempty"`
+	Conditions   []json.RawMessage `json:"conditions,omitempty"`
+	Scars        []json.RawMessage `json:"scars,omitempty"`
+	STR          uint8             `json:"str"`
+	DEX          uint8             `json:"dex"`
+	WIL          uint8             `json:"wil"`
+	HP           uint8             `json:"hp"`
+	MaxHP        *uint8            `json:"maxHP"`
+	Armor        uint8             `json:"armor"`
+	Fatigue      uint8             `json:"fatigue"`
+	IsDetachment bool              `json:"isDetachment"`
+	IsDeprived   bool              `json:"isDeprived"`
+}
+
+// MarshalJSON implements json.Marshaler. Attacks, spellbooks, supports,
+// items, conditions and scars are encoded with their own json.Marshaler
+// implementations, the zone as its string representation, e.g. "Near".
+// Errors of the fields are reported as *codec.FieldError with the full path,
+// e.g. "attacks[0].dice". Like the other methods of Creature it has a pointer
+// receiver, so encode a *Creature.
+func (c *Creature) MarshalJSON() ([]byte, error) {
+	var errs []error
+
+	zone, err := c.Zone.MarshalText()
+	if err != nil {
+		errs = append(errs, codec.WrapField("zone", err))
+	}
+
+	raw := creatureJSON{
+		ID: string(c.ID), Name: c.Name, Zone: string(zone),
+		STR: c.STR, DEX: c.DEX, WIL: c.WIL, HP: c.HP, MaxHP: &c.MaxHP,
+		Armor: c.Armor, Fatigue: c.Fatigue, IsDetachment: c.IsDetachment,
+		IsDeprived: c.IsDeprived,
+		Attacks:    encodeSlice("attacks", c.Attacks, &errs),
+		Spellbooks: encodeSlice("spellbooks", c.Spellbooks, &errs),
+		Supports:   encodeSlice("supports", c.Supports, &errs),
+		Items:      encodeSlice("items", c.Items, &errs),
+		Conditions: encodeSlice("conditions", c.Conditions, &errs),
+		Scars:      encodeSlice("scars", c.Scars, &errs),
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode creature: %w", err)
+	}
+	return data, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Missing zone defaults to
+// atk.ZoneEngaged and missing max HP defaults to HP. Errors of the fields are
+// reported as *codec.FieldError with the full path, e.g. "attacks[0].dice". The
+// decoded creature isn't validated because it may be encoded in the middle of
+// a battle, see DecodeJSON for freshly created creatures.
+func (c *Creature) UnmarshalJSON(data []byte) error {
+	raw := creatureJSON{
+		ID: "", Name: "", Zone: atk.ZoneEngaged.String(),
+		Attacks: nil, Spellbooks: nil, Supports: nil, Items: nil,
+		Conditions: nil, Scars: nil, STR: 0, DEX: 0, WIL: 0, HP: 0, MaxHP: nil,
+		Armor: 0, Fatigue: 0, IsDetachment: false, IsDeprived: false,
+	}
+	if err := codec.DecodeJSON(data, &raw); err != nil {
+		// Suppressing wrapcheck "error returned from external package is
+		// unwrapped" because the error already reports the failed field.
+		return err // nolint:wrapcheck
+	}
+
+	var errs []error
+	creature := Creature{
+		ID: ID(raw.ID), Name: raw.Name,
+		Attacks:    decodeSlice[atk.Attack]("attacks", raw.Attacks, &errs),
+		Spellbooks: decodeSlice[spell.Book]("spellbooks", raw.Spellbooks, &errs),
+		Supports:   decodeSlice[act.Support]("supports", raw.Supports, &errs),
+		Items:      decodeSlice[item.Item]("items", raw.Items, &errs),
+		Conditions: decodeSlice[atk.Condition](
+			"conditions", raw.Conditions, &errs,
+		),
+		Scars: decodeSlice[Scar]("scars", raw.Scars, &errs),
+		STR:   raw.STR, DEX: raw.DEX, WIL: raw.WIL, HP: raw.HP, MaxHP: raw.HP,
+		Armor: raw.Armor, Fatigue: raw.Fatigue, Zone: atk.ZoneEngaged,
+		IsDetachment: raw.IsDetachment, IsDeprived: raw.IsDeprived,
+	}
+	if raw.MaxHP != nil {
+		creature.MaxHP = *raw.MaxHP
+	}
+	if err := creature.Zone.UnmarshalText([]byte(raw.Zone)); err != nil {
+		errs = append(errs, codec.WrapField("zone", err))
+	}
+
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	*c = creature
+	return nil
+}
+
+// DecodeJSON decodes the JSON encoding of a freshly created Creature and
+// validates it, see Validate. Errors of the fields, both decoding and
+// validation ones, are reported as *codec.FieldError with the full path, e.g.
+// "attacks[0].diceCnt".
+func DecodeJSON(data []byte) (Creature, error) {
+	var none, creature Creature
+	if err := creature.UnmarshalJSON(data); err != nil {
+		return none, err
+	}
+	if err := creature.Validate(); err != nil {
+		return none, err
+	}
+	return creature, nil
+}
+
+// scarJSON is the JSON encoding of a Scar. Empty detail is omitted.
+type scarJSON struct {
+	Kind   string `json:"kind"`
+	Detail string `json:"detail,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler, e.g.
+// {"kind": "BrokenLimb", "detail": "Arm"}.
+func (s Scar) MarshalJSON() ([]byte, error) {
+	var errs []error
+
+	kind, err := s.Kind.MarshalText()
+	if err != nil {
+		errs = append(errs, codec.WrapField("kind", err))
+	}
+
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	data, err := json.Marshal(scarJSON{Kind: string(kind), Detail: s.Detail})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode scar: %w", err)
+	}
+	return data, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Errors of the fields are
+// reported as *codec.FieldError.
+func (s *Scar) UnmarshalJSON(data []byte) error {
+	var raw scarJSON
+	if err := codec.DecodeJSON(data, &raw); err != nil {
+		// Suppressing wrapcheck "error returned from external package is
+		// unwrapped" because the error already reports the failed field.
+		return err // nolint:wrapcheck
+	}
+
+	var errs []error
+	scar := Scar{Detail: raw.Detail, Kind: ScarLasting}
+
+	if err := scar.Kind.UnmarshalText([]byte(raw.Kind)); err != nil {
+		errs = append(errs, codec.WrapField("kind", err))
+	}
+
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	*s = scar
+	return nil
+}
+
+// encodeSlice encodes the values of the named slice field one by one, so the
+// errors report the index of the failed value, e.g. "attacks[0]". The errors
+// are appended to errs.
+func encodeSlice[T any, PT interface {
+	*T
+	json.Marshaler
+}](name string, values []T, errs *[]error) []json.RawMessage {
+	raws := make([]json.RawMessage, 0, len(values))
+	for idx := range values {
+		raw, err := PT(&values[idx]).MarshalJSON()
+		if err != nil {
+			path := fmt.Sprintf("%s[%d]", name, idx)
+			*errs = append(*errs, codec.WrapField(path, err))
+			continue
+		}
+		raws = append(raws, raw)
+	}
+	return raws
+}
+
+// decodeSlice decodes the values of the named slice field one by one, so the
+// errors report the index of the failed value, e.g. "attacks[0]". The errors
+// are appended to errs. It returns nil for an empty slice.
+func decodeSlice[T any, PT interface {
+	*T
+	json.Unmarshaler
+}](name string, raws []json.RawMessage, errs *[]error) []T {
+	if len(raws) == 0 {
+		return nil
+	}
+
+	values := make([]T, len(raws))
+	for idx, raw := range raws {
+		if err := PT(&values[idx]).UnmarshalJSON(raw); err != nil {
+			path := fmt.Sprintf("%s[%d]", name, idx)
+			*errs = append(*errs, codec.WrapField(path, err))
+		}
+	}
+	return values
+}
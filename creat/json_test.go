@@ -0,0 +1,478 @@
+package creat
+
+import (
+	"encoding/json"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/rozag/cabasi/act"
+	"github.com/rozag/cabasi/atk"
+	"github.com/rozag/cabasi/dice"
+	"github.com/rozag/cabasi/internal/codec"
+	"github.com/rozag/cabasi/item"
+	"github.com/rozag/cabasi/spell"
+)
+
+func TestCreatureMarshalJSON(t *testing.T) {
+	noCondition := atk.Condition{Kind: atk.ConditionNone, Rounds: 0}
+	goblin := Creature{
+		ID: "goblin-1", Name: "Goblin",
+		Attacks: []atk.Attack{
+			{
+				Name: "Spear", TargetCharacteristic: atk.STR,
+				Dice: dice.D6, DiceCnt: 1, Charges: -1,
+				IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitReach,
+				PairedWith: 0, Inflicts: noCondition, Recharge: nil,
+				DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+				Area: atk.AreaZone, MaxTargets: 0,
+			},
+		},
+		Spellbooks: nil, Supports: nil, Items: nil,
+		STR: 8, DEX: 14, WIL: 8, HP: 3, MaxHP: 3, Scars: nil, Armor: 0, Fatigue: 0,
+		Zone: atk.ZoneNear, IsDetachment: false, IsDeprived: false,
+		Conditions: []atk.Condition{{Kind: atk.ConditionFrightened, Rounds: 1}},
+	}
+	data, err := json.Marshal(&goblin)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+
+	want := `{"id":"goblin-1","name":"Goblin","zone":"Near",` +
+		`"attacks":[{"name":"Spear","target":"STR","dice":"d6","area":"Zone",` +
+		`"pool":"Highest","traits":"Reach","usage":"Always",` +
+		`"charges":"unlimited","diceCnt":1,"dmgMod":0,"minDmg":0,` +
+		`"maxTargets":0,"isBlast":false}],` +
+		`"conditions":[{"kind":"Frightened","rounds":1}],` +
+		`"str":8,"dex":14,"wil":8,"hp":3,"maxHP":3,"armor":0,"fatigue":0,` +
+		`"isDetachment":false,"isDeprived":false}`
+	if string(data) != want {
+		t.Fatalf("json.Marshal() = %s, want %s", data, want)
+	}
+}
+
+func TestCreatureJSONRoundTrip(t *testing.T) {
+	noCondition := atk.Condition{Kind: atk.ConditionNone, Rounds: 0}
+	poisoned := Creature{
+		ID: "player-0", Name: "Tuck",
+		Attacks: []atk.Attack{
+			{
+				Name: "Staff", TargetCharacteristic: atk.STR,
+				Dice: dice.D6, DiceCnt: 1, Charges: -1,
+				IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitBulky,
+				PairedWith: 0,
+				Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+				Recharge:   nil, DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+				Area: atk.AreaZone, MaxTargets: 0,
+			},
+		},
+		Spellbooks: nil,
+		Supports: []act.Support{
+			{
+				Name: "Cure Wounds", Kind: act.SupportHeal,
+				Dice: dice.D6, DiceCnt: 1, Rounds: 0, Charges: -1,
+			},
+			{
+				Name: "Shield of Faith", Kind: act.SupportProtect,
+				Dice: 0, DiceCnt: 0, Rounds: 1, Charges: 2,
+			},
+		},
+		Conditions: nil, Fatigue: 0,
+		STR: 8, DEX: 12, WIL: 15, HP: 4, MaxHP: 4, Scars: nil, Armor: 0,
+		IsDetachment: false, IsDeprived: false, Items: nil,
+		Zone: atk.ZoneEngaged,
+	}
+	poisoned.Conditions = []atk.Condition{
+		{Kind: atk.ConditionPoisoned, Rounds: 2},
+		{Kind: atk.ConditionProtected, Rounds: -1},
+	}
+	poisoned.Fatigue = 1
+	poisoned.IsDeprived = true
+
+	fighter := Creature{
+		ID: "player-0", Name: "Lancelot",
+		Attacks: nil,
+		Items: []item.Item{
+			{
+				Name: "Longsword", Kind: item.KindWeapon, Armor: 0,
+				Weapon: &atk.Attack{
+					Name: "Longsword", TargetCharacteristic: atk.STR,
+					Dice: dice.D10, DiceCnt: 1, Charges: -1,
+					IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitBulky,
+					PairedWith: 0,
+					Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+					Recharge:   nil, DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+					Area: atk.AreaZone, MaxTargets: 0,
+				},
+				IsBulky: true, IsEquipped: true, AttackIdx: 0,
+			},
+			{
+				Name: "Dagger", Kind: item.KindWeapon, Armor: 0,
+				Weapon: &atk.Attack{
+					Name: "Dagger", TargetCharacteristic: atk.STR,
+					Dice: dice.D6, DiceCnt: 1, Charges: -1,
+					IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+					PairedWith: 0,
+					Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+					Recharge:   nil, DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+					Area: atk.AreaZone, MaxTargets: 0,
+				},
+				IsBulky: false, IsEquipped: false, AttackIdx: 0,
+			},
+			{
+				Name: "Brigandine", Weapon: nil, Kind: item.KindArmor, Armor: 1,
+				IsBulky: false, IsEquipped: true, AttackIdx: 0,
+			},
+			{
+				Name: "Shield", Weapon: nil, Kind: item.KindShield, Armor: 1,
+				IsBulky: false, IsEquipped: true, AttackIdx: 0,
+			},
+			{
+				Name: "Helmet", Weapon: nil, Kind: item.KindHelmet, Armor: 1,
+				IsBulky: false, IsEquipped: true, AttackIdx: 0,
+			},
+			{
+				Name: "Rope", Weapon: nil, Kind: item.KindGear, Armor: 0,
+				IsBulky: false, IsEquipped: false, AttackIdx: 0,
+			},
+		},
+		STR: 14, DEX: 10, WIL: 9, HP: 5, MaxHP: 5, Scars: nil, Armor: 0,
+		Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+		IsDetachment: false, Conditions: nil, Zone: atk.ZoneEngaged,
+	}
+	scarred := fighter
+	scarred.HP = 2
+	scarred.MaxHP = 9
+	scarred.Scars = []Scar{
+		{Kind: ScarBrokenLimb, Detail: "Arm"},
+		{Kind: ScarDoomed, Detail: ""},
+	}
+
+	tests := []struct {
+		name     string
+		creature Creature
+	}{
+		{name: "Fighter", creature: fighter},
+		{
+			name: "Wizard",
+			creature: Creature{
+				ID: "player-0", Name: "Merlin",
+				Attacks: []atk.Attack{
+					{
+						Name: "Staff", TargetCharacteristic: atk.STR,
+						Dice: dice.D6, DiceCnt: 1, Charges: -1,
+						IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitBulky,
+						PairedWith: 0, Inflicts: noCondition, Recharge: nil,
+						DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+						Area: atk.AreaZone, MaxTargets: 0,
+					},
+				},
+				Spellbooks: []spell.Book{
+					{
+						Name: "Fireball",
+						Effect: atk.Attack{
+							Name: "Fireball", TargetCharacteristic: atk.STR,
+							Dice: dice.D8, DiceCnt: 1, Charges: -1,
+							IsBlast: true, Pool: atk.PoolHighest, Traits: atk.TraitMagical,
+							PairedWith: 0, Inflicts: noCondition, Recharge: nil,
+							DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+							Area: atk.AreaZone, MaxTargets: 0,
+						},
+					},
+					{
+						Name: "Fear",
+						Effect: atk.Attack{
+							Name: "Fear", TargetCharacteristic: atk.WIL,
+							Dice: dice.D4, DiceCnt: 1, Charges: -1,
+							IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitMagical,
+							PairedWith: 0, Recharge: nil, DmgMod: 0, MinDmg: 0,
+							Usage: atk.UsageAlways, Area: atk.AreaZone, MaxTargets: 0,
+							Inflicts: atk.Condition{
+								Kind: atk.ConditionFrightened, Rounds: 2,
+							},
+						},
+					},
+				},
+				Conditions: nil, Fatigue: 0,
+				STR: 8, DEX: 12, WIL: 15, HP: 4, MaxHP: 4, Scars: nil, Armor: 0,
+				IsDetachment: false, IsDeprived: false, Supports: nil, Items: nil,
+				Zone: atk.ZoneEngaged,
+			},
+		},
+		{name: "PoisonedCleric", creature: poisoned},
+		{name: "ScarredFighter", creature: scarred},
+		{
+			name: "Dragon",
+			creature: Creature{
+				ID: "monster-0", Name: "Red Dragon",
+				Attacks: []atk.Attack{
+					{
+						Name: "Claws", TargetCharacteristic: atk.STR,
+						Dice: dice.D8, DiceCnt: 1, Charges: -1,
+						IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+						PairedWith: 0, Inflicts: noCondition, Recharge: nil,
+						DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+						Area: atk.AreaZone, MaxTargets: 0,
+					},
+					{
+						Name: "Fire Breath", TargetCharacteristic: atk.STR,
+						Dice: dice.D12, DiceCnt: 1, Charges: 0,
+						IsBlast: true, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+						PairedWith: 0, Inflicts: noCondition,
+						Recharge: &atk.Recharge{
+							Kind: atk.RechargeOnRoll, Rounds: 0, Dice: dice.D6, Threshold: 5,
+							MaxCharges: 1, Elapsed: 0,
+						},
+						DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+						Area: atk.AreaZone, MaxTargets: 0,
+					},
+					{
+						Name: "Frightful Presence", TargetCharacteristic: atk.WIL,
+						Dice: dice.D6, DiceCnt: 1, Charges: 0,
+						IsBlast: true, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+						PairedWith: 0, Inflicts: noCondition,
+						Recharge: &atk.Recharge{
+							Kind: atk.RechargeOnRest, Rounds: 0, Dice: 0, Threshold: 0,
+							MaxCharges: 1, Elapsed: 0,
+						},
+						DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+						Area: atk.AreaZone, MaxTargets: 0,
+					},
+				},
+				STR: 18, DEX: 12, WIL: 16, HP: 20, MaxHP: 20, Scars: nil, Armor: 3,
+				IsDetachment: false, Conditions: nil,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil, Zone: atk.ZoneEngaged,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data, err := json.Marshal(&test.creature)
+			if err != nil {
+				t.Fatalf("json.Marshal() error = %v", err)
+			}
+
+			var decoded Creature
+			if err := json.Unmarshal(data, &decoded); err != nil {
+				t.Fatalf("json.Unmarshal(%s) error = %v", data, err)
+			}
+			if !decoded.Equals(&test.creature) {
+				t.Fatalf(
+					"json.Unmarshal(%s) = %s, want %s",
+					data, decoded.String(), test.creature.String(),
+				)
+			}
+		})
+	}
+}
+
+func TestCreatureUnmarshalJSONDefaults(t *testing.T) {
+	data := `{"id": "rat-1", "name": "Rat", "str": 3, "dex": 12, "wil": 4, ` +
+		`"hp": 1, "attacks": [{"name": "Bite", "dice": "d4"}]}`
+	var got Creature
+	if err := json.Unmarshal([]byte(data), &got); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+
+	if got.Zone != atk.ZoneEngaged {
+		t.Errorf("json.Unmarshal(): want zone Engaged, got %s", got.Zone)
+	}
+	if len(got.Attacks) != 1 || got.Attacks[0].Charges != -1 {
+		t.Errorf("json.Unmarshal(): want 1 unlimited attack, got %v", got.Attacks)
+	}
+	if got.MaxHP != got.HP {
+		t.Errorf("json.Unmarshal(): want max HP %d, got %d", got.HP, got.MaxHP)
+	}
+	if got.Spellbooks != nil || got.Items != nil || got.Conditions != nil ||
+		got.Scars != nil {
+		t.Errorf(
+			"json.Unmarshal(): want no spellbooks, items, conditions and scars",
+		)
+	}
+	if err := got.Validate(); err != nil {
+		t.Errorf("json.Unmarshal(): want valid creature, got %v", err)
+	}
+}
+
+func TestCreatureMarshalJSONErrors(t *testing.T) {
+	fighter := Creature{
+		ID: "player-0", Name: "Lancelot",
+		Attacks: nil,
+		Items: []item.Item{
+			{
+				Name: "Longsword", Kind: item.KindWeapon, Armor: 0,
+				Weapon: &atk.Attack{
+					Name: "Longsword", TargetCharacteristic: atk.STR,
+					Dice: dice.D10, DiceCnt: 1, Charges: -1,
+					IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitBulky,
+					PairedWith: 0,
+					Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+					Recharge:   nil, DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+					Area: atk.AreaZone, MaxTargets: 0,
+				},
+				IsBulky: true, IsEquipped: true, AttackIdx: 0,
+			},
+			{
+				Name: "Dagger", Kind: item.KindWeapon, Armor: 0,
+				Weapon: &atk.Attack{
+					Name: "Dagger", TargetCharacteristic: atk.STR,
+					Dice: dice.D6, DiceCnt: 1, Charges: -1,
+					IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+					PairedWith: 0,
+					Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+					Recharge:   nil, DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+					Area: atk.AreaZone, MaxTargets: 0,
+				},
+				IsBulky: false, IsEquipped: false, AttackIdx: 0,
+			},
+			{
+				Name: "Brigandine", Weapon: nil, Kind: item.KindArmor, Armor: 1,
+				IsBulky: false, IsEquipped: true, AttackIdx: 0,
+			},
+			{
+				Name: "Shield", Weapon: nil, Kind: item.KindShield, Armor: 1,
+				IsBulky: false, IsEquipped: true, AttackIdx: 0,
+			},
+			{
+				Name: "Helmet", Weapon: nil, Kind: item.KindHelmet, Armor: 1,
+				IsBulky: false, IsEquipped: true, AttackIdx: 0,
+			},
+			{
+				Name: "Rope", Weapon: nil, Kind: item.KindGear, Armor: 0,
+				IsBulky: false, IsEquipped: false, AttackIdx: 0,
+			},
+		},
+		STR: 14, DEX: 10, WIL: 9, HP: 5, MaxHP: 5, Scars: nil, Armor: 0,
+		Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+		IsDetachment: false, Conditions: nil, Zone: atk.ZoneEngaged,
+	}
+	fighter.Zone = atk.Zone(42)
+	fighter.Items[1].Weapon.Dice = dice.Dice(7)
+	fighter.Conditions = []atk.Condition{{Kind: atk.ConditionKind(42), Rounds: 1}}
+	fighter.Scars = []Scar{{Kind: ScarKind(42), Detail: ""}}
+
+	_, err := fighter.MarshalJSON()
+	want := []string{
+		"zone", "items[1].weapon.dice", "conditions[0].kind", "scars[0].kind",
+	}
+	if got := fieldPaths(err); !slices.Equal(got, want) {
+		t.Fatalf("Creature.MarshalJSON() error = %v, want paths %v", err, want)
+	}
+}
+
+func TestCreatureUnmarshalJSONErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		data      string
+		wantPaths []string
+	}{
+		{
+			name:      "UnknownZone",
+			data:      `{"name": "Rat", "zone": "Behind"}`,
+			wantPaths: []string{"zone"},
+		},
+		{
+			name:      "WrongType",
+			data:      `{"name": "Rat", "hp": -1}`,
+			wantPaths: []string{"hp"},
+		},
+		{
+			name:      "UnknownField",
+			data:      `{"name": "Rat", "morale": 7}`,
+			wantPaths: []string{"morale"},
+		},
+		{
+			name: "NestedFields",
+			data: `{"name": "Rat", "attacks": [` +
+				`{"name": "Bite", "dice": "d4"}, ` +
+				`{"name": "Claws", "dice": "d3", "target": "CHA"}], ` +
+				`"spellbooks": [{"name": "Fear", "effect": {"dice": "d4", ` +
+				`"inflicts": {"kind": "Scared", "rounds": 2}}}], ` +
+				`"supports": [{"name": "Lick", "kind": "Heal", "charges": "lots"}], ` +
+				`"items": [{"name": "Cheese", "kind": "Food"}], ` +
+				`"conditions": [{"kind": "Poisoned", "turns": 1}], ` +
+				`"scars": [{"kind": "Doomed"}, {"kind": "Bruised"}]}`,
+			wantPaths: []string{
+				"attacks[1].target", "attacks[1].dice",
+				"spellbooks[0].effect.inflicts.kind",
+				"supports[0].charges",
+				"items[0].kind",
+				"conditions[0].turns",
+				"scars[1].kind",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var got Creature
+			err := got.UnmarshalJSON([]byte(test.data))
+			if paths := fieldPaths(err); !slices.Equal(paths, test.wantPaths) {
+				t.Fatalf(
+					"Creature.UnmarshalJSON() error = %v, want paths %v",
+					err, test.wantPaths,
+				)
+			}
+		})
+	}
+}
+
+func TestDecodeJSON(t *testing.T) {
+	tests := []struct {
+		name      string
+		data      string
+		wantPaths []string
+	}{
+		{
+			name: "Valid",
+			data: `{"id": "goblin", "name": "Goblin", "str": 8, "dex": 14, ` +
+				`"wil": 8, "hp": 3, "attacks": [{"name": "Spear", "dice": "d6"}]}`,
+			wantPaths: nil,
+		},
+		{
+			name: "DecodeErrors",
+			data: `{"id": "goblin", "name": "Goblin", "str": 8, "dex": 14, ` +
+				`"wil": 8, "hp": 3, "attacks": [{"name": "Spear", "dice": "d7"}]}`,
+			wantPaths: []string{"attacks[0].dice"},
+		},
+		{
+			name: "ValidationErrors",
+			data: `{"attacks": [{"dice": "d6", "diceCnt": 0}]}`,
+			wantPaths: []string{
+				"id", "name", "attacks[0].name", "attacks[0].diceCnt",
+				"str", "dex", "wil", "hp",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := DecodeJSON([]byte(test.data))
+			if paths := fieldPaths(err); !slices.Equal(paths, test.wantPaths) {
+				t.Fatalf(
+					"DecodeJSON() error = %v, want paths %v", err, test.wantPaths,
+				)
+			}
+			if err == nil && got.Name != "Goblin" {
+				t.Fatalf("DecodeJSON() = %v, want the goblin", &got)
+			}
+		})
+	}
+}
+
+// fieldPaths returns the paths of the field errors joined in err, joined
+// errors are flattened.
+func fieldPaths(err error) []string {
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		var result []string
+		for _, err := range joined.Unwrap() {
+			result = append(result, fieldPaths(err)...)
+		}
+		return result
+	}
+
+	var fieldErr *codec.FieldError
+	if errors.As(err, &fieldErr) {
+		return []string{fieldErr.Path}
+	}
+	return nil
+}
@@ -0,0 +1,34 @@
+package creat
+
+import (
+	"github.com/rozag/cabasi/atk"
+	"github.com/rozag/cabasi/dice"
+)
+
+// TickRecharges advances the recharges of all the Creature's attacks by
+// a round. RNG is used for the attacks recharging on a die roll.
+func (c *Creature) TickRecharges(rng dice.RNG) {
+	for i := range c.Attacks {
+		attack := &c.Attacks[i]
+		isRolled := attack.IsRecharging() &&
+			attack.Recharge.Kind == atk.RechargeOnRoll
+		if isRolled {
+			dice.Announcef(rng, "%s's %s recharge", c.Name, attack.Name)
+		}
+		attack.TickRecharge(rng)
+		if isRolled {
+			dice.Unannounce(rng)
+		}
+	}
+}
+
+// IsRecharging checks if any of the Creature's attacks regains charges during
+// the battle.
+func (c *Creature) IsRecharging() bool {
+	for i := range c.Attacks {
+		if c.Attacks[i].IsRecharging() {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,137 @@
+package creat
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/rozag/cabasi/atk"
+	"github.com/rozag/cabasi/dice"
+	"github.com/rozag/cabasi/dice/dicetest"
+)
+
+func TestCreatureTickRecharges(t *testing.T) {
+	noCondition := atk.Condition{Kind: atk.ConditionNone, Rounds: 0}
+	dragon := Creature{
+		ID: "monster-0", Name: "Red Dragon",
+		Attacks: []atk.Attack{
+			{
+				Name: "Claws", TargetCharacteristic: atk.STR,
+				Dice: dice.D8, DiceCnt: 1, Charges: -1,
+				IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+				PairedWith: 0, Inflicts: noCondition, Recharge: nil,
+				DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+				Area: atk.AreaZone, MaxTargets: 0,
+			},
+			{
+				Name: "Fire Breath", TargetCharacteristic: atk.STR,
+				Dice: dice.D12, DiceCnt: 1, Charges: 0,
+				IsBlast: true, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+				PairedWith: 0, Inflicts: noCondition,
+				Recharge: &atk.Recharge{
+					Kind: atk.RechargeOnRoll, Rounds: 0, Dice: dice.D6, Threshold: 5,
+					MaxCharges: 1, Elapsed: 0,
+				},
+				DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+				Area: atk.AreaZone, MaxTargets: 0,
+			},
+			{
+				Name: "Frightful Presence", TargetCharacteristic: atk.WIL,
+				Dice: dice.D6, DiceCnt: 1, Charges: 0,
+				IsBlast: true, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+				PairedWith: 0, Inflicts: noCondition,
+				Recharge: &atk.Recharge{
+					Kind: atk.RechargeOnRest, Rounds: 0, Dice: 0, Threshold: 0,
+					MaxCharges: 1, Elapsed: 0,
+				},
+				DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+				Area: atk.AreaZone, MaxTargets: 0,
+			},
+		},
+		STR: 18, DEX: 12, WIL: 16, HP: 20, MaxHP: 20, Scars: nil, Armor: 3,
+		IsDetachment: false, Conditions: nil,
+		Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil, Items: nil,
+		Zone: atk.ZoneEngaged,
+	}
+	rng := &announcingRNG{Min: dicetest.Min{}, what: nil}
+
+	dragon.TickRecharges(rng)
+	if dragon.Attacks[1].Charges != 0 {
+		t.Errorf(
+			"Creature.TickRecharges(): want 0 charges after a failed roll, got %d",
+			dragon.Attacks[1].Charges,
+		)
+	}
+
+	dragon.TickRecharges(dicetest.NewSequence(t, 6))
+	if dragon.Attacks[1].Charges != 1 {
+		t.Errorf(
+			"Creature.TickRecharges(): want 1 charge after a successful roll, got %d",
+			dragon.Attacks[1].Charges,
+		)
+	}
+	if dragon.Attacks[2].Charges != 0 {
+		t.Errorf(
+			"Creature.TickRecharges(): want 0 charges recharged on rest only, got %d",
+			dragon.Attacks[2].Charges,
+		)
+	}
+
+	want := []string{"Red Dragon's Fire Breath recharge", ""}
+	if !slices.Equal(rng.what, want) {
+		t.Errorf(
+			"Creature.TickRecharges(): want announcements %q, got %q",
+			want, rng.what,
+		)
+	}
+}
+
+func TestCreatureIsRecharging(t *testing.T) {
+	breath := atk.Attack{
+		Name: "Fire Breath", TargetCharacteristic: atk.STR,
+		Dice: dice.D12, DiceCnt: 1, Charges: 0,
+		IsBlast: true, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+		PairedWith: 0, Inflicts: atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+		Recharge: &atk.Recharge{
+			Kind: atk.RechargeOnRoll, Rounds: 0, Dice: dice.D6, Threshold: 5,
+			MaxCharges: 1, Elapsed: 0,
+		},
+		DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+		Area: atk.AreaZone, MaxTargets: 0,
+	}
+	charged := breath
+	charged.Charges = 1
+	onRest := breath
+	onRest.Recharge = &atk.Recharge{
+		Kind: atk.RechargeOnRest, Rounds: 0, Dice: 0, Threshold: 0,
+		MaxCharges: 1, Elapsed: 0,
+	}
+	tests := []struct {
+		name    string
+		attacks []atk.Attack
+		want    bool
+	}{
+		{name: "NoAttacks", attacks: nil, want: false},
+		{name: "Recharging", attacks: []atk.Attack{breath}, want: true},
+		{name: "FullyCharged", attacks: []atk.Attack{charged}, want: false},
+		{name: "RechargedOnRestOnly", attacks: []atk.Attack{onRest}, want: false},
+		{
+			name:    "AnyRecharging",
+			attacks: []atk.Attack{charged, onRest, breath},
+			want:    true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			creature := Creature{
+				ID: "monster-0", Name: "Red Dragon", Attacks: test.attacks,
+				STR: 18, DEX: 12, WIL: 16, HP: 20, MaxHP: 20, Scars: nil, Armor: 3,
+				IsDetachment: false, Conditions: nil,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil, Zone: atk.ZoneEngaged,
+			}
+			if got := creature.IsRecharging(); got != test.want {
+				t.Fatalf("Creature.IsRecharging() = %t, want %t", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,20 @@
+package creat
+
+// Rest makes the Creature take a full rest: all its recharging attacks regain
+// their charges. A creature that isn't deprived also gets rid of all its
+// Fatigue and recovers its HP to MaxHP unless it's still overloaded, while a
+// deprived one can't recover at all until its needs are met.
+func (c *Creature) Rest() {
+	for i := range c.Attacks {
+		c.Attacks[i].Rest()
+	}
+
+	if c.IsDeprived {
+		return
+	}
+	c.Fatigue = 0
+	c.HP = c.EffectiveMaxHP()
+	if c.IsOverloaded() {
+		c.HP = 0
+	}
+}
@@ -0,0 +1,172 @@
+package creat
+
+import (
+	"testing"
+
+	"github.com/rozag/cabasi/atk"
+	"github.com/rozag/cabasi/dice"
+	"github.com/rozag/cabasi/item"
+)
+
+func TestCreatureRest(t *testing.T) {
+	noCondition := atk.Condition{Kind: atk.ConditionNone, Rounds: 0}
+	dragon := Creature{
+		ID: "monster-0", Name: "Red Dragon",
+		Attacks: []atk.Attack{
+			{
+				Name: "Claws", TargetCharacteristic: atk.STR,
+				Dice: dice.D8, DiceCnt: 1, Charges: -1,
+				IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+				PairedWith: 0, Inflicts: noCondition, Recharge: nil,
+				DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+				Area: atk.AreaZone, MaxTargets: 0,
+			},
+			{
+				Name: "Fire Breath", TargetCharacteristic: atk.STR,
+				Dice: dice.D12, DiceCnt: 1, Charges: 0,
+				IsBlast: true, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+				PairedWith: 0, Inflicts: noCondition,
+				Recharge: &atk.Recharge{
+					Kind: atk.RechargeOnRoll, Rounds: 0, Dice: dice.D6, Threshold: 5,
+					MaxCharges: 1, Elapsed: 0,
+				},
+				DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+				Area: atk.AreaZone, MaxTargets: 0,
+			},
+			{
+				Name: "Frightful Presence", TargetCharacteristic: atk.WIL,
+				Dice: dice.D6, DiceCnt: 1, Charges: 0,
+				IsBlast: true, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+				PairedWith: 0, Inflicts: noCondition,
+				Recharge: &atk.Recharge{
+					Kind: atk.RechargeOnRest, Rounds: 0, Dice: 0, Threshold: 0,
+					MaxCharges: 1, Elapsed: 0,
+				},
+				DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+				Area: atk.AreaZone, MaxTargets: 0,
+			},
+		},
+		STR: 18, DEX: 12, WIL: 16, HP: 20, MaxHP: 20, Scars: nil, Armor: 3,
+		IsDetachment: false, Conditions: nil,
+		Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil, Items: nil,
+		Zone: atk.ZoneEngaged,
+	}
+	tests := []struct {
+		name        string
+		isDeprived  bool
+		wantCharges []int8
+		wantFatigue uint8
+		wantHP      uint8
+	}{
+		{
+			name:        "Rested",
+			isDeprived:  false,
+			wantCharges: []int8{-1, 1, 1},
+			wantFatigue: 0,
+			wantHP:      20,
+		},
+		{
+			name:        "Deprived",
+			isDeprived:  true,
+			wantCharges: []int8{-1, 1, 1},
+			wantFatigue: 2,
+			wantHP:      3,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			creature := dragon.DeepCopy()
+			creature.Fatigue = 2
+			creature.HP = 3
+			creature.IsDeprived = test.isDeprived
+			creature.Rest()
+
+			for i, want := range test.wantCharges {
+				if got := creature.Attacks[i].Charges; got != want {
+					t.Errorf(
+						"Creature.Rest(): want %d charges of %q, got %d",
+						want, creature.Attacks[i].Name, got,
+					)
+				}
+			}
+			if creature.Fatigue != test.wantFatigue {
+				t.Errorf(
+					"Creature.Rest(): want %d Fatigue, got %d",
+					test.wantFatigue, creature.Fatigue,
+				)
+			}
+			if creature.HP != test.wantHP {
+				t.Errorf(
+					"Creature.Rest(): want HP %d, got %d", test.wantHP, creature.HP,
+				)
+			}
+		})
+	}
+}
+
+func TestCreatureRestOverloaded(t *testing.T) {
+	fighter := Creature{
+		ID: "player-0", Name: "Lancelot",
+		Attacks: nil,
+		Items: []item.Item{
+			{
+				Name: "Longsword", Kind: item.KindWeapon, Armor: 0,
+				Weapon: &atk.Attack{
+					Name: "Longsword", TargetCharacteristic: atk.STR,
+					Dice: dice.D10, DiceCnt: 1, Charges: -1,
+					IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitBulky,
+					PairedWith: 0,
+					Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+					Recharge:   nil, DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+					Area: atk.AreaZone, MaxTargets: 0,
+				},
+				IsBulky: true, IsEquipped: true, AttackIdx: 0,
+			},
+			{
+				Name: "Dagger", Kind: item.KindWeapon, Armor: 0,
+				Weapon: &atk.Attack{
+					Name: "Dagger", TargetCharacteristic: atk.STR,
+					Dice: dice.D6, DiceCnt: 1, Charges: -1,
+					IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+					PairedWith: 0,
+					Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+					Recharge:   nil, DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+					Area: atk.AreaZone, MaxTargets: 0,
+				},
+				IsBulky: false, IsEquipped: false, AttackIdx: 0,
+			},
+			{
+				Name: "Brigandine", Weapon: nil, Kind: item.KindArmor, Armor: 1,
+				IsBulky: false, IsEquipped: true, AttackIdx: 0,
+			},
+			{
+				Name: "Shield", Weapon: nil, Kind: item.KindShield, Armor: 1,
+				IsBulky: false, IsEquipped: true, AttackIdx: 0,
+			},
+			{
+				Name: "Helmet", Weapon: nil, Kind: item.KindHelmet, Armor: 1,
+				IsBulky: false, IsEquipped: true, AttackIdx: 0,
+			},
+			{
+				Name: "Rope", Weapon: nil, Kind: item.KindGear, Armor: 0,
+				IsBulky: false, IsEquipped: false, AttackIdx: 0,
+			},
+		},
+		STR: 14, DEX: 10, WIL: 9, HP: 5, MaxHP: 5, Scars: nil, Armor: 0,
+		Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+		IsDetachment: false, Conditions: nil, Zone: atk.ZoneEngaged,
+	}
+	for range 3 {
+		fighter.Items = append(fighter.Items, fighter.Items[len(fighter.Items)-1])
+	}
+	fighter.Fatigue = 1
+	fighter.HP = 0
+	fighter.Rest()
+
+	if fighter.Fatigue != 0 || fighter.HP != 0 {
+		t.Errorf(
+			"Creature.Rest(): want 0 Fatigue and HP 0, got %d Fatigue and HP %d",
+			fighter.Fatigue, fighter.HP,
+		)
+	}
+}
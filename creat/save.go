@@ -0,0 +1,153 @@
+package creat
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/rozag/cabasi/atk"
+	"github.com/rozag/cabasi/dice"
+)
+
+// SaveMode represents how many d20s are rolled for a save and which one is
+// kept.
+type SaveMode uint8
+
+const (
+	// SaveNormal rolls a single d20.
+	SaveNormal SaveMode = iota
+	// SaveAdvantage rolls 2 d20s and keeps the lowest one.
+	SaveAdvantage
+	// SaveDisadvantage rolls 2 d20s and keeps the highest one.
+	SaveDisadvantage
+)
+
+// String returns the string representation of the SaveMode.
+func (m SaveMode) String() string {
+	switch m {
+	case SaveNormal:
+		return "Normal"
+	case SaveAdvantage:
+		return "Advantage"
+	case SaveDisadvantage:
+		return "Disadvantage"
+	default:
+		panic(fmt.Errorf("unknown SaveMode: %d", m))
+	}
+}
+
+// SaveOpts holds the optional parameters of a save.
+type SaveOpts struct {
+	// Reason describes what the save is for, e.g. "morale". It's used to
+	// announce the roll and may be empty.
+	Reason string
+	// Mode is the advantage or disadvantage of the save.
+	Mode SaveMode
+	// Modifier is added to the characteristic to get the save target.
+	Modifier int8
+}
+
+// SaveResult is the auditable result of a save.
+type SaveResult struct {
+	Characteristic atk.Characteristic
+	Mode           SaveMode
+	// Rolled is the d20 the save is decided by.
+	Rolled uint8
+	// Discarded is the other d20 rolled with advantage or disadvantage, it's 0
+	// for normal saves.
+	Discarded uint8
+	// Target is the characteristic value with the modifier applied, clamped to
+	// [0,255].
+	Target  uint8
+	Success bool
+}
+
+// String returns the string representation of the SaveResult.
+func (r SaveResult) String() string {
+	return fmt.Sprintf(
+		"SaveResult{"+
+			"Characteristic: %s"+
+			", Mode: %s"+
+			", Rolled: %d"+
+			", Discarded: %d"+
+			", Target: %d"+
+			", Success: %t"+
+			"}",
+		r.Characteristic,
+		r.Mode,
+		r.Rolled,
+		r.Discarded,
+		r.Target,
+		r.Success,
+	)
+}
+
+// Save makes a normal save against the Creature's characteristic: a d20 is
+// rolled and the save succeeds if the roll is less than or equal to the
+// characteristic.
+func (c *Creature) Save(ch atk.Characteristic, rng dice.RNG) SaveResult {
+	return c.SaveWith(
+		ch, rng, SaveOpts{Reason: "", Mode: SaveNormal, Modifier: 0},
+	)
+}
+
+// SaveWith makes a save against the Creature's characteristic with the
+// provided options. It panics if the characteristic or the mode is unknown.
+func (c *Creature) SaveWith(
+	ch atk.Characteristic,
+	rng dice.RNG,
+	opts SaveOpts,
+) SaveResult {
+	var value uint8
+	switch ch {
+	case atk.STR:
+		value = c.STR
+	case atk.DEX:
+		value = c.DEX
+	case atk.WIL:
+		value = c.WIL
+	default:
+		panic(fmt.Errorf("unknown Characteristic: %d", ch))
+	}
+
+	// Suppressing gosec "G115: integer overflow conversion int -> uint8"
+	// because the target is clamped to [0,255] right here.
+	target := uint8( //nolint:gosec
+		min(max(int(value)+int(opts.Modifier), 0), math.MaxUint8),
+	)
+
+	if len(opts.Reason) > 0 {
+		dice.Announcef(rng, "%s's %s %s save", c.Name, opts.Reason, ch)
+	} else {
+		dice.Announcef(rng, "%s's %s save", c.Name, ch)
+	}
+
+	rolled, discarded := dice.D20.Roll(rng), uint8(0)
+	switch opts.Mode {
+	case SaveNormal:
+		// OK
+	case SaveAdvantage:
+		rolled, discarded = minMax(rolled, dice.D20.Roll(rng))
+	case SaveDisadvantage:
+		discarded, rolled = minMax(rolled, dice.D20.Roll(rng))
+	default:
+		panic(fmt.Errorf("unknown SaveMode: %d", opts.Mode))
+	}
+	dice.Unannounce(rng)
+
+	return SaveResult{
+		Characteristic: ch,
+		Mode:           opts.Mode,
+		Rolled:         rolled,
+		Discarded:      discarded,
+		Target:         target,
+		Success:        rolled <= target,
+	}
+}
+
+// minMax returns the provided values in ascending order.
+func minMax(a, b uint8) (uint8, uint8) {
+	if a <= b {
+		return a, b
+	}
+	return b, a
+}
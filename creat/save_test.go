@@ -0,0 +1,174 @@
+package creat
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/rozag/cabasi/atk"
+	"github.com/rozag/cabasi/dice"
+	"github.com/rozag/cabasi/dice/dicetest"
+)
+
+func TestCreatureSaveWith(t *testing.T) {
+	spear := atk.Attack{
+		Name: "Spear", TargetCharacteristic: atk.STR,
+		Dice: dice.D6, DiceCnt: 1, Charges: -1,
+		IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone, PairedWith: 0,
+		Inflicts: atk.Condition{Kind: atk.ConditionNone, Rounds: 0}, Recharge: nil,
+		DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+		Area: atk.AreaZone, MaxTargets: 0,
+	}
+	goblin := Creature{
+		ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
+		STR: 8, DEX: 14, WIL: 5, HP: 4, MaxHP: 4, Scars: nil, Armor: 0,
+		IsDetachment: false, Conditions: nil,
+		Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil, Items: nil,
+		Zone: atk.ZoneEngaged,
+	}
+	tests := []struct {
+		name           string
+		characteristic atk.Characteristic
+		opts           SaveOpts
+		faces          []uint
+		want           SaveResult
+	}{
+		{
+			name:           "NormalSuccessOnEqual",
+			characteristic: atk.STR,
+			opts:           SaveOpts{Reason: "", Mode: SaveNormal, Modifier: 0},
+			faces:          []uint{8},
+			want: SaveResult{
+				Characteristic: atk.STR, Mode: SaveNormal,
+				Rolled: 8, Discarded: 0, Target: 8, Success: true,
+			},
+		},
+		{
+			name:           "NormalFailure",
+			characteristic: atk.DEX,
+			opts:           SaveOpts{Reason: "", Mode: SaveNormal, Modifier: 0},
+			faces:          []uint{15},
+			want: SaveResult{
+				Characteristic: atk.DEX, Mode: SaveNormal,
+				Rolled: 15, Discarded: 0, Target: 14, Success: false,
+			},
+		},
+		{
+			name:           "AdvantageKeepsLowest",
+			characteristic: atk.WIL,
+			opts: SaveOpts{
+				Reason: "morale", Mode: SaveAdvantage, Modifier: 0,
+			},
+			faces: []uint{17, 4},
+			want: SaveResult{
+				Characteristic: atk.WIL, Mode: SaveAdvantage,
+				Rolled: 4, Discarded: 17, Target: 5, Success: true,
+			},
+		},
+		{
+			name:           "DisadvantageKeepsHighest",
+			characteristic: atk.WIL,
+			opts:           SaveOpts{Reason: "", Mode: SaveDisadvantage, Modifier: 0},
+			faces:          []uint{4, 17},
+			want: SaveResult{
+				Characteristic: atk.WIL, Mode: SaveDisadvantage,
+				Rolled: 17, Discarded: 4, Target: 5, Success: false,
+			},
+		},
+		{
+			name:           "PositiveModifier",
+			characteristic: atk.STR,
+			opts:           SaveOpts{Reason: "", Mode: SaveNormal, Modifier: 2},
+			faces:          []uint{10},
+			want: SaveResult{
+				Characteristic: atk.STR, Mode: SaveNormal,
+				Rolled: 10, Discarded: 0, Target: 10, Success: true,
+			},
+		},
+		{
+			name:           "NegativeModifierClampedAtZero",
+			characteristic: atk.WIL,
+			opts:           SaveOpts{Reason: "", Mode: SaveNormal, Modifier: -10},
+			faces:          []uint{1},
+			want: SaveResult{
+				Characteristic: atk.WIL, Mode: SaveNormal,
+				Rolled: 1, Discarded: 0, Target: 0, Success: false,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rng := dicetest.NewSequence(t, test.faces...)
+			got := goblin.SaveWith(test.characteristic, rng, test.opts)
+			if got != test.want {
+				t.Fatalf("Creature.SaveWith(): want %v, got %v", test.want, got)
+			}
+		})
+	}
+}
+
+func TestCreatureSave(t *testing.T) {
+	spear := atk.Attack{
+		Name: "Spear", TargetCharacteristic: atk.STR,
+		Dice: dice.D6, DiceCnt: 1, Charges: -1,
+		IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone, PairedWith: 0,
+		Inflicts: atk.Condition{Kind: atk.ConditionNone, Rounds: 0}, Recharge: nil,
+		DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+		Area: atk.AreaZone, MaxTargets: 0,
+	}
+	goblin := Creature{
+		ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
+		STR: 8, DEX: 14, WIL: 5, HP: 4, MaxHP: 4, Scars: nil, Armor: 0,
+		IsDetachment: false, Conditions: nil,
+		Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil, Items: nil,
+		Zone: atk.ZoneEngaged,
+	}
+
+	got := goblin.Save(atk.STR, dicetest.NewSequence(t, 9))
+	want := SaveResult{
+		Characteristic: atk.STR, Mode: SaveNormal,
+		Rolled: 9, Discarded: 0, Target: 8, Success: false,
+	}
+	if got != want {
+		t.Fatalf("Creature.Save(): want %v, got %v", want, got)
+	}
+}
+
+type announcingRNG struct {
+	dicetest.Min
+	what []string
+}
+
+func (a *announcingRNG) Announce(what string) { a.what = append(a.what, what) }
+
+func TestCreatureSaveAnnounces(t *testing.T) {
+	spear := atk.Attack{
+		Name: "Spear", TargetCharacteristic: atk.STR,
+		Dice: dice.D6, DiceCnt: 1, Charges: -1,
+		IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone, PairedWith: 0,
+		Inflicts: atk.Condition{Kind: atk.ConditionNone, Rounds: 0}, Recharge: nil,
+		DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+		Area: atk.AreaZone, MaxTargets: 0,
+	}
+	goblin := Creature{
+		ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
+		STR: 8, DEX: 14, WIL: 5, HP: 4, MaxHP: 4, Scars: nil, Armor: 0,
+		IsDetachment: false, Conditions: nil,
+		Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil, Items: nil,
+		Zone: atk.ZoneEngaged,
+	}
+	rng := &announcingRNG{Min: dicetest.Min{}, what: nil}
+
+	_ = goblin.Save(atk.DEX, rng)
+	_ = goblin.SaveWith(
+		atk.WIL, rng, SaveOpts{Reason: "morale", Mode: SaveNormal, Modifier: 0},
+	)
+
+	want := []string{
+		"Root Goblin's DEX save", "", "Root Goblin's morale WIL save", "",
+	}
+	if !slices.Equal(rng.what, want) {
+		t.Fatalf(
+			"Creature.SaveWith(): want announcements %q, got %q", want, rng.what,
+		)
+	}
+}
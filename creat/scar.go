@@ -0,0 +1,258 @@
+package creat
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/rozag/cabasi/atk"
+	"github.com/rozag/cabasi/dice"
+	"github.com/rozag/cabasi/internal/codec"
+)
+
+const (
+	// scarHPDiceCnt is the number of d6s rolled for max HP by the severe
+	// scars.
+	scarHPDiceCnt = 2
+	// scarCharacteristicDiceCnt is the number of d6s rolled for a
+	// characteristic by the scars that reroll it.
+	scarCharacteristicDiceCnt = 3
+)
+
+// ScarKind represents a lasting injury from the scars table, a creature gains
+// one when its HP hits exactly 0.
+type ScarKind uint8
+
+const (
+	// ScarLasting leaves a visible scar, max HP is rerolled with d6.
+	ScarLasting ScarKind = iota
+	// ScarRattlingBlow disorients the creature, max HP is rerolled with d6.
+	ScarRattlingBlow
+	// ScarWalloped sends the creature flying, it's deprived and gains d6 max
+	// HP.
+	ScarWalloped
+	// ScarBrokenLimb breaks a limb, max HP is rerolled with 2d6.
+	ScarBrokenLimb
+	// ScarDiseased afflicts the creature with an infection, max HP is rerolled
+	// with 2d6.
+	ScarDiseased
+	// ScarHeadWound reorients the creature, a random characteristic is
+	// rerolled with 3d6.
+	ScarHeadWound
+	// ScarHamstrung leaves the creature barely moving, DEX is rerolled with
+	// 3d6.
+	ScarHamstrung
+	// ScarDeafened makes the creature deaf, it gains d4 WIL on a WIL save.
+	ScarDeafened
+	// ScarRebrained knocks a part of the creature's psyche loose, WIL is
+	// rerolled with 3d6.
+	ScarRebrained
+	// ScarSunderedAppendage takes an appendage away, STR is rerolled with 3d6.
+	ScarSunderedAppendage
+	// ScarMortalWound leaves the creature deprived and out of action, max HP is
+	// set to a 2d6 roll.
+	ScarMortalWound
+	// ScarDoomed has no immediate effect, death came close but the creature
+	// survived.
+	ScarDoomed
+)
+
+// String returns the string representation of the ScarKind.
+func (k ScarKind) String() string {
+	switch k {
+	case ScarLasting:
+		return "Lasting"
+	case ScarRattlingBlow:
+		return "RattlingBlow"
+	case ScarWalloped:
+		return "Walloped"
+	case ScarBrokenLimb:
+		return "BrokenLimb"
+	case ScarDiseased:
+		return "Diseased"
+	case ScarHeadWound:
+		return "HeadWound"
+	case ScarHamstrung:
+		return "Hamstrung"
+	case ScarDeafened:
+		return "Deafened"
+	case ScarRebrained:
+		return "Rebrained"
+	case ScarSunderedAppendage:
+		return "SunderedAppendage"
+	case ScarMortalWound:
+		return "MortalWound"
+	case ScarDoomed:
+		return "Doomed"
+	default:
+		panic(fmt.Errorf("unknown ScarKind: %d", k))
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler, the ScarKind is encoded as
+// its string representation, e.g. "BrokenLimb".
+func (k ScarKind) MarshalText() ([]byte, error) {
+	return codec.MarshalText("scar kind", k, scarKinds()...)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, it decodes a ScarKind
+// encoded as its string representation, case-insensitive.
+func (k *ScarKind) UnmarshalText(text []byte) error {
+	value, err := codec.UnmarshalText("scar kind", text, scarKinds()...)
+	if err != nil {
+		return err
+	}
+	*k = value
+	return nil
+}
+
+// Scar is a lasting injury of a creature. Detail is the body part or the
+// characteristic the scar is about, it's empty for the kinds with no detail.
+type Scar struct {
+	Detail string
+	Kind   ScarKind
+}
+
+// String returns the string representation of the Scar.
+func (s Scar) String() string {
+	return fmt.Sprintf("Scar{Kind: %s, Detail: %q}", s.Kind, s.Detail)
+}
+
+// GainScar rolls a d12 on the scars table and applies the scar to the
+// Creature right away: max HP, characteristics, and deprivation change as the
+// scar says, and the scar is recorded in Scars. A rerolled max HP or
+// characteristic is taken only if it's higher than the current one, except
+// for the mortal wound which sets max HP to the roll. RNG is used for the
+// table roll and the rolls the scar asks for.
+func (c *Creature) GainScar(rng dice.RNG) Scar {
+	dice.Announcef(rng, "%s's scar", c.Name)
+	// Suppressing gosec "G115: integer overflow conversion uint8 -> ScarKind"
+	// because the d12 roll minus 1 is in the range of the known scar kinds.
+	kind := ScarKind(dice.D12.Roll(rng) - 1) //nolint:gosec
+	dice.Unannounce(rng)
+	scar := Scar{Detail: "", Kind: kind}
+
+	switch kind {
+	case ScarLasting:
+		scar.Detail = rollDetail(c, rng, "scar", scarredParts())
+		c.rerollMaxHP(rng, 1)
+	case ScarRattlingBlow:
+		c.rerollMaxHP(rng, 1)
+	case ScarWalloped:
+		c.IsDeprived = true
+		dice.Announcef(rng, "%s's max HP gain", c.Name)
+		c.MaxHP += min(dice.D6.Roll(rng), math.MaxUint8-c.MaxHP)
+		dice.Unannounce(rng)
+	case ScarBrokenLimb:
+		scar.Detail = rollDetail(c, rng, "broken limb", brokenLimbs())
+		c.rerollMaxHP(rng, scarHPDiceCnt)
+	case ScarDiseased:
+		c.rerollMaxHP(rng, scarHPDiceCnt)
+	case ScarHeadWound:
+		ch := rollDetail(c, rng, "head wound", reorientedCharacteristics())
+		scar.Detail = ch.String()
+		c.rerollCharacteristic(rng, ch)
+	case ScarHamstrung:
+		scar.Detail = atk.DEX.String()
+		c.rerollCharacteristic(rng, atk.DEX)
+	case ScarDeafened:
+		if c.Save(atk.WIL, rng).Success {
+			dice.Announcef(rng, "%s's WIL gain", c.Name)
+			c.WIL = min(c.WIL+dice.D4.Roll(rng), CharacteristicMax)
+			dice.Unannounce(rng)
+		}
+	case ScarRebrained:
+		scar.Detail = atk.WIL.String()
+		c.rerollCharacteristic(rng, atk.WIL)
+	case ScarSunderedAppendage:
+		scar.Detail = rollDetail(c, rng, "lost appendage", sunderedAppendages())
+		c.rerollCharacteristic(rng, atk.STR)
+	case ScarMortalWound:
+		c.IsDeprived = true
+		dice.Announcef(rng, "%s's max HP", c.Name)
+		c.MaxHP = atk.PoolSum.Roll(dice.D6, scarHPDiceCnt, rng)
+		dice.Unannounce(rng)
+		c.HP = min(c.HP, c.MaxHP)
+	case ScarDoomed:
+		// No immediate effect.
+	default:
+		panic(fmt.Errorf("unknown ScarKind: %d", kind))
+	}
+
+	c.Scars = append(c.Scars, scar)
+	return scar
+}
+
+// rollDetail rolls a d6 to pick the scar's detail from the 6 options.
+func rollDetail[T any](
+	c *Creature, rng dice.RNG, what string, options [6]T,
+) T {
+	dice.Announcef(rng, "%s's %s", c.Name, what)
+	roll := dice.D6.Roll(rng)
+	dice.Unannounce(rng)
+	return options[roll-1]
+}
+
+// rerollMaxHP rolls cnt d6s and takes the sum as the new max HP if it's
+// higher than the current one.
+func (c *Creature) rerollMaxHP(rng dice.RNG, cnt uint8) {
+	dice.Announcef(rng, "%s's max HP", c.Name)
+	c.MaxHP = max(c.MaxHP, atk.PoolSum.Roll(dice.D6, cnt, rng))
+	dice.Unannounce(rng)
+}
+
+// rerollCharacteristic rolls 3d6 and takes the sum as the new characteristic
+// if it's higher than the current one. It panics if the characteristic is
+// unknown.
+func (c *Creature) rerollCharacteristic(
+	rng dice.RNG, ch atk.Characteristic,
+) {
+	var value *uint8
+	switch ch {
+	case atk.STR:
+		value = &c.STR
+	case atk.DEX:
+		value = &c.DEX
+	case atk.WIL:
+		value = &c.WIL
+	default:
+		panic(fmt.Errorf("unknown Characteristic: %d", ch))
+	}
+
+	dice.Announcef(rng, "%s's %s", c.Name, ch)
+	*value = max(
+		*value, atk.PoolSum.Roll(dice.D6, scarCharacteristicDiceCnt, rng),
+	)
+	dice.Unannounce(rng)
+}
+
+// scarKinds returns all the known scar kinds.
+func scarKinds() []ScarKind {
+	return []ScarKind{
+		ScarLasting, ScarRattlingBlow, ScarWalloped, ScarBrokenLimb,
+		ScarDiseased, ScarHeadWound, ScarHamstrung, ScarDeafened, ScarRebrained,
+		ScarSunderedAppendage, ScarMortalWound, ScarDoomed,
+	}
+}
+
+// scarredParts returns the body parts a lasting scar is left on by d6.
+func scarredParts() [6]string {
+	return [6]string{"Neck", "Hands", "Eye", "Chest", "Legs", "Ear"}
+}
+
+// brokenLimbs returns the limbs broken by d6.
+func brokenLimbs() [6]string {
+	return [6]string{"Leg", "Leg", "Arm", "Arm", "Rib", "Skull"}
+}
+
+// reorientedCharacteristics returns the characteristics rerolled after a head
+// wound by d6.
+func reorientedCharacteristics() [6]atk.Characteristic {
+	return [6]atk.Characteristic{
+		atk.STR, atk.STR, atk.DEX, atk.DEX, atk.WIL, atk.WIL,
+	}
+}
+
+// sunderedAppendages returns the appendages lost by d6.
+func sunderedAppendages() [6]string {
+	return [6]string{"Fingers", "Fingers", "Ear", "Ear", "Eye", "Hand"}
+}
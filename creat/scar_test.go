@@ -0,0 +1,298 @@
+package creat
+
+import (
+	"testing"
+
+	"github.com/rozag/cabasi/atk"
+	"github.com/rozag/cabasi/dice"
+	"github.com/rozag/cabasi/dice/dicetest"
+	"github.com/rozag/cabasi/item"
+)
+
+func TestCreatureGainScar(t *testing.T) {
+	spear := atk.Attack{
+		Name: "Spear", TargetCharacteristic: atk.STR,
+		Dice: dice.D6, DiceCnt: 1, Charges: -1,
+		IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone, PairedWith: 0,
+		Inflicts: atk.Condition{Kind: atk.ConditionNone, Rounds: 0}, Recharge: nil,
+		DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+		Area: atk.AreaZone, MaxTargets: 0,
+	}
+	goblin := Creature{
+		ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
+		STR: 8, DEX: 14, WIL: 5, HP: 0, MaxHP: 4, Scars: nil, Armor: 0,
+		IsDetachment: false, Conditions: nil,
+		Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil, Items: nil,
+		Zone: atk.ZoneEngaged,
+	}
+	tests := []struct {
+		name   string
+		faces  []uint
+		modify func(c *Creature)
+		want   Scar
+	}{
+		{
+			name:   "LastingRaisesMaxHP",
+			faces:  []uint{1, 3, 6},
+			modify: func(c *Creature) { c.MaxHP = 6 },
+			want:   Scar{Kind: ScarLasting, Detail: "Eye"},
+		},
+		{
+			name:   "LastingKeepsHigherMaxHP",
+			faces:  []uint{1, 1, 2},
+			modify: func(*Creature) {},
+			want:   Scar{Kind: ScarLasting, Detail: "Neck"},
+		},
+		{
+			name:   "RattlingBlow",
+			faces:  []uint{2, 5},
+			modify: func(c *Creature) { c.MaxHP = 5 },
+			want:   Scar{Kind: ScarRattlingBlow, Detail: ""},
+		},
+		{
+			name:  "Walloped",
+			faces: []uint{3, 4},
+			modify: func(c *Creature) {
+				c.MaxHP = 8
+				c.IsDeprived = true
+			},
+			want: Scar{Kind: ScarWalloped, Detail: ""},
+		},
+		{
+			name:   "BrokenLimb",
+			faces:  []uint{4, 3, 6, 5},
+			modify: func(c *Creature) { c.MaxHP = 11 },
+			want:   Scar{Kind: ScarBrokenLimb, Detail: "Arm"},
+		},
+		{
+			name:   "DiseasedKeepsHigherMaxHP",
+			faces:  []uint{5, 1, 2},
+			modify: func(*Creature) {},
+			want:   Scar{Kind: ScarDiseased, Detail: ""},
+		},
+		{
+			name:   "HeadWound",
+			faces:  []uint{6, 6, 6, 6, 6},
+			modify: func(c *Creature) { c.WIL = 18 },
+			want:   Scar{Kind: ScarHeadWound, Detail: "WIL"},
+		},
+		{
+			name:   "HamstrungKeepsHigherDEX",
+			faces:  []uint{7, 1, 1, 1},
+			modify: func(*Creature) {},
+			want:   Scar{Kind: ScarHamstrung, Detail: "DEX"},
+		},
+		{
+			name:   "DeafenedSuccessfulSave",
+			faces:  []uint{8, 5, 4},
+			modify: func(c *Creature) { c.WIL = 9 },
+			want:   Scar{Kind: ScarDeafened, Detail: ""},
+		},
+		{
+			name:   "DeafenedFailedSave",
+			faces:  []uint{8, 6},
+			modify: func(*Creature) {},
+			want:   Scar{Kind: ScarDeafened, Detail: ""},
+		},
+		{
+			name:   "Rebrained",
+			faces:  []uint{9, 3, 3, 3},
+			modify: func(c *Creature) { c.WIL = 9 },
+			want:   Scar{Kind: ScarRebrained, Detail: "WIL"},
+		},
+		{
+			name:   "SunderedAppendage",
+			faces:  []uint{10, 6, 6, 6, 5},
+			modify: func(c *Creature) { c.STR = 17 },
+			want:   Scar{Kind: ScarSunderedAppendage, Detail: "Hand"},
+		},
+		{
+			name:  "MortalWoundSetsMaxHP",
+			faces: []uint{11, 1, 2},
+			modify: func(c *Creature) {
+				c.MaxHP = 3
+				c.IsDeprived = true
+			},
+			want: Scar{Kind: ScarMortalWound, Detail: ""},
+		},
+		{
+			name:   "Doomed",
+			faces:  []uint{12},
+			modify: func(*Creature) {},
+			want:   Scar{Kind: ScarDoomed, Detail: ""},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := goblin.DeepCopy()
+			scar := got.GainScar(dicetest.NewSequence(t, test.faces...))
+			if scar != test.want {
+				t.Errorf("Creature.GainScar() = %s, want %s", scar, test.want)
+			}
+
+			want := goblin.DeepCopy()
+			test.modify(&want)
+			want.Scars = []Scar{test.want}
+			if !got.Equals(&want) {
+				t.Fatalf(
+					"Creature.GainScar(): creature = %s, want %s",
+					got.String(), want.String(),
+				)
+			}
+		})
+	}
+}
+
+func TestCreatureGainScarCapsWIL(t *testing.T) {
+	goblin := Creature{
+		ID: "monster-0", Name: "Root Goblin", Attacks: nil,
+		STR: 8, DEX: 14, WIL: CharacteristicMax - 1, HP: 0, MaxHP: 4,
+		Scars: nil, Armor: 0, IsDetachment: false, Conditions: nil,
+		Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+		Items: nil, Zone: atk.ZoneEngaged,
+	}
+	goblin.GainScar(dicetest.NewSequence(t, 8, 1, 4))
+	if goblin.WIL != CharacteristicMax {
+		t.Fatalf(
+			"Creature.GainScar(): want WIL %d, got %d",
+			CharacteristicMax, goblin.WIL,
+		)
+	}
+}
+
+func TestCreatureGainScarKeepsScars(t *testing.T) {
+	fighter := Creature{
+		ID: "player-0", Name: "Lancelot",
+		Attacks: nil,
+		Items: []item.Item{
+			{
+				Name: "Longsword", Kind: item.KindWeapon, Armor: 0,
+				Weapon: &atk.Attack{
+					Name: "Longsword", TargetCharacteristic: atk.STR,
+					Dice: dice.D10, DiceCnt: 1, Charges: -1,
+					IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitBulky,
+					PairedWith: 0,
+					Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+					Recharge:   nil, DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+					Area: atk.AreaZone, MaxTargets: 0,
+				},
+				IsBulky: true, IsEquipped: true, AttackIdx: 0,
+			},
+			{
+				Name: "Dagger", Kind: item.KindWeapon, Armor: 0,
+				Weapon: &atk.Attack{
+					Name: "Dagger", TargetCharacteristic: atk.STR,
+					Dice: dice.D6, DiceCnt: 1, Charges: -1,
+					IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+					PairedWith: 0,
+					Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+					Recharge:   nil, DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+					Area: atk.AreaZone, MaxTargets: 0,
+				},
+				IsBulky: false, IsEquipped: false, AttackIdx: 0,
+			},
+			{
+				Name: "Brigandine", Weapon: nil, Kind: item.KindArmor, Armor: 1,
+				IsBulky: false, IsEquipped: true, AttackIdx: 0,
+			},
+			{
+				Name: "Shield", Weapon: nil, Kind: item.KindShield, Armor: 1,
+				IsBulky: false, IsEquipped: true, AttackIdx: 0,
+			},
+			{
+				Name: "Helmet", Weapon: nil, Kind: item.KindHelmet, Armor: 1,
+				IsBulky: false, IsEquipped: true, AttackIdx: 0,
+			},
+			{
+				Name: "Rope", Weapon: nil, Kind: item.KindGear, Armor: 0,
+				IsBulky: false, IsEquipped: false, AttackIdx: 0,
+			},
+		},
+		STR: 14, DEX: 10, WIL: 9, HP: 5, MaxHP: 5, Scars: nil, Armor: 0,
+		Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+		IsDetachment: false, Conditions: nil, Zone: atk.ZoneEngaged,
+	}
+	fighter.GainScar(dicetest.NewSequence(t, 12))
+	fighter.GainScar(dicetest.NewSequence(t, 2, 1))
+
+	want := []Scar{
+		{Kind: ScarDoomed, Detail: ""},
+		{Kind: ScarRattlingBlow, Detail: ""},
+	}
+	if len(fighter.Scars) != len(want) ||
+		fighter.Scars[0] != want[0] || fighter.Scars[1] != want[1] {
+		t.Fatalf("Creature.GainScar(): want scars %v, got %v", want, fighter.Scars)
+	}
+}
+
+func TestCreatureScarsEqualsAndDeepCopy(t *testing.T) {
+	noCondition := atk.Condition{Kind: atk.ConditionNone, Rounds: 0}
+	original := Creature{
+		ID: "player-0", Name: "Merlin",
+		Attacks: []atk.Attack{
+			{
+				Name: "Staff", TargetCharacteristic: atk.STR,
+				Dice: dice.D6, DiceCnt: 1, Charges: -1,
+				IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitBulky,
+				PairedWith: 0, Inflicts: noCondition, Recharge: nil,
+				DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+				Area: atk.AreaZone, MaxTargets: 0,
+			},
+		},
+		STR: 8, DEX: 12, WIL: 15, HP: 4, MaxHP: 4,
+		Scars: []Scar{{Kind: ScarBrokenLimb, Detail: "Leg"}}, Armor: 0,
+		IsDetachment: false, Conditions: nil,
+		Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil, Items: nil,
+		Zone: atk.ZoneEngaged,
+	}
+	copied := original.DeepCopy()
+	if !original.Equals(&copied) {
+		t.Fatalf("Creature.DeepCopy() = %v, want %v", &copied, &original)
+	}
+
+	copied.Scars[0].Detail = "Arm"
+	if original.Scars[0].Detail == copied.Scars[0].Detail {
+		t.Errorf("original.Scars == copied.Scars")
+	}
+	if original.Equals(&copied) {
+		t.Errorf("Creature.Equals() = true, want false for different scars")
+	}
+
+	copied = original.DeepCopy()
+	copied.MaxHP++
+	if original.Equals(&copied) {
+		t.Errorf("Creature.Equals() = true, want false for different max HP")
+	}
+}
+
+func TestScarKindText(t *testing.T) {
+	for _, kind := range scarKinds() {
+		text, err := kind.MarshalText()
+		if err != nil {
+			t.Fatalf("ScarKind(%d).MarshalText() error = %v", kind, err)
+		}
+
+		var decoded ScarKind
+		if err := decoded.UnmarshalText(text); err != nil {
+			t.Fatalf("ScarKind.UnmarshalText(%s) error = %v", text, err)
+		}
+		if decoded != kind {
+			t.Fatalf("ScarKind.UnmarshalText(%s) = %s, want %s", text, decoded, kind)
+		}
+	}
+
+	var decoded ScarKind
+	if err := decoded.UnmarshalText([]byte("brokenlimb")); err != nil ||
+		decoded != ScarBrokenLimb {
+		t.Errorf(
+			"ScarKind.UnmarshalText(brokenlimb) = %s, %v, want BrokenLimb",
+			decoded, err,
+		)
+	}
+	if err := decoded.UnmarshalText([]byte("Bruised")); err == nil {
+		t.Errorf("ScarKind.UnmarshalText(Bruised) error = nil, want error")
+	}
+	if _, err := ScarKind(42).MarshalText(); err == nil {
+		t.Errorf("ScarKind(42).MarshalText() error = nil, want error")
+	}
+}
@@ -1,6 +1,10 @@
 package creat
 
-import "strings"
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
 
 // CreatureSlice is a `[]Creature` with helper methods.
 type CreatureSlice []Creature
@@ -41,3 +45,59 @@ func (cs CreatureSlice) Equals(other CreatureSlice) bool {
 
 	return true
 }
+
+// Diff compares the creatures before a battle with the same creatures after
+// the battle, see Creature.Diff. Creatures are matched by ID, and the diffs
+// follow the order of the CreatureSlice. It returns an error with
+// `Unwrap() []error` method to get all the errors if an ID isn't unique or a
+// creature is missing from either slice.
+func (cs CreatureSlice) Diff(after CreatureSlice) ([]Diff, error) {
+	var errs []error
+
+	afterIdxs := make(map[ID]int, len(after))
+	for i := range after {
+		if _, ok := afterIdxs[after[i].ID]; ok {
+			errs = append(errs, fmt.Errorf(
+				"creature %q is not unique after the battle", after[i].ID,
+			))
+			continue
+		}
+		afterIdxs[after[i].ID] = i
+	}
+
+	isMatched := make(map[ID]bool, len(cs))
+	diffs := make([]Diff, 0, len(cs))
+	for i := range cs {
+		id := cs[i].ID
+		if isMatched[id] {
+			errs = append(errs, fmt.Errorf(
+				"creature %q is not unique before the battle", id,
+			))
+			continue
+		}
+		isMatched[id] = true
+
+		idx, ok := afterIdxs[id]
+		if !ok {
+			errs = append(errs, fmt.Errorf(
+				"creature %q is missing after the battle", id,
+			))
+			continue
+		}
+		diffs = append(diffs, cs[i].Diff(&after[idx]))
+	}
+
+	for i := range after {
+		id := after[i].ID
+		if afterIdxs[id] == i && !isMatched[id] {
+			errs = append(errs, fmt.Errorf(
+				"creature %q is missing before the battle", id,
+			))
+		}
+	}
+
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return diffs, nil
+}
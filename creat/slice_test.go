@@ -5,23 +5,32 @@ import (
 
 	"github.com/rozag/cabasi/atk"
 	"github.com/rozag/cabasi/dice"
+	"github.com/rozag/cabasi/item"
+	"github.com/rozag/cabasi/spell"
 )
 
 func TestCreatureSliceEquals(t *testing.T) {
 	spear := atk.Attack{
 		Name: "Spear", TargetCharacteristic: atk.STR,
 		Dice: dice.D6, DiceCnt: 1, Charges: -1,
-		IsBlast: false,
+		IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone, PairedWith: 0,
+		Inflicts: atk.Condition{Kind: atk.ConditionNone, Rounds: 0}, Recharge: nil,
+		DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+		Area: atk.AreaZone, MaxTargets: 0,
 	}
 	hero := Creature{
 		ID: "player-0", Name: "Jane Appleseed", Attacks: []atk.Attack{spear},
-		STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-		IsDetachment: false,
+		STR: 8, DEX: 14, WIL: 8, HP: 4, MaxHP: 4, Scars: nil, Armor: 0,
+		IsDetachment: false, Conditions: nil,
+		Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil, Items: nil,
+		Zone: atk.ZoneEngaged,
 	}
 	monster := Creature{
 		ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
-		STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-		IsDetachment: false,
+		STR: 8, DEX: 14, WIL: 8, HP: 4, MaxHP: 4, Scars: nil, Armor: 0,
+		IsDetachment: false, Conditions: nil,
+		Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil, Items: nil,
+		Zone: atk.ZoneEngaged,
 	}
 	tests := []struct {
 		name        string
@@ -82,3 +91,229 @@ func TestCreatureSliceEquals(t *testing.T) {
 		})
 	}
 }
+
+func TestCreatureSliceDiff(t *testing.T) {
+	fighter := Creature{
+		ID: "player-1", Name: "Lancelot",
+		Attacks: nil,
+		Items: []item.Item{
+			{
+				Name: "Longsword", Kind: item.KindWeapon, Armor: 0,
+				Weapon: &atk.Attack{
+					Name: "Longsword", TargetCharacteristic: atk.STR,
+					Dice: dice.D10, DiceCnt: 1, Charges: -1,
+					IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitBulky,
+					PairedWith: 0,
+					Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+					Recharge:   nil, DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+					Area: atk.AreaZone, MaxTargets: 0,
+				},
+				IsBulky: true, IsEquipped: true, AttackIdx: 0,
+			},
+			{
+				Name: "Dagger", Kind: item.KindWeapon, Armor: 0,
+				Weapon: &atk.Attack{
+					Name: "Dagger", TargetCharacteristic: atk.STR,
+					Dice: dice.D6, DiceCnt: 1, Charges: -1,
+					IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+					PairedWith: 0,
+					Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+					Recharge:   nil, DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+					Area: atk.AreaZone, MaxTargets: 0,
+				},
+				IsBulky: false, IsEquipped: false, AttackIdx: 0,
+			},
+			{
+				Name: "Brigandine", Weapon: nil, Kind: item.KindArmor, Armor: 1,
+				IsBulky: false, IsEquipped: true, AttackIdx: 0,
+			},
+			{
+				Name: "Shield", Weapon: nil, Kind: item.KindShield, Armor: 1,
+				IsBulky: false, IsEquipped: true, AttackIdx: 0,
+			},
+			{
+				Name: "Helmet", Weapon: nil, Kind: item.KindHelmet, Armor: 1,
+				IsBulky: false, IsEquipped: true, AttackIdx: 0,
+			},
+			{
+				Name: "Rope", Weapon: nil, Kind: item.KindGear, Armor: 0,
+				IsBulky: false, IsEquipped: false, AttackIdx: 0,
+			},
+		},
+		STR: 14, DEX: 10, WIL: 9, HP: 5, MaxHP: 5, Scars: nil, Armor: 0,
+		Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+		IsDetachment: false, Conditions: nil, Zone: atk.ZoneEngaged,
+	}
+	noCondition := atk.Condition{Kind: atk.ConditionNone, Rounds: 0}
+	dragon := Creature{
+		ID: "monster-0", Name: "Red Dragon",
+		Attacks: []atk.Attack{
+			{
+				Name: "Claws", TargetCharacteristic: atk.STR,
+				Dice: dice.D8, DiceCnt: 1, Charges: -1,
+				IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+				PairedWith: 0, Inflicts: noCondition, Recharge: nil,
+				DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+				Area: atk.AreaZone, MaxTargets: 0,
+			},
+			{
+				Name: "Fire Breath", TargetCharacteristic: atk.STR,
+				Dice: dice.D12, DiceCnt: 1, Charges: 0,
+				IsBlast: true, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+				PairedWith: 0, Inflicts: noCondition,
+				Recharge: &atk.Recharge{
+					Kind: atk.RechargeOnRoll, Rounds: 0, Dice: dice.D6, Threshold: 5,
+					MaxCharges: 1, Elapsed: 0,
+				},
+				DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+				Area: atk.AreaZone, MaxTargets: 0,
+			},
+			{
+				Name: "Frightful Presence", TargetCharacteristic: atk.WIL,
+				Dice: dice.D6, DiceCnt: 1, Charges: 0,
+				IsBlast: true, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+				PairedWith: 0, Inflicts: noCondition,
+				Recharge: &atk.Recharge{
+					Kind: atk.RechargeOnRest, Rounds: 0, Dice: 0, Threshold: 0,
+					MaxCharges: 1, Elapsed: 0,
+				},
+				DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+				Area: atk.AreaZone, MaxTargets: 0,
+			},
+		},
+		STR: 18, DEX: 12, WIL: 16, HP: 20, MaxHP: 20, Scars: nil, Armor: 3,
+		IsDetachment: false, Conditions: nil,
+		Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil, Items: nil,
+		Zone: atk.ZoneEngaged,
+	}
+	wizard := Creature{
+		ID: "player-0", Name: "Merlin",
+		Attacks: []atk.Attack{
+			{
+				Name: "Staff", TargetCharacteristic: atk.STR,
+				Dice: dice.D6, DiceCnt: 1, Charges: -1,
+				IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitBulky,
+				PairedWith: 0, Inflicts: noCondition, Recharge: nil,
+				DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+				Area: atk.AreaZone, MaxTargets: 0,
+			},
+		},
+		Spellbooks: []spell.Book{
+			{
+				Name: "Fireball",
+				Effect: atk.Attack{
+					Name: "Fireball", TargetCharacteristic: atk.STR,
+					Dice: dice.D8, DiceCnt: 1, Charges: -1,
+					IsBlast: true, Pool: atk.PoolHighest, Traits: atk.TraitMagical,
+					PairedWith: 0, Inflicts: noCondition, Recharge: nil,
+					DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+					Area: atk.AreaZone, MaxTargets: 0,
+				},
+			},
+			{
+				Name: "Fear",
+				Effect: atk.Attack{
+					Name: "Fear", TargetCharacteristic: atk.WIL,
+					Dice: dice.D4, DiceCnt: 1, Charges: -1,
+					IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitMagical,
+					PairedWith: 0, Recharge: nil, DmgMod: 0, MinDmg: 0,
+					Usage: atk.UsageAlways, Area: atk.AreaZone, MaxTargets: 0,
+					Inflicts: atk.Condition{
+						Kind: atk.ConditionFrightened, Rounds: 2,
+					},
+				},
+			},
+		},
+		Conditions: nil, Fatigue: 0,
+		STR: 8, DEX: 12, WIL: 15, HP: 4, MaxHP: 4, Scars: nil, Armor: 0,
+		IsDetachment: false, IsDeprived: false, Supports: nil, Items: nil,
+		Zone: atk.ZoneEngaged,
+	}
+	tests := []struct {
+		name       string
+		before     CreatureSlice
+		after      CreatureSlice
+		wantErrCnt uint
+	}{
+		{
+			name:       "MatchedByID",
+			before:     CreatureSlice{dragon, wizard, fighter},
+			after:      CreatureSlice{fighter, wizard, dragon},
+			wantErrCnt: 0,
+		},
+		{
+			name:       "MissingAfter",
+			before:     CreatureSlice{dragon, wizard, fighter},
+			after:      CreatureSlice{wizard, dragon},
+			wantErrCnt: 1,
+		},
+		{
+			name:       "MissingBefore",
+			before:     CreatureSlice{dragon},
+			after:      CreatureSlice{wizard, dragon, fighter},
+			wantErrCnt: 2,
+		},
+		{
+			name:       "NotUnique",
+			before:     CreatureSlice{dragon, dragon, wizard},
+			after:      CreatureSlice{dragon, wizard, wizard},
+			wantErrCnt: 2,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			before := make(CreatureSlice, 0, len(test.before))
+			for _, c := range test.before {
+				before = append(before, c.DeepCopy())
+			}
+			after := make(CreatureSlice, 0, len(test.after))
+			for _, c := range test.after {
+				c = c.DeepCopy()
+				switch c.ID {
+				case dragon.ID:
+					c.HP -= 5
+				case wizard.ID:
+					c.Fatigue = 2
+				}
+				after = append(after, c)
+			}
+
+			diffs, err := before.Diff(after)
+			if test.wantErrCnt == 0 {
+				if err != nil {
+					t.Fatalf("CreatureSlice.Diff(): want nil, got %v", err)
+				}
+				if len(diffs) != 3 ||
+					diffs[0].ID != dragon.ID || diffs[0].HP != -5 ||
+					diffs[1].ID != wizard.ID || diffs[1].Fatigue != 2 ||
+					diffs[2].ID != fighter.ID || !diffs[2].IsEmpty() {
+					t.Errorf(
+						"CreatureSlice.Diff() = %v, want dragon with HP -5, "+
+							"wizard with Fatigue 2, and unchanged fighter",
+						diffs,
+					)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("CreatureSlice.Diff(): want error, got nil")
+			}
+
+			jointErr, ok := err.(interface{ Unwrap() []error })
+			if !ok {
+				t.Fatalf(
+					"CreatureSlice.Diff(): error must have `Unwrap() []error` method",
+				)
+			}
+
+			errs := jointErr.Unwrap()
+			if uint(len(errs)) != test.wantErrCnt {
+				t.Fatalf(
+					"CreatureSlice.Diff(): want %d errors, got %d",
+					test.wantErrCnt, len(errs),
+				)
+			}
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package creat
+
+import (
+	"fmt"
+
+	"github.com/rozag/cabasi/atk"
+	"github.com/rozag/cabasi/dice"
+)
+
+// CastOutcome represents the outcome of casting a spell.
+type CastOutcome uint8
+
+const (
+	// CastSuccess means the spell takes effect.
+	CastSuccess CastOutcome = iota
+	// CastNoSlots means the caster has no free slots for the Fatigue, the spell
+	// isn't cast at all.
+	CastNoSlots
+	// CastMishap means the caster failed the WIL save, the spell fails and
+	// lashes back.
+	CastMishap
+)
+
+// String returns the string representation of the CastOutcome.
+func (o CastOutcome) String() string {
+	switch o {
+	case CastSuccess:
+		return "Success"
+	case CastNoSlots:
+		return "NoSlots"
+	case CastMishap:
+		return "Mishap"
+	default:
+		panic(fmt.Errorf("unknown CastOutcome: %d", o))
+	}
+}
+
+// FreeSlots returns the number of the Creature's inventory slots not taken by
+// items, spellbooks, or Fatigue.
+func (c *Creature) FreeSlots() uint8 {
+	used := min(c.UsedSlots(), SlotsMax)
+	// Suppressing gosec "G115: integer overflow conversion uint -> uint8"
+	// because the result is in [0,SlotsMax].
+	return uint8(SlotsMax - used) //nolint:gosec
+}
+
+// CanCast checks if the Creature has enough free slots to take the Fatigue
+// for casting the spell from the spellbook at the provided index.
+func (c *Creature) CanCast(bookIdx uint, isEnhanced bool) bool {
+	if bookIdx >= uint(len(c.Spellbooks)) {
+		return false
+	}
+	return c.FreeSlots() >= c.Spellbooks[bookIdx].FatigueCost(isEnhanced)
+}
+
+// Cast takes the Fatigue for casting the spell from the spellbook at the
+// provided index. A deprived caster or a caster filling the last free slot
+// must pass a WIL save, otherwise the spell fails with a mishap and the
+// caster takes d6 WIL damage. If Fatigue fills all the slots, the caster's HP
+// is reduced to 0.
+func (c *Creature) Cast(
+	bookIdx uint,
+	isEnhanced bool,
+	rng dice.RNG,
+) CastOutcome {
+	if !c.CanCast(bookIdx, isEnhanced) {
+		return CastNoSlots
+	}
+
+	cost := c.Spellbooks[bookIdx].FatigueCost(isEnhanced)
+	isOverloaded := c.FreeSlots() == cost
+	c.TakeFatigue(cost)
+
+	if !c.IsDeprived && !isOverloaded {
+		return CastSuccess
+	}
+
+	save := SaveOpts{Reason: "spellcasting", Mode: SaveNormal, Modifier: 0}
+	if c.SaveWith(atk.WIL, rng, save).Success {
+		return CastSuccess
+	}
+
+	dice.Announcef(rng, "%s's %s mishap", c.Name, c.Spellbooks[bookIdx].Name)
+	c.WIL -= min(dice.D6.Roll(rng), c.WIL)
+	dice.Unannounce(rng)
+	return CastMishap
+}
@@ -0,0 +1,176 @@
+package creat
+
+import (
+	"testing"
+
+	"github.com/rozag/cabasi/atk"
+	"github.com/rozag/cabasi/dice"
+	"github.com/rozag/cabasi/dice/dicetest"
+	"github.com/rozag/cabasi/spell"
+)
+
+func TestCreatureFreeSlots(t *testing.T) {
+	noCondition := atk.Condition{Kind: atk.ConditionNone, Rounds: 0}
+	wizard := Creature{
+		ID: "player-0", Name: "Merlin",
+		Attacks: []atk.Attack{
+			{
+				Name: "Staff", TargetCharacteristic: atk.STR,
+				Dice: dice.D6, DiceCnt: 1, Charges: -1,
+				IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitBulky,
+				PairedWith: 0, Inflicts: noCondition, Recharge: nil,
+				DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+				Area: atk.AreaZone, MaxTargets: 0,
+			},
+		},
+		Spellbooks: []spell.Book{
+			{
+				Name: "Fireball",
+				Effect: atk.Attack{
+					Name: "Fireball", TargetCharacteristic: atk.STR,
+					Dice: dice.D8, DiceCnt: 1, Charges: -1,
+					IsBlast: true, Pool: atk.PoolHighest, Traits: atk.TraitMagical,
+					PairedWith: 0, Inflicts: noCondition, Recharge: nil,
+					DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+					Area: atk.AreaZone, MaxTargets: 0,
+				},
+			},
+			{
+				Name: "Fear",
+				Effect: atk.Attack{
+					Name: "Fear", TargetCharacteristic: atk.WIL,
+					Dice: dice.D4, DiceCnt: 1, Charges: -1,
+					IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitMagical,
+					PairedWith: 0, Recharge: nil, DmgMod: 0, MinDmg: 0,
+					Usage: atk.UsageAlways, Area: atk.AreaZone, MaxTargets: 0,
+					Inflicts: atk.Condition{
+						Kind: atk.ConditionFrightened, Rounds: 2,
+					},
+				},
+			},
+		},
+		Conditions: nil, Fatigue: 0,
+		STR: 8, DEX: 12, WIL: 15, HP: 4, MaxHP: 4, Scars: nil, Armor: 0,
+		IsDetachment: false, IsDeprived: false, Supports: nil, Items: nil,
+		Zone: atk.ZoneEngaged,
+	}
+	if got := wizard.FreeSlots(); got != 8 {
+		t.Errorf("Creature.FreeSlots(): want 8, got %d", got)
+	}
+
+	wizard.Fatigue = 9
+	if got := wizard.FreeSlots(); got != 0 {
+		t.Errorf("Creature.FreeSlots(): want 0 for overfilled slots, got %d", got)
+	}
+}
+
+func TestCreatureCast(t *testing.T) {
+	noCondition := atk.Condition{Kind: atk.ConditionNone, Rounds: 0}
+	merlin := Creature{
+		ID: "player-0", Name: "Merlin",
+		Attacks: []atk.Attack{
+			{
+				Name: "Staff", TargetCharacteristic: atk.STR,
+				Dice: dice.D6, DiceCnt: 1, Charges: -1,
+				IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitBulky,
+				PairedWith: 0, Inflicts: noCondition, Recharge: nil,
+				DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+				Area: atk.AreaZone, MaxTargets: 0,
+			},
+		},
+		Spellbooks: []spell.Book{
+			{
+				Name: "Fireball",
+				Effect: atk.Attack{
+					Name: "Fireball", TargetCharacteristic: atk.STR,
+					Dice: dice.D8, DiceCnt: 1, Charges: -1,
+					IsBlast: true, Pool: atk.PoolHighest, Traits: atk.TraitMagical,
+					PairedWith: 0, Inflicts: noCondition, Recharge: nil,
+					DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+					Area: atk.AreaZone, MaxTargets: 0,
+				},
+			},
+			{
+				Name: "Fear",
+				Effect: atk.Attack{
+					Name: "Fear", TargetCharacteristic: atk.WIL,
+					Dice: dice.D4, DiceCnt: 1, Charges: -1,
+					IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitMagical,
+					PairedWith: 0, Recharge: nil, DmgMod: 0, MinDmg: 0,
+					Usage: atk.UsageAlways, Area: atk.AreaZone, MaxTargets: 0,
+					Inflicts: atk.Condition{
+						Kind: atk.ConditionFrightened, Rounds: 2,
+					},
+				},
+			},
+		},
+		Conditions: nil, Fatigue: 0,
+		STR: 8, DEX: 12, WIL: 15, HP: 4, MaxHP: 4, Scars: nil, Armor: 0,
+		IsDetachment: false, IsDeprived: false, Supports: nil, Items: nil,
+		Zone: atk.ZoneEngaged,
+	}
+	tests := []struct {
+		name        string
+		fatigue     uint8
+		isDeprived  bool
+		isEnhanced  bool
+		faces       []uint
+		want        CastOutcome
+		wantFatigue uint8
+		wantHP      uint8
+		wantWIL     uint8
+	}{
+		{
+			name: "Success", fatigue: 0, isDeprived: false, isEnhanced: false,
+			faces: nil, want: CastSuccess,
+			wantFatigue: 1, wantHP: 4, wantWIL: 15,
+		},
+		{
+			name: "Enhanced", fatigue: 0, isDeprived: false, isEnhanced: true,
+			faces: nil, want: CastSuccess,
+			wantFatigue: 2, wantHP: 4, wantWIL: 15,
+		},
+		{
+			name: "NoSlots", fatigue: 7, isDeprived: false, isEnhanced: true,
+			faces: nil, want: CastNoSlots,
+			wantFatigue: 7, wantHP: 4, wantWIL: 15,
+		},
+		{
+			name: "DeprivedPassesSave", fatigue: 0, isDeprived: true,
+			isEnhanced: false, faces: []uint{15}, want: CastSuccess,
+			wantFatigue: 1, wantHP: 4, wantWIL: 15,
+		},
+		{
+			name: "DeprivedMishap", fatigue: 0, isDeprived: true,
+			isEnhanced: false, faces: []uint{16, 6}, want: CastMishap,
+			wantFatigue: 1, wantHP: 4, wantWIL: 9,
+		},
+		{
+			name: "OverloadedFillsSlots", fatigue: 7, isDeprived: false,
+			isEnhanced: false, faces: []uint{1}, want: CastSuccess,
+			wantFatigue: 8, wantHP: 0, wantWIL: 15,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			wizard := merlin.DeepCopy()
+			wizard.Fatigue = test.fatigue
+			wizard.IsDeprived = test.isDeprived
+
+			rng := dicetest.NewSequence(t, test.faces...)
+			got := wizard.Cast(0, test.isEnhanced, rng)
+			if got != test.want {
+				t.Errorf("Creature.Cast(): want %s, got %s", test.want, got)
+			}
+			if wizard.Fatigue != test.wantFatigue ||
+				wizard.HP != test.wantHP ||
+				wizard.WIL != test.wantWIL {
+				t.Errorf(
+					"Creature.Cast(): want Fatigue %d, HP %d, WIL %d, got %d, %d, %d",
+					test.wantFatigue, test.wantHP, test.wantWIL,
+					wizard.Fatigue, wizard.HP, wizard.WIL,
+				)
+			}
+		})
+	}
+}
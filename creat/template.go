@@ -0,0 +1,69 @@
+package creat
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/rozag/cabasi/atk"
+	"github.com/rozag/cabasi/dice"
+	"github.com/rozag/cabasi/internal/codec"
+)
+
+// Template is a blueprint of creatures of a kind, e.g. goblins. Creature is
+// copied for every instance, its ID is the prefix of the instances' IDs, e.g.
+// "goblin" for "goblin-1". If HPDiceCnt isn't 0, every instance rolls its HP
+// as the sum of HPDiceCnt HPDice instead of copying Creature's HP.
+type Template struct {
+	Creature  Creature
+	HPDice    dice.Dice
+	HPDiceCnt uint8
+}
+
+// Validate checks if the Template is valid. It returns an error with
+// `Unwrap() []error` method to get all the errors or `nil` if the template is
+// valid. Errors of the fields are reported as *codec.FieldError, e.g.
+// "creature.attacks[0].dice" or "hpDice".
+func (t *Template) Validate() error {
+	var errs []error
+
+	if err := t.Creature.Validate(); err != nil {
+		errs = append(errs, codec.WrapField("creature", err))
+	}
+
+	if t.HPDiceCnt > 0 {
+		switch t.HPDice {
+		case dice.D4, dice.D6, dice.D8, dice.D10, dice.D12, dice.D20:
+			// OK
+		default:
+			errs = append(errs, codec.WrapField(
+				"hpDice", fmt.Errorf("invalid HP dice: %d", t.HPDice),
+			))
+		}
+	} else if t.HPDice != 0 {
+		errs = append(errs, codec.WrapField(
+			"hpDiceCnt", errors.New("HP dice count must be at least 1"),
+		))
+	}
+
+	return errors.Join(errs...)
+}
+
+// Spawn makes cnt instances of the Template taking their IDs from the
+// registry, e.g. "goblin-1", "goblin-2", and so on. Instances roll their HP
+// with the RNG in order if the template has HP dice, the rolled HP is also
+// their max HP.
+func (t *Template) Spawn(ids *Registry, cnt uint, rng dice.RNG) []Creature {
+	creatures := make([]Creature, 0, cnt)
+	for range cnt {
+		creature := t.Creature.DeepCopy()
+		creature.ID = ids.Next(string(t.Creature.ID))
+		if t.HPDiceCnt > 0 {
+			dice.Announcef(rng, "%s's HP", creature.ID)
+			creature.HP = atk.PoolSum.Roll(t.HPDice, t.HPDiceCnt, rng)
+			dice.Unannounce(rng)
+			creature.MaxHP = creature.HP
+		}
+		creatures = append(creatures, creature)
+	}
+	return creatures
+}
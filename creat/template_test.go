@@ -0,0 +1,219 @@
+package creat
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/rozag/cabasi/atk"
+	"github.com/rozag/cabasi/dice"
+	"github.com/rozag/cabasi/dice/dicetest"
+)
+
+func TestTemplateValidate(t *testing.T) {
+	goblin := Template{
+		Creature: Creature{
+			ID: "goblin", Name: "Goblin",
+			Attacks: []atk.Attack{
+				{
+					Name: "Spear", TargetCharacteristic: atk.STR,
+					Dice: dice.D6, DiceCnt: 1, Charges: -1,
+					IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitReach,
+					PairedWith: 0, Recharge: nil, DmgMod: 0, MinDmg: 0,
+					Usage: atk.UsageAlways, Area: atk.AreaZone, MaxTargets: 0,
+					Inflicts: atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+				},
+			},
+			Spellbooks: nil, Supports: nil, Items: nil, Conditions: nil,
+			STR: 8, DEX: 14, WIL: 8, HP: 3,
+			MaxHP: 3, Scars: nil, Armor: 0, Fatigue: 0,
+			Zone: atk.ZoneEngaged, IsDetachment: false, IsDeprived: false,
+		},
+		HPDice: 0, HPDiceCnt: 0,
+	}
+	tests := []struct {
+		modify     func(tmpl *Template)
+		name       string
+		wantPaths  []string
+		wantErrCnt int
+	}{
+		{
+			name: "Valid", modify: func(*Template) {},
+			wantPaths: nil, wantErrCnt: 0,
+		},
+		{
+			name: "ValidRolledHP",
+			modify: func(tmpl *Template) {
+				tmpl.HPDice, tmpl.HPDiceCnt = dice.D6, 2
+			},
+			wantPaths: nil, wantErrCnt: 0,
+		},
+		{
+			name:       "InvalidCreature",
+			modify:     func(tmpl *Template) { tmpl.Creature.Name = "" },
+			wantPaths:  []string{"creature.name"},
+			wantErrCnt: 1,
+		},
+		{
+			name: "UnknownHPDice",
+			modify: func(tmpl *Template) {
+				tmpl.HPDice, tmpl.HPDiceCnt = dice.Dice(7), 1
+			},
+			wantPaths: []string{"hpDice"}, wantErrCnt: 1,
+		},
+		{
+			name:       "HPDiceWithoutCnt",
+			modify:     func(tmpl *Template) { tmpl.HPDice = dice.D6 },
+			wantPaths:  []string{"hpDiceCnt"},
+			wantErrCnt: 1,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tmpl := goblin
+			test.modify(&tmpl)
+			err := tmpl.Validate()
+			if test.wantErrCnt == 0 {
+				if err != nil {
+					t.Fatalf("Template.Validate(): want nil, got %v", err)
+				}
+				return
+			}
+
+			jointErr, ok := err.(interface{ Unwrap() []error })
+			if !ok {
+				t.Fatalf(
+					"Template.Validate(): error must have `Unwrap() []error` method",
+				)
+			}
+			if errs := jointErr.Unwrap(); len(errs) != test.wantErrCnt {
+				t.Fatalf(
+					"Template.Validate(): want %d errors, got %d: %v",
+					test.wantErrCnt, len(errs), err,
+				)
+			}
+			if paths := fieldPaths(err); !slices.Equal(paths, test.wantPaths) {
+				t.Fatalf(
+					"Template.Validate(): want paths %v, got %v", test.wantPaths, paths,
+				)
+			}
+		})
+	}
+}
+
+func TestTemplateSpawn(t *testing.T) {
+	tmpl := Template{
+		Creature: Creature{
+			ID: "goblin", Name: "Goblin",
+			Attacks: []atk.Attack{
+				{
+					Name: "Spear", TargetCharacteristic: atk.STR,
+					Dice: dice.D6, DiceCnt: 1, Charges: -1,
+					IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitReach,
+					PairedWith: 0, Recharge: nil, DmgMod: 0, MinDmg: 0,
+					Usage: atk.UsageAlways, Area: atk.AreaZone, MaxTargets: 0,
+					Inflicts: atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+				},
+			},
+			Spellbooks: nil, Supports: nil, Items: nil, Conditions: nil,
+			STR: 8, DEX: 14, WIL: 8, HP: 3,
+			MaxHP: 3, Scars: nil, Armor: 0, Fatigue: 0,
+			Zone: atk.ZoneEngaged, IsDetachment: false, IsDeprived: false,
+		},
+		HPDice: 0, HPDiceCnt: 0,
+	}
+	goblins := tmpl.Spawn(NewRegistry(), 3, dicetest.Min{})
+
+	if len(goblins) != 3 {
+		t.Fatalf("Template.Spawn(): want 3 goblins, got %d", len(goblins))
+	}
+	for idx, goblin := range goblins {
+		want := tmpl.Creature.DeepCopy()
+		want.ID = ID(fmt.Sprintf("goblin-%d", idx+1))
+		if !goblin.Equals(&want) {
+			t.Fatalf("Template.Spawn()[%d] = %v, want %v", idx, &goblin, &want)
+		}
+		if err := goblin.Validate(); err != nil {
+			t.Fatalf("Template.Spawn()[%d] is invalid: %v", idx, err)
+		}
+	}
+
+	goblins[0].Attacks[0].Name = "Bow"
+	if tmpl.Creature.Attacks[0].Name != "Spear" {
+		t.Fatalf("Template.Spawn(): modifying an instance affected the template")
+	}
+}
+
+func TestTemplateSpawnRollsHP(t *testing.T) {
+	tmpl := Template{
+		Creature: Creature{
+			ID: "goblin", Name: "Goblin",
+			Attacks: []atk.Attack{
+				{
+					Name: "Spear", TargetCharacteristic: atk.STR,
+					Dice: dice.D6, DiceCnt: 1, Charges: -1,
+					IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitReach,
+					PairedWith: 0, Recharge: nil, DmgMod: 0, MinDmg: 0,
+					Usage: atk.UsageAlways, Area: atk.AreaZone, MaxTargets: 0,
+					Inflicts: atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+				},
+			},
+			Spellbooks: nil, Supports: nil, Items: nil, Conditions: nil,
+			STR: 8, DEX: 14, WIL: 8, HP: 3,
+			MaxHP: 3, Scars: nil, Armor: 0, Fatigue: 0,
+			Zone: atk.ZoneEngaged, IsDetachment: false, IsDeprived: false,
+		},
+		HPDice: 0, HPDiceCnt: 0,
+	}
+	tmpl.HPDice, tmpl.HPDiceCnt = dice.D6, 2
+	rng := dicetest.NewSequence(t, 1, 2, 6, 5)
+
+	goblins := tmpl.Spawn(NewRegistry(), 2, rng)
+	if goblins[0].HP != 3 || goblins[1].HP != 11 {
+		t.Fatalf(
+			"Template.Spawn(): want HP 3 and 11, got %d and %d",
+			goblins[0].HP, goblins[1].HP,
+		)
+	}
+	if tmpl.Creature.HP != 3 {
+		t.Fatalf("Template.Spawn(): template HP changed to %d", tmpl.Creature.HP)
+	}
+}
+
+func TestTemplateSpawnSharedRegistry(t *testing.T) {
+	ids := NewRegistry()
+	if err := ids.Reserve("goblin-2"); err != nil {
+		t.Fatalf("Registry.Reserve(): want nil, got %v", err)
+	}
+
+	tmpl := Template{
+		Creature: Creature{
+			ID: "goblin", Name: "Goblin",
+			Attacks: []atk.Attack{
+				{
+					Name: "Spear", TargetCharacteristic: atk.STR,
+					Dice: dice.D6, DiceCnt: 1, Charges: -1,
+					IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitReach,
+					PairedWith: 0, Recharge: nil, DmgMod: 0, MinDmg: 0,
+					Usage: atk.UsageAlways, Area: atk.AreaZone, MaxTargets: 0,
+					Inflicts: atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+				},
+			},
+			Spellbooks: nil, Supports: nil, Items: nil, Conditions: nil,
+			STR: 8, DEX: 14, WIL: 8, HP: 3,
+			MaxHP: 3, Scars: nil, Armor: 0, Fatigue: 0,
+			Zone: atk.ZoneEngaged, IsDetachment: false, IsDeprived: false,
+		},
+		HPDice: 0, HPDiceCnt: 0,
+	}
+	first := tmpl.Spawn(ids, 2, dicetest.Min{})
+	second := tmpl.Spawn(ids, 1, dicetest.Min{})
+
+	got := []ID{first[0].ID, first[1].ID, second[0].ID}
+	want := []ID{"goblin-1", "goblin-3", "goblin-4"}
+	for idx := range want {
+		if got[idx] != want[idx] {
+			t.Fatalf("Template.Spawn(): want IDs %v, got %v", want, got)
+		}
+	}
+}
@@ -0,0 +1,32 @@
+package creat
+
+import (
+	"gopkg.in/yaml.v3"
+
+	"github.com/rozag/cabasi/internal/codec"
+)
+
+// MarshalYAML implements yaml.Marshaler with the JSON encoding of the
+// Creature, see MarshalJSON.
+func (c *Creature) MarshalYAML() (any, error) {
+	return codec.EncodeYAML(c)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler with the JSON decoding of the
+// Creature, see UnmarshalJSON. The decoded creature isn't validated.
+func (c *Creature) UnmarshalYAML(node *yaml.Node) error {
+	return codec.DecodeYAML(node, c)
+}
+
+// DecodeYAML decodes the YAML encoding of a freshly created Creature and
+// validates it, see DecodeJSON.
+func DecodeYAML(data []byte) (Creature, error) {
+	var none, creature Creature
+	if err := yaml.Unmarshal(data, &creature); err != nil {
+		return none, err
+	}
+	if err := creature.Validate(); err != nil {
+		return none, err
+	}
+	return creature, nil
+}
@@ -0,0 +1,166 @@
+package creat
+
+import (
+	"slices"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/rozag/cabasi/atk"
+	"github.com/rozag/cabasi/dice"
+	"github.com/rozag/cabasi/item"
+)
+
+func TestCreatureYAMLRoundTrip(t *testing.T) {
+	lancelot := Creature{
+		ID: "player-0", Name: "Lancelot",
+		Attacks: nil,
+		Items: []item.Item{
+			{
+				Name: "Longsword", Kind: item.KindWeapon, Armor: 0,
+				Weapon: &atk.Attack{
+					Name: "Longsword", TargetCharacteristic: atk.STR,
+					Dice: dice.D10, DiceCnt: 1, Charges: -1,
+					IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitBulky,
+					PairedWith: 0,
+					Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+					Recharge:   nil, DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+					Area: atk.AreaZone, MaxTargets: 0,
+				},
+				IsBulky: true, IsEquipped: true, AttackIdx: 0,
+			},
+			{
+				Name: "Dagger", Kind: item.KindWeapon, Armor: 0,
+				Weapon: &atk.Attack{
+					Name: "Dagger", TargetCharacteristic: atk.STR,
+					Dice: dice.D6, DiceCnt: 1, Charges: -1,
+					IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+					PairedWith: 0,
+					Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+					Recharge:   nil, DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+					Area: atk.AreaZone, MaxTargets: 0,
+				},
+				IsBulky: false, IsEquipped: false, AttackIdx: 0,
+			},
+			{
+				Name: "Brigandine", Weapon: nil, Kind: item.KindArmor, Armor: 1,
+				IsBulky: false, IsEquipped: true, AttackIdx: 0,
+			},
+			{
+				Name: "Shield", Weapon: nil, Kind: item.KindShield, Armor: 1,
+				IsBulky: false, IsEquipped: true, AttackIdx: 0,
+			},
+			{
+				Name: "Helmet", Weapon: nil, Kind: item.KindHelmet, Armor: 1,
+				IsBulky: false, IsEquipped: true, AttackIdx: 0,
+			},
+			{
+				Name: "Rope", Weapon: nil, Kind: item.KindGear, Armor: 0,
+				IsBulky: false, IsEquipped: false, AttackIdx: 0,
+			},
+		},
+		STR: 14, DEX: 10, WIL: 9, HP: 5, MaxHP: 5, Scars: nil, Armor: 0,
+		Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+		IsDetachment: false, Conditions: nil, Zone: atk.ZoneEngaged,
+	}
+
+	data, err := yaml.Marshal(&lancelot)
+	if err != nil {
+		t.Fatalf("yaml.Marshal() error = %v", err)
+	}
+
+	var decoded Creature
+	if err := yaml.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("yaml.Unmarshal(%s) error = %v", data, err)
+	}
+	if !decoded.Equals(&lancelot) {
+		t.Fatalf(
+			"yaml.Unmarshal(%s) = %s, want %s",
+			data, decoded.String(), lancelot.String(),
+		)
+	}
+}
+
+func TestCreatureUnmarshalYAMLErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		data      string
+		wantPaths []string
+	}{
+		{
+			name:      "UnknownField",
+			data:      "name: Rat\nmorale: 7\n",
+			wantPaths: []string{"morale"},
+		},
+		{
+			name: "NestedFields",
+			data: "name: Rat\n" +
+				"attacks:\n" +
+				"  - name: Bite\n" +
+				"    dice: d4\n" +
+				"  - name: Claws\n" +
+				"    dice: d3\n" +
+				"    target: CHA\n" +
+				"items:\n" +
+				"  - name: Cheese\n" +
+				"    kind: Food\n",
+			wantPaths: []string{
+				"attacks[1].target", "attacks[1].dice", "items[0].kind",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var got Creature
+			err := yaml.Unmarshal([]byte(test.data), &got)
+			if paths := fieldPaths(err); !slices.Equal(paths, test.wantPaths) {
+				t.Fatalf(
+					"yaml.Unmarshal() error = %v, want paths %v",
+					err, test.wantPaths,
+				)
+			}
+		})
+	}
+}
+
+func TestDecodeYAML(t *testing.T) {
+	tests := []struct {
+		name      string
+		data      string
+		wantPaths []string
+	}{
+		{
+			name: "Valid",
+			data: "id: goblin\nname: Goblin\nstr: 8\ndex: 14\nwil: 8\nhp: 3\n" +
+				"attacks:\n  - name: Spear\n    dice: d6\n",
+			wantPaths: nil,
+		},
+		{
+			name: "DecodeErrors",
+			data: "id: goblin\nname: Goblin\nstr: 8\ndex: 14\nwil: 8\nhp: 3\n" +
+				"attacks:\n  - name: Spear\n    dice: d7\n",
+			wantPaths: []string{"attacks[0].dice"},
+		},
+		{
+			name: "ValidationErrors",
+			data: "attacks:\n  - dice: d6\n    diceCnt: 0\n",
+			wantPaths: []string{
+				"id", "name", "attacks[0].name", "attacks[0].diceCnt",
+				"str", "dex", "wil", "hp",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := DecodeYAML([]byte(test.data))
+			if paths := fieldPaths(err); !slices.Equal(paths, test.wantPaths) {
+				t.Fatalf(
+					"DecodeYAML() error = %v, want paths %v", err, test.wantPaths,
+				)
+			}
+			if err == nil && got.Name != "Goblin" {
+				t.Fatalf("DecodeYAML() = %v, want the goblin", &got)
+			}
+		})
+	}
+}
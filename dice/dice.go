@@ -1,6 +1,9 @@
 package dice
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 // Dice represents a dice with a given number of sides.
 type Dice uint8
@@ -40,6 +43,28 @@ func (d Dice) String() string {
 	}
 }
 
+// MarshalText implements encoding.TextMarshaler, the Dice is encoded as "d6".
+func (d Dice) MarshalText() ([]byte, error) {
+	switch d {
+	case D4, D6, D8, D10, D12, D20:
+		return []byte(strings.ToLower(d.String())), nil
+	default:
+		return nil, fmt.Errorf("unknown dice: %d", d)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, it decodes a Dice encoded
+// as "d6", case-insensitive.
+func (d *Dice) UnmarshalText(text []byte) error {
+	for _, known := range []Dice{D4, D6, D8, D10, D12, D20} {
+		if strings.EqualFold(string(text), known.String()) {
+			*d = known
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown dice %q", text)
+}
+
 // RNG is a random number generator.
 type RNG interface {
 	// UintN returns, as a uint, a non-negative pseudo-random number in the
@@ -47,6 +72,30 @@ type RNG interface {
 	UintN(n uint) uint
 }
 
+// Announcer is an optional RNG extension for RNGs that want to know what
+// the next roll is for, e.g. to prompt a human rolling physical dice.
+type Announcer interface {
+	// Announce describes the next roll, e.g. "Root Goblin's morale save".
+	Announce(what string)
+}
+
+// Announcef describes the next roll to the RNG if it implements Announcer and
+// does nothing otherwise. The description is formatted only when it's needed.
+func Announcef(rng RNG, format string, args ...any) {
+	if announcer, ok := rng.(Announcer); ok {
+		announcer.Announce(fmt.Sprintf(format, args...))
+	}
+}
+
+// Unannounce clears the description of the rolls if the RNG implements
+// Announcer and does nothing otherwise. Call it once the announced dice are
+// rolled, so the following rolls aren't described as the same ones.
+func Unannounce(rng RNG) {
+	if announcer, ok := rng.(Announcer); ok {
+		announcer.Announce("")
+	}
+}
+
 // Roll rolls the dice and returns the result.
 func (d Dice) Roll(rng RNG) uint8 {
 	// Suppressing gosec "G115: integer overflow conversion uint -> uint8" because
@@ -77,3 +77,80 @@ func TestDiceRollRNGArg(t *testing.T) {
 		})
 	}
 }
+
+type announcerRNG struct {
+	fixedRNG
+	what []string
+}
+
+func (a *announcerRNG) Announce(what string) { a.what = append(a.what, what) }
+
+func TestAnnouncef(t *testing.T) {
+	announcer := &announcerRNG{fixedRNG: 0, what: nil}
+	Announcef(announcer, "%s's %s save", "Root Goblin", "WIL")
+	if len(announcer.what) != 1 || announcer.what[0] != "Root Goblin's WIL save" {
+		t.Fatalf(
+			"Announcef(): want [Root Goblin's WIL save], got %v", announcer.what,
+		)
+	}
+
+	// Must not panic for RNGs that aren't Announcers.
+	Announcef(fixedRNG(0), "%s's %s save", "Root Goblin", "WIL")
+}
+
+func TestDiceMarshalText(t *testing.T) {
+	for _, d := range []Dice{D4, D6, D8, D10, D12, D20} {
+		t.Run(d.String(), func(t *testing.T) {
+			text, err := d.MarshalText()
+			if err != nil {
+				t.Fatalf("Dice.MarshalText() error = %v", err)
+			}
+			var decoded Dice
+			if err := decoded.UnmarshalText(text); err != nil {
+				t.Fatalf("Dice.UnmarshalText(%q) error = %v", text, err)
+			}
+			if decoded != d {
+				t.Fatalf("Dice.UnmarshalText(%q) = %s, want %s", text, decoded, d)
+			}
+		})
+	}
+
+	if text, _ := D6.MarshalText(); string(text) != "d6" {
+		t.Fatalf("D6.MarshalText() = %q, want %q", text, "d6")
+	}
+	if _, err := Dice(7).MarshalText(); err == nil {
+		t.Fatal("Dice(7).MarshalText(): want error, got nil")
+	}
+}
+
+func TestDiceUnmarshalText(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		want    Dice
+		wantErr bool
+	}{
+		{name: "Lowercase", text: "d8", want: D8, wantErr: false},
+		{name: "Uppercase", text: "D12", want: D12, wantErr: false},
+		{name: "Unknown", text: "d7", want: 0, wantErr: true},
+		{name: "Count", text: "2d6", want: 0, wantErr: true},
+		{name: "Empty", text: "", want: 0, wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var got Dice
+			err := got.UnmarshalText([]byte(test.text))
+			if (err != nil) != test.wantErr {
+				t.Fatalf(
+					"Dice.UnmarshalText(%q) error = %v, wantErr %t",
+					test.text, err, test.wantErr,
+				)
+			}
+			if got != test.want {
+				t.Fatalf(
+					"Dice.UnmarshalText(%q) = %d, want %d", test.text, got, test.want,
+				)
+			}
+		})
+	}
+}
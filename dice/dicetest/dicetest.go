@@ -0,0 +1,190 @@
+// Package dicetest provides dice.RNG test doubles for writing precise rules
+// tests against the battle engine and custom strategies.
+//
+// All the scripted RNGs work with faces - the 1-based numbers printed on a die
+// - rather than raw RNG values, i.e. a scripted 6 on a d6 is its maximum roll.
+// They report misuse (faces out of range, unconsumed or over-consumed rolls)
+// via testing.TB.Errorf rather than Fatalf, so the test keeps running after
+// a report.
+//
+// Min, Max, and Fixed have no state and can be shared between goroutines.
+// Sequence and PerDie consume their scripts without any synchronization, so
+// each of them must be used by a single goroutine.
+package dicetest
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/rozag/cabasi/dice"
+)
+
+// Min is an RNG that always rolls the minimum value.
+type Min struct{}
+
+// UintN returns 0. It panics if n == 0 like any other dice.RNG.
+func (Min) UintN(n uint) uint {
+	checkN("Min", n)
+	return 0
+}
+
+// Max is an RNG that always rolls the maximum value.
+type Max struct{}
+
+// UintN returns n-1. It panics if n == 0 like any other dice.RNG.
+func (Max) UintN(n uint) uint {
+	checkN("Max", n)
+	return n - 1
+}
+
+// checkN panics if n == 0, Min and Max have no testing.TB to report it to.
+func checkN(name string, n uint) {
+	if n == 0 {
+		panic(fmt.Errorf("dicetest.%s: n must be greater than 0", name))
+	}
+}
+
+// Fixed is an RNG that always rolls the same face no matter the die.
+type Fixed struct {
+	tb   testing.TB
+	face uint
+}
+
+// NewFixed creates a new Fixed RNG rolling the provided face.
+func NewFixed(tb testing.TB, face uint) *Fixed {
+	tb.Helper()
+	if face == 0 {
+		tb.Errorf("dicetest.Fixed: face must be at least 1")
+	}
+	return &Fixed{tb: tb, face: face}
+}
+
+// UintN returns the face minus one. It reports an error and rolls the minimum
+// if the face is bigger than n.
+func (f *Fixed) UintN(n uint) uint {
+	return toValue(f.tb, "Fixed", f.face, n)
+}
+
+// Sequence is an RNG that rolls scripted faces in order no matter the die. It
+// reports an error if a roll is requested after the script is exhausted, and
+// at the end of the test if some of the scripted faces weren't rolled.
+type Sequence struct {
+	tb    testing.TB
+	faces []uint
+	idx   int
+}
+
+// NewSequence creates a new Sequence RNG rolling the provided faces.
+func NewSequence(tb testing.TB, faces ...uint) *Sequence {
+	tb.Helper()
+	s := &Sequence{tb: tb, faces: faces, idx: 0}
+	tb.Cleanup(func() {
+		if remaining := s.Remaining(); remaining > 0 {
+			tb.Errorf(
+				"dicetest.Sequence: %d of %d faces not rolled: %v",
+				remaining, len(s.faces), s.faces[s.idx:],
+			)
+		}
+	})
+	return s
+}
+
+// UintN returns the next scripted face minus one. It reports an error and
+// rolls the minimum if the script is exhausted or the face is bigger than n.
+func (s *Sequence) UintN(n uint) uint {
+	if s.idx >= len(s.faces) {
+		s.tb.Errorf(
+			"dicetest.Sequence: d%d rolled after all %d faces were rolled",
+			n, len(s.faces),
+		)
+		return 0
+	}
+	face := s.faces[s.idx]
+	s.idx++
+	return toValue(s.tb, "Sequence", face, n)
+}
+
+// Remaining returns the number of scripted faces not rolled yet.
+func (s *Sequence) Remaining() int {
+	return len(s.faces) - s.idx
+}
+
+// PerDie is an RNG that rolls scripted faces per die size, so the order of
+// rolls of different dice doesn't matter, e.g. damage rolls and d20 saves can
+// be scripted independently. It reports an error if a die is rolled more times
+// than scripted, and at the end of the test if some of the scripted faces
+// weren't rolled.
+type PerDie struct {
+	tb     testing.TB
+	script map[dice.Dice][]uint
+}
+
+// NewPerDie creates a new PerDie RNG rolling the scripted faces for each die.
+// The script is copied, so it can be safely reused.
+func NewPerDie(tb testing.TB, script map[dice.Dice][]uint) *PerDie {
+	tb.Helper()
+	copied := make(map[dice.Dice][]uint, len(script))
+	for d, faces := range script {
+		copied[d] = append([]uint(nil), faces...)
+	}
+	p := &PerDie{tb: tb, script: copied}
+	tb.Cleanup(func() {
+		if remaining := p.remaining(); len(remaining) > 0 {
+			tb.Errorf("dicetest.PerDie: faces not rolled: %s", remaining)
+		}
+	})
+	return p
+}
+
+// UintN returns the next face scripted for dN minus one. It reports an error
+// and rolls the minimum if there are no more faces for the die or the face is
+// bigger than n.
+func (p *PerDie) UintN(n uint) uint {
+	if n > math.MaxUint8 {
+		p.tb.Errorf("dicetest.PerDie: d%d is not a dice.Dice", n)
+		return 0
+	}
+
+	d := dice.Dice(n)
+	faces := p.script[d]
+	if len(faces) == 0 {
+		p.tb.Errorf("dicetest.PerDie: d%d rolled but no faces left", n)
+		return 0
+	}
+	p.script[d] = faces[1:]
+	return toValue(p.tb, "PerDie", faces[0], n)
+}
+
+// remaining returns the description of faces not rolled yet or an empty string
+// if all the scripted faces were rolled.
+func (p *PerDie) remaining() string {
+	sizes := make([]dice.Dice, 0, len(p.script))
+	for d, faces := range p.script {
+		if len(faces) > 0 {
+			sizes = append(sizes, d)
+		}
+	}
+	slices.Sort(sizes)
+
+	var sb strings.Builder
+	for i, d := range sizes {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		fmt.Fprintf(&sb, "d%d: %v", d, p.script[d])
+	}
+	return sb.String()
+}
+
+// toValue converts a face into an RNG value for UintN(n). It reports an error
+// and returns the minimum if the face is out of [1,n].
+func toValue(tb testing.TB, name string, face, n uint) uint {
+	if face == 0 || face > n {
+		tb.Errorf("dicetest.%s: face %d is out of [1,%d]", name, face, n)
+		return 0
+	}
+	return face - 1
+}
@@ -0,0 +1,240 @@
+package dicetest
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/rozag/cabasi/dice"
+)
+
+// recordingTB is a testing.TB recording reported errors instead of failing.
+type recordingTB struct {
+	testing.TB
+	errs     []string
+	cleanups []func()
+}
+
+func (r *recordingTB) Helper() {}
+
+func (r *recordingTB) Errorf(format string, args ...any) {
+	r.errs = append(r.errs, fmt.Sprintf(format, args...))
+}
+
+func (r *recordingTB) Cleanup(f func()) { r.cleanups = append(r.cleanups, f) }
+
+func (r *recordingTB) runCleanups() {
+	for _, f := range slices.Backward(r.cleanups) {
+		f()
+	}
+}
+
+func roll(rng dice.RNG, ds ...dice.Dice) []uint8 {
+	rolls := make([]uint8, len(ds))
+	for i, d := range ds {
+		rolls[i] = d.Roll(rng)
+	}
+	return rolls
+}
+
+func TestMinMax(t *testing.T) {
+	tests := []struct {
+		name string
+		rng  dice.RNG
+		want []uint8
+	}{
+		{name: "Min", rng: Min{}, want: []uint8{1, 1, 1}},
+		{name: "Max", rng: Max{}, want: []uint8{4, 12, 20}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := roll(test.rng, dice.D4, dice.D12, dice.D20)
+			if !slices.Equal(got, test.want) {
+				t.Fatalf("%s: want rolls %v, got %v", test.name, test.want, got)
+			}
+		})
+	}
+}
+
+func TestMinMaxPanicOnZeroN(t *testing.T) {
+	tests := []struct {
+		name string
+		rng  dice.RNG
+	}{
+		{name: "Min", rng: Min{}},
+		{name: "Max", rng: Max{}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Fatalf("%s.UintN(0): want panic, got none", test.name)
+				}
+			}()
+
+			_ = test.rng.UintN(0)
+		})
+	}
+}
+
+func TestFixed(t *testing.T) {
+	tests := []struct {
+		name       string
+		face       uint
+		dice       []dice.Dice
+		want       []uint8
+		wantErrCnt int
+	}{
+		{
+			name: "FitsAllDice", face: 4,
+			dice: []dice.Dice{dice.D4, dice.D20},
+			want: []uint8{4, 4}, wantErrCnt: 0,
+		},
+		{
+			name: "TooBigForDie", face: 6,
+			dice: []dice.Dice{dice.D4, dice.D6},
+			want: []uint8{1, 6}, wantErrCnt: 1,
+		},
+		{
+			name: "ZeroFace", face: 0,
+			dice: []dice.Dice{dice.D6},
+			want: []uint8{1}, wantErrCnt: 2,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tb := &recordingTB{TB: t, errs: nil, cleanups: nil}
+			got := roll(NewFixed(tb, test.face), test.dice...)
+			tb.runCleanups()
+
+			if !slices.Equal(got, test.want) {
+				t.Errorf("Fixed: want rolls %v, got %v", test.want, got)
+			}
+			if len(tb.errs) != test.wantErrCnt {
+				t.Errorf(
+					"Fixed: want %d errors, got %d: %q",
+					test.wantErrCnt, len(tb.errs), tb.errs,
+				)
+			}
+		})
+	}
+}
+
+func TestSequence(t *testing.T) {
+	tests := []struct {
+		name       string
+		faces      []uint
+		dice       []dice.Dice
+		want       []uint8
+		wantErrCnt int
+	}{
+		{
+			name: "AllConsumed", faces: []uint{3, 20, 1},
+			dice: []dice.Dice{dice.D6, dice.D20, dice.D4},
+			want: []uint8{3, 20, 1}, wantErrCnt: 0,
+		},
+		{
+			name: "Unconsumed", faces: []uint{3, 20, 1},
+			dice: []dice.Dice{dice.D6},
+			want: []uint8{3}, wantErrCnt: 1,
+		},
+		{
+			name: "OverConsumed", faces: []uint{3},
+			dice: []dice.Dice{dice.D6, dice.D6, dice.D6},
+			want: []uint8{3, 1, 1}, wantErrCnt: 2,
+		},
+		{
+			name: "FaceOutOfRange", faces: []uint{8, 0},
+			dice: []dice.Dice{dice.D6, dice.D6},
+			want: []uint8{1, 1}, wantErrCnt: 2,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tb := &recordingTB{TB: t, errs: nil, cleanups: nil}
+			got := roll(NewSequence(tb, test.faces...), test.dice...)
+			tb.runCleanups()
+
+			if !slices.Equal(got, test.want) {
+				t.Errorf("Sequence: want rolls %v, got %v", test.want, got)
+			}
+			if len(tb.errs) != test.wantErrCnt {
+				t.Errorf(
+					"Sequence: want %d errors, got %d: %q",
+					test.wantErrCnt, len(tb.errs), tb.errs,
+				)
+			}
+		})
+	}
+}
+
+func TestSequenceRemaining(t *testing.T) {
+	tb := &recordingTB{TB: t, errs: nil, cleanups: nil}
+	rng := NewSequence(tb, 1, 2, 3)
+	_ = roll(rng, dice.D6)
+	if got := rng.Remaining(); got != 2 {
+		t.Fatalf("Sequence.Remaining(): want 2, got %d", got)
+	}
+}
+
+func TestPerDie(t *testing.T) {
+	tests := []struct {
+		name       string
+		script     map[dice.Dice][]uint
+		dice       []dice.Dice
+		want       []uint8
+		wantErrCnt int
+	}{
+		{
+			name:   "InterleavedDice",
+			script: map[dice.Dice][]uint{dice.D6: {5, 2}, dice.D20: {17}},
+			dice:   []dice.Dice{dice.D6, dice.D20, dice.D6},
+			want:   []uint8{5, 17, 2}, wantErrCnt: 0,
+		},
+		{
+			name:   "UnscriptedDie",
+			script: map[dice.Dice][]uint{dice.D6: {5}},
+			dice:   []dice.Dice{dice.D6, dice.D20},
+			want:   []uint8{5, 1}, wantErrCnt: 1,
+		},
+		{
+			name:   "Unconsumed",
+			script: map[dice.Dice][]uint{dice.D6: {5}, dice.D20: {17, 2}},
+			dice:   []dice.Dice{dice.D20},
+			want:   []uint8{17}, wantErrCnt: 1,
+		},
+		{
+			name:   "FaceOutOfRange",
+			script: map[dice.Dice][]uint{dice.D4: {6}},
+			dice:   []dice.Dice{dice.D4},
+			want:   []uint8{1}, wantErrCnt: 1,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tb := &recordingTB{TB: t, errs: nil, cleanups: nil}
+			got := roll(NewPerDie(tb, test.script), test.dice...)
+			tb.runCleanups()
+
+			if !slices.Equal(got, test.want) {
+				t.Errorf("PerDie: want rolls %v, got %v", test.want, got)
+			}
+			if len(tb.errs) != test.wantErrCnt {
+				t.Errorf(
+					"PerDie: want %d errors, got %d: %q",
+					test.wantErrCnt, len(tb.errs), tb.errs,
+				)
+			}
+		})
+	}
+}
+
+func TestPerDieCopiesScript(t *testing.T) {
+	script := map[dice.Dice][]uint{dice.D6: {5, 2}}
+	tb := &recordingTB{TB: t, errs: nil, cleanups: nil}
+	_ = roll(NewPerDie(tb, script), dice.D6, dice.D6)
+
+	if !slices.Equal(script[dice.D6], []uint{5, 2}) {
+		t.Fatalf("NewPerDie(): script mutated, got %v", script[dice.D6])
+	}
+}
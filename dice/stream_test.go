@@ -0,0 +1,108 @@
+package dice
+
+import (
+	"slices"
+	"sync"
+	"testing"
+)
+
+func rollMany(rng RNG, cnt int) []uint8 {
+	rolls := make([]uint8, cnt)
+	for i := range rolls {
+		rolls[i] = D20.Roll(rng)
+	}
+	return rolls
+}
+
+func TestStreamsReproducible(t *testing.T) {
+	tests := []struct {
+		name string
+		seed uint64
+		idx  uint64
+	}{
+		{name: "ZeroSeedZeroIdx", seed: 0, idx: 0},
+		{name: "ZeroSeed", seed: 0, idx: 42},
+		{name: "ZeroIdx", seed: 0x436169726E, idx: 0},
+		{name: "SeedAndIdx", seed: 0x436169726E, idx: 1234},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			first := rollMany(NewStreams(test.seed).Stream(test.idx), 100)
+			second := rollMany(NewStreams(test.seed).Stream(test.idx), 100)
+			if !slices.Equal(first, second) {
+				t.Fatalf(
+					"Streams.Stream(): rolls differ for the same seed and idx: %v, %v",
+					first, second,
+				)
+			}
+		})
+	}
+}
+
+func TestStreamsIndependent(t *testing.T) {
+	tests := []struct {
+		name   string
+		first  func() RNG
+		second func() RNG
+	}{
+		{
+			name:   "DifferentIdx",
+			first:  func() RNG { return NewStreams(7).Stream(0) },
+			second: func() RNG { return NewStreams(7).Stream(1) },
+		},
+		{
+			name:   "DifferentSeed",
+			first:  func() RNG { return NewStreams(7).Stream(0) },
+			second: func() RNG { return NewStreams(8).Stream(0) },
+		},
+		{
+			name:   "SplitDiffersFromStream",
+			first:  func() RNG { return NewStreams(7).Stream(0) },
+			second: func() RNG { return NewStreams(7).Split(0).Stream(0) },
+		},
+		{
+			name:   "DifferentSplit",
+			first:  func() RNG { return NewStreams(7).Split(0).Stream(0) },
+			second: func() RNG { return NewStreams(7).Split(1).Stream(0) },
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			first := rollMany(test.first(), 100)
+			second := rollMany(test.second(), 100)
+			if slices.Equal(first, second) {
+				t.Fatalf("Streams: want different rolls, got the same %v", first)
+			}
+		})
+	}
+}
+
+func TestStreamsParallel(t *testing.T) {
+	const streamsCnt = 16
+	streams := NewStreams(0x525047)
+
+	want := make([][]uint8, streamsCnt)
+	for i := range uint64(streamsCnt) {
+		want[i] = rollMany(streams.Stream(i), 100)
+	}
+
+	got := make([][]uint8, streamsCnt)
+	var wg sync.WaitGroup
+	for i := range uint64(streamsCnt) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			got[i] = rollMany(streams.Stream(i), 100)
+		}()
+	}
+	wg.Wait()
+
+	for i := range want {
+		if !slices.Equal(want[i], got[i]) {
+			t.Errorf(
+				"Streams.Stream(%d): parallel rolls differ: want %v, got %v",
+				i, want[i], got[i],
+			)
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package dice
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// TableRNG is an RNG backed by physical dice rolled at the table. For every
+// UintN(n) request it prompts for a dN roll on the writer and reads the rolled
+// value from the reader, re-prompting until the value is in [1,n].
+//
+// If the roll was announced (see Announcer), the prompt includes what the roll
+// is for, e.g. "roll d20 for Root Goblin's morale save: ". The announcement
+// applies to all the rolls until it's cleared with Announce("") (see
+// Unannounce), so every die of a dice pool is prompted with the same
+// description and the rolls after the pool aren't.
+//
+// UintN panics if the reader is exhausted or fails because the RNG interface
+// leaves no room for an error.
+type TableRNG struct {
+	in   *bufio.Reader
+	out  io.Writer
+	what string
+}
+
+// NewTableRNG creates a new TableRNG reading rolls from in and writing prompts
+// to out.
+func NewTableRNG(in io.Reader, out io.Writer) *TableRNG {
+	return &TableRNG{in: bufio.NewReader(in), out: out, what: ""}
+}
+
+// Announce describes the next roll.
+func (t *TableRNG) Announce(what string) {
+	t.what = what
+}
+
+// UintN prompts for a dN roll and returns the rolled value minus one, so the
+// result is in the half-open interval [0,n). It panics if n == 0.
+func (t *TableRNG) UintN(n uint) uint {
+	if n == 0 {
+		panic(errors.New("TableRNG: n must be greater than 0"))
+	}
+
+	prompt := fmt.Sprintf("roll d%d: ", n)
+	if len(t.what) > 0 {
+		prompt = fmt.Sprintf("roll d%d for %s: ", n, t.what)
+	}
+
+	for {
+		// Prompts are best effort: a broken writer doesn't prevent reading rolls.
+		_, _ = io.WriteString(t.out, prompt)
+
+		line, err := t.in.ReadString('\n')
+		if err != nil && (!errors.Is(err, io.EOF) || len(line) == 0) {
+			if errors.Is(err, io.EOF) {
+				err = io.ErrUnexpectedEOF
+			}
+			panic(fmt.Errorf("TableRNG: failed to read a d%d roll: %w", n, err))
+		}
+
+		input := strings.TrimSpace(line)
+		value, err := strconv.ParseUint(input, 10, 0)
+		if err != nil || value < 1 || value > uint64(n) {
+			_, _ = fmt.Fprintf(
+				t.out, "invalid roll %q, want a number from 1 to %d\n", input, n,
+			)
+			continue
+		}
+
+		return uint(value) - 1
+	}
+}
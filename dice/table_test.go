@@ -0,0 +1,126 @@
+package dice
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestTableRNG(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		announce string
+		dice     Dice
+		want     uint8
+		wantOut  string
+	}{
+		{
+			name:     "ValidRoll",
+			input:    "7\n",
+			announce: "",
+			dice:     D20,
+			want:     7,
+			wantOut:  "roll d20: ",
+		},
+		{
+			name:     "Announced",
+			input:    "3\n",
+			announce: "Root Goblin's morale save",
+			dice:     D20,
+			want:     3,
+			wantOut:  "roll d20 for Root Goblin's morale save: ",
+		},
+		{
+			name:     "SurroundingSpaces",
+			input:    "  4 \r\n",
+			announce: "",
+			dice:     D6,
+			want:     4,
+			wantOut:  "roll d6: ",
+		},
+		{
+			name:     "NoTrailingNewline",
+			input:    "6",
+			announce: "",
+			dice:     D6,
+			want:     6,
+			wantOut:  "roll d6: ",
+		},
+		{
+			name:     "RepromptOnBadInput",
+			input:    "seven\n0\n7\n\n2\n",
+			announce: "Spear",
+			dice:     D6,
+			want:     2,
+			wantOut: "roll d6 for Spear: " +
+				"invalid roll \"seven\", want a number from 1 to 6\n" +
+				"roll d6 for Spear: " +
+				"invalid roll \"0\", want a number from 1 to 6\n" +
+				"roll d6 for Spear: " +
+				"invalid roll \"7\", want a number from 1 to 6\n" +
+				"roll d6 for Spear: " +
+				"invalid roll \"\", want a number from 1 to 6\n" +
+				"roll d6 for Spear: ",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var out strings.Builder
+			rng := NewTableRNG(strings.NewReader(test.input), &out)
+			rng.Announce(test.announce)
+
+			got := test.dice.Roll(rng)
+			if got != test.want {
+				t.Errorf("TableRNG: want roll %d, got %d", test.want, got)
+			}
+			if out.String() != test.wantOut {
+				t.Errorf("TableRNG: want output %q, got %q", test.wantOut, out.String())
+			}
+		})
+	}
+}
+
+func TestTableRNGAnnouncementSticks(t *testing.T) {
+	var out strings.Builder
+	rng := NewTableRNG(strings.NewReader("1\n2\n3\n"), &out)
+
+	Announcef(rng, "%s", "Spear")
+	_ = D6.Roll(rng)
+	_ = D6.Roll(rng)
+	Unannounce(rng)
+	_ = D6.Roll(rng)
+
+	want := "roll d6 for Spear: roll d6 for Spear: roll d6: "
+	if out.String() != want {
+		t.Fatalf("TableRNG: want output %q, got %q", want, out.String())
+	}
+}
+
+func TestTableRNGPanicsOnExhaustedInput(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{name: "EmptyInput", input: ""},
+		{name: "OnlyBadInput", input: "42\n"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			defer func() {
+				r := recover()
+				if r == nil {
+					t.Fatalf("TableRNG: want panic, got none")
+				}
+				err, ok := r.(error)
+				if !ok || !errors.Is(err, io.ErrUnexpectedEOF) {
+					t.Fatalf("TableRNG: want io.ErrUnexpectedEOF panic, got %v", r)
+				}
+			}()
+
+			rng := NewTableRNG(strings.NewReader(test.input), io.Discard)
+			_ = D20.Roll(rng)
+		})
+	}
+}
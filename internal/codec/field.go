@@ -0,0 +1,91 @@
+// Package codec provides the encoding building blocks shared by the game's
+// types: field errors with paths, strict JSON decoding, YAML through the JSON
+// encodings, and the text encodings of enums and sets of flags.
+package codec
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// FieldError is an error of encoding or decoding a field of a value. Path is
+// the path to the field from the value, e.g. "attacks[0].dice".
+type FieldError struct {
+	Err  error
+	Path string
+}
+
+// Error returns the path to the field followed by the error.
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("%s: %v", e.Path, e.Err)
+}
+
+// Unwrap returns the error of the field.
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// WrapField returns the error of the named field of a value, e.g. "dice" or
+// "attacks[0]", as a *FieldError. Field errors are prefixed with the name to
+// make the full path and joined errors are wrapped one by one, so the result
+// is a flat join of field errors. It returns nil if err is nil.
+func WrapField(name string, err error) error {
+	// Suppressing errorlint "type switch on error will fail on wrapped errors"
+	// because only the error itself makes the path, the errors it wraps keep
+	// their own context.
+	switch e := err.(type) { // nolint:errorlint
+	case nil:
+		return nil
+	case *FieldError:
+		sep := "."
+		if strings.HasPrefix(e.Path, "[") {
+			sep = ""
+		}
+		return &FieldError{Err: e.Err, Path: name + sep + e.Path}
+	case interface{ Unwrap() []error }:
+		errs := e.Unwrap()
+		wrapped := make([]error, 0, len(errs))
+		for _, inner := range errs {
+			wrapped = append(wrapped, WrapField(name, inner))
+		}
+		return errors.Join(wrapped...)
+	default:
+		return &FieldError{Err: err, Path: name}
+	}
+}
+
+// DecodeJSON decodes the JSON data into v. Unknown fields are reported as
+// errors to catch typos. Errors of a particular field, e.g. a string instead
+// of a number, are reported as *FieldError.
+func DecodeJSON(data []byte, v any) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	err := decoder.Decode(v)
+	if err == nil {
+		return nil
+	}
+
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) && len(typeErr.Field) > 0 {
+		return &FieldError{
+			Err: fmt.Errorf(
+				"cannot decode JSON %s into %s", typeErr.Value, typeErr.Type,
+			),
+			Path: typeErr.Field,
+		}
+	}
+
+	// The json package has no error type for unknown fields, their errors are
+	// recognized by the message.
+	if quoted, ok := strings.CutPrefix(err.Error(), "json: unknown field "); ok {
+		if field, err := strconv.Unquote(quoted); err == nil {
+			return &FieldError{Err: errors.New("unknown field"), Path: field}
+		}
+	}
+
+	return fmt.Errorf("failed to decode JSON: %w", err)
+}
@@ -0,0 +1,139 @@
+package codec
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestFieldErrorError(t *testing.T) {
+	err := &FieldError{Err: errors.New("unknown dice"), Path: "attacks[0].dice"}
+	if got, want := err.Error(), "attacks[0].dice: unknown dice"; got != want {
+		t.Fatalf("FieldError.Error() = %q, want %q", got, want)
+	}
+}
+
+func TestWrapField(t *testing.T) {
+	cause := errors.New("cause")
+	tests := []struct {
+		err       error
+		name      string
+		field     string
+		wantPaths []string
+	}{
+		{name: "Nil", field: "dice", err: nil, wantPaths: nil},
+		{
+			name: "Plain", field: "dice", err: cause,
+			wantPaths: []string{"dice"},
+		},
+		{
+			name: "Field", field: "attacks[0]",
+			err:       &FieldError{Err: cause, Path: "dice"},
+			wantPaths: []string{"attacks[0].dice"},
+		},
+		{
+			name: "Index", field: "attacks",
+			err:       &FieldError{Err: cause, Path: "[1].dice"},
+			wantPaths: []string{"attacks[1].dice"},
+		},
+		{
+			name: "Joined", field: "recharge",
+			err: errors.Join(
+				&FieldError{Err: cause, Path: "kind"},
+				&FieldError{Err: cause, Path: "dice"},
+			),
+			wantPaths: []string{"recharge.kind", "recharge.dice"},
+		},
+		{
+			name: "Nested", field: "items[2]",
+			err: WrapField("weapon", errors.Join(
+				cause, &FieldError{Err: cause, Path: "target"},
+			)),
+			wantPaths: []string{"items[2].weapon", "items[2].weapon.target"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := paths(t, WrapField(test.field, test.err))
+			if !slices.Equal(got, test.wantPaths) {
+				t.Fatalf("WrapField() paths = %v, want %v", got, test.wantPaths)
+			}
+		})
+	}
+}
+
+func TestWrapFieldKeepsCause(t *testing.T) {
+	cause := errors.New("cause")
+	err := WrapField("attacks[0]", WrapField("dice", cause))
+	if !errors.Is(err, cause) {
+		t.Fatalf("WrapField() = %v, must wrap %v", err, cause)
+	}
+}
+
+func TestDecodeJSON(t *testing.T) {
+	type value struct {
+		Name string `json:"name"`
+		Cnt  uint8  `json:"cnt"`
+	}
+	tests := []struct {
+		name      string
+		data      string
+		wantPaths []string
+		wantErr   bool
+	}{
+		{
+			name: "Valid", data: `{"name": "Knife", "cnt": 1}`,
+			wantPaths: nil, wantErr: false,
+		},
+		{
+			name: "WrongType", data: `{"name": "Knife", "cnt": "one"}`,
+			wantPaths: []string{"cnt"}, wantErr: true,
+		},
+		{
+			name: "OutOfRange", data: `{"cnt": 256}`,
+			wantPaths: []string{"cnt"}, wantErr: true,
+		},
+		{
+			name: "UnknownField", data: `{"name": "Knife", "count": 1}`,
+			wantPaths: []string{"count"}, wantErr: true,
+		},
+		{
+			name: "Syntax", data: `{"name": "Knife",`,
+			wantPaths: nil, wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var v value
+			err := DecodeJSON([]byte(test.data), &v)
+			if (err != nil) != test.wantErr {
+				t.Fatalf(
+					"DecodeJSON() error = %v, wantErr %t", err, test.wantErr,
+				)
+			}
+			if got := paths(t, err); !slices.Equal(got, test.wantPaths) {
+				t.Fatalf("DecodeJSON() paths = %v, want %v", got, test.wantPaths)
+			}
+		})
+	}
+}
+
+// paths returns the paths of the field errors joined in err, joined errors
+// are flattened.
+func paths(t *testing.T, err error) []string {
+	t.Helper()
+
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		var result []string
+		for _, err := range joined.Unwrap() {
+			result = append(result, paths(t, err)...)
+		}
+		return result
+	}
+
+	var fieldErr *FieldError
+	if errors.As(err, &fieldErr) {
+		return []string{fieldErr.Path}
+	}
+	return nil
+}
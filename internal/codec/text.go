@@ -0,0 +1,70 @@
+package codec
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Enum is a type with a fixed set of known values, each with a string
+// representation.
+type Enum interface {
+	~uint8
+	fmt.Stringer
+}
+
+// MarshalText encodes the value as its string representation. It returns an
+// error naming the type as what if the value isn't among the known ones.
+func MarshalText[T Enum](what string, value T, known ...T) ([]byte, error) {
+	if !slices.Contains(known, value) {
+		return nil, fmt.Errorf("unknown %s: %d", what, value)
+	}
+	return []byte(value.String()), nil
+}
+
+// UnmarshalText decodes the known value whose string representation matches
+// the text, case-insensitive. It returns an error naming the type as what if
+// none of the known values matches.
+func UnmarshalText[T Enum](what string, text []byte, known ...T) (T, error) {
+	for _, value := range known {
+		if strings.EqualFold(string(text), value.String()) {
+			return value, nil
+		}
+	}
+	var none T
+	return none, fmt.Errorf("unknown %s %q", what, text)
+}
+
+// MarshalSet encodes the set of flags as its string representation, e.g.
+// "Ranged|Reach". It returns an error naming the type as what if the set has
+// any flags not included in all.
+func MarshalSet[T Enum](what string, set, all T) ([]byte, error) {
+	if unknown := set &^ all; unknown != 0 {
+		return nil, fmt.Errorf("unknown %s: 0x%02x", what, uint8(unknown))
+	}
+	return []byte(set.String()), nil
+}
+
+// UnmarshalSet decodes a set of the known flags encoded as "Ranged|Reach",
+// case-insensitive, the empty set is encoded as its string representation. It
+// returns an error naming the type as what for every unknown flag.
+func UnmarshalSet[T Enum](
+	what string, text []byte, empty T, known ...T,
+) (T, error) {
+	if strings.EqualFold(string(text), empty.String()) {
+		return empty, nil
+	}
+
+	set := empty
+	var errs []error
+	for _, part := range strings.Split(string(text), "|") {
+		flag, err := UnmarshalText(what, []byte(strings.TrimSpace(part)), known...)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		set |= flag
+	}
+	return set, errors.Join(errs...)
+}
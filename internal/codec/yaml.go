@@ -0,0 +1,50 @@
+package codec
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+// EncodeYAML converts the JSON encoding of a value to a YAML node, so the
+// value's yaml.Marshaler implementation can reuse its json.Marshaler one. The
+// styles of the JSON are dropped to let the YAML encoder pick its own ones.
+func EncodeYAML(v json.Marshaler) (*yaml.Node, error) {
+	data, err := v.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	// JSON is a subset of YAML, so the JSON encoding is always decoded.
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("failed to convert JSON to YAML: %w", err)
+	}
+	resetStyles(&doc)
+	return doc.Content[0], nil
+}
+
+// resetStyles resets the styles of the node and all its children.
+func resetStyles(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		resetStyles(child)
+	}
+}
+
+// DecodeYAML converts the YAML node to JSON and decodes it into the value, so
+// the value's yaml.Unmarshaler implementation can reuse its json.Unmarshaler
+// one, including the *FieldError paths of the errors.
+func DecodeYAML(node *yaml.Node, v json.Unmarshaler) error {
+	var raw any
+	if err := node.Decode(&raw); err != nil {
+		return fmt.Errorf("failed to decode YAML: %w", err)
+	}
+
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return fmt.Errorf("failed to convert YAML to JSON: %w", err)
+	}
+	return v.UnmarshalJSON(data)
+}
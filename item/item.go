@@ -0,0 +1,233 @@
+// Package item provides inventory items - weapons, armor pieces, and gear.
+// Each item takes an inventory slot, bulky items take two.
+package item
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/rozag/cabasi/atk"
+	"github.com/rozag/cabasi/internal/codec"
+)
+
+const (
+	// Slots is the number of inventory slots an item takes.
+	Slots = 1
+	// BulkySlots is the number of inventory slots a bulky item takes.
+	BulkySlots = 2
+)
+
+// Kind represents the kind of an item.
+type Kind uint8
+
+const (
+	// KindGear is an item with no effect in a battle, e.g. a rope or a torch.
+	KindGear Kind = iota
+	// KindWeapon is an item making an attack when equipped.
+	KindWeapon
+	// KindArmor is a body armor protecting its wearer when equipped.
+	KindArmor
+	// KindShield is a shield protecting its bearer when equipped.
+	KindShield
+	// KindHelmet is a helmet protecting its wearer when equipped.
+	KindHelmet
+)
+
+// String returns the string representation of the Kind.
+func (k Kind) String() string {
+	switch k {
+	case KindGear:
+		return "Gear"
+	case KindWeapon:
+		return "Weapon"
+	case KindArmor:
+		return "Armor"
+	case KindShield:
+		return "Shield"
+	case KindHelmet:
+		return "Helmet"
+	default:
+		panic(fmt.Errorf("unknown Kind: %d", k))
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler, the Kind is encoded as its
+// string representation, e.g. "Weapon".
+func (k Kind) MarshalText() ([]byte, error) {
+	return codec.MarshalText("kind", k, kinds()...)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, it decodes a Kind encoded
+// as its string representation, case-insensitive.
+func (k *Kind) UnmarshalText(text []byte) error {
+	value, err := codec.UnmarshalText("kind", text, kinds()...)
+	if err != nil {
+		return err
+	}
+	*k = value
+	return nil
+}
+
+// kinds returns all the known item kinds.
+func kinds() []Kind {
+	return []Kind{KindGear, KindWeapon, KindArmor, KindShield, KindHelmet}
+}
+
+// IsProtective checks if items of the Kind add their armor to the wearer's.
+func (k Kind) IsProtective() bool {
+	switch k {
+	case KindArmor, KindShield, KindHelmet:
+		return true
+	case KindGear, KindWeapon:
+		return false
+	default:
+		panic(fmt.Errorf("unknown Kind: %d", k))
+	}
+}
+
+// Item represents an inventory item. Weapon is the attack a weapon makes, it
+// must be set for weapons only. Armor is the armor an armor piece adds to its
+// wearer's, it must be set for armor pieces only. Only equipped items take
+// effect, but every item takes inventory slots.
+//
+// AttackIdx is the 1-based index of the attack a weapon added to its owner's
+// attacks when equipped, 0 means it added none. It's kept until the next
+// creat.Creature.Equip, even if the weapon is unequipped.
+type Item struct {
+	Name       string
+	Weapon     *atk.Attack
+	Kind       Kind
+	Armor      uint8
+	AttackIdx  uint8
+	IsBulky    bool
+	IsEquipped bool
+}
+
+// String returns the string representation of the Item.
+func (i *Item) String() string {
+	weapon := "nil"
+	if i.Weapon != nil {
+		weapon = i.Weapon.String()
+	}
+	return fmt.Sprintf(
+		"Item{"+
+			"Name: %q"+
+			", Weapon: %s"+
+			", Kind: %s"+
+			", Armor: %d"+
+			", AttackIdx: %d"+
+			", IsBulky: %t"+
+			", IsEquipped: %t"+
+			"}",
+		i.Name,
+		weapon,
+		i.Kind,
+		i.Armor,
+		i.AttackIdx,
+		i.IsBulky,
+		i.IsEquipped,
+	)
+}
+
+// Validate checks if the freshly created item is valid. It returns an error
+// with `Unwrap() []error` method to get all the errors or `nil` if the item is
+// valid. The errors are reported as *codec.FieldError, e.g. "weapon.diceCnt".
+func (i *Item) Validate() error {
+	var errs []error
+
+	if len(i.Name) == 0 {
+		errs = append(
+			errs, codec.WrapField("name", errors.New("item must have a name")),
+		)
+	}
+
+	switch i.Kind {
+	case KindGear, KindWeapon, KindArmor, KindShield, KindHelmet:
+		if i.Kind == KindWeapon && i.Weapon == nil {
+			errs = append(errs, codec.WrapField(
+				"weapon", errors.New("weapon must have an attack"),
+			))
+		}
+
+		if i.Kind != KindWeapon && i.Weapon != nil {
+			errs = append(errs, codec.WrapField(
+				"weapon", fmt.Errorf("only weapons can have an attack, got %s", i.Kind),
+			))
+		}
+
+		if i.Kind.IsProtective() && i.Armor == 0 {
+			errs = append(errs, codec.WrapField(
+				"armor", fmt.Errorf("%s must have armor", i.Kind),
+			))
+		}
+
+		if !i.Kind.IsProtective() && i.Armor != 0 {
+			errs = append(errs, codec.WrapField(
+				"armor", fmt.Errorf("only armor pieces can have armor, got %s", i.Kind),
+			))
+		}
+	default:
+		errs = append(
+			errs, codec.WrapField("kind", fmt.Errorf("invalid kind: %d", i.Kind)),
+		)
+	}
+
+	if i.Weapon != nil {
+		if err := i.Weapon.Validate(); err != nil {
+			errs = append(errs, codec.WrapField("weapon", err))
+		}
+
+		if i.Weapon.Traits.Has(atk.TraitBulky) && !i.IsBulky {
+			errs = append(errs, codec.WrapField(
+				"isBulky", errors.New("weapon with Bulky trait must be bulky"),
+			))
+		}
+	}
+
+	if i.AttackIdx != 0 && i.Weapon == nil {
+		errs = append(errs, codec.WrapField(
+			"attackIdx", errors.New("only weapons can add an attack"),
+		))
+	}
+
+	return errors.Join(errs...)
+}
+
+// Slots returns the number of inventory slots the Item takes.
+func (i *Item) Slots() uint8 {
+	if i.IsBulky {
+		return BulkySlots
+	}
+	return Slots
+}
+
+// Equals checks if the Item is equal to the other Item.
+func (i *Item) Equals(other *Item) bool {
+	return i.Name == other.Name &&
+		i.Kind == other.Kind &&
+		i.Armor == other.Armor &&
+		i.AttackIdx == other.AttackIdx &&
+		i.IsBulky == other.IsBulky &&
+		i.IsEquipped == other.IsEquipped &&
+		(i.Weapon == other.Weapon ||
+			(i.Weapon != nil && other.Weapon != nil &&
+				i.Weapon.Equals(other.Weapon)))
+}
+
+// DeepCopy creates a deep copy of the Item.
+func (i *Item) DeepCopy() Item {
+	var weapon *atk.Attack
+	if i.Weapon != nil {
+		copied := i.Weapon.DeepCopy()
+		weapon = &copied
+	}
+	return Item{
+		Name:       i.Name,
+		Weapon:     weapon,
+		Kind:       i.Kind,
+		Armor:      i.Armor,
+		AttackIdx:  i.AttackIdx,
+		IsBulky:    i.IsBulky,
+		IsEquipped: i.IsEquipped,
+	}
+}
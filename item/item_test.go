@@ -0,0 +1,362 @@
+package item
+
+import (
+	"testing"
+
+	"github.com/rozag/cabasi/atk"
+	"github.com/rozag/cabasi/dice"
+)
+
+func TestKindUnknownPanics(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Fatalf("Kind.String(): want panic for unknown Kind, got none")
+		}
+	}()
+	_ = Kind(42).String()
+}
+
+func TestKindIsProtective(t *testing.T) {
+	tests := []struct {
+		kind Kind
+		want bool
+	}{
+		{kind: KindGear, want: false},
+		{kind: KindWeapon, want: false},
+		{kind: KindArmor, want: true},
+		{kind: KindShield, want: true},
+		{kind: KindHelmet, want: true},
+	}
+	for _, test := range tests {
+		t.Run(test.kind.String(), func(t *testing.T) {
+			if got := test.kind.IsProtective(); got != test.want {
+				t.Fatalf("Kind.IsProtective() = %t, want %t", got, test.want)
+			}
+		})
+	}
+}
+
+func TestItemValidate(t *testing.T) {
+	sword := Item{
+		Name: "Longsword",
+		Weapon: &atk.Attack{
+			Name: "Longsword", TargetCharacteristic: atk.STR,
+			Dice: dice.D10, DiceCnt: 1, Charges: -1,
+			IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitBulky,
+			PairedWith: 0,
+			Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+			Recharge:   nil,
+			DmgMod:     0,
+			MinDmg:     0,
+			Usage:      atk.UsageAlways,
+			Area:       atk.AreaZone,
+			MaxTargets: 0,
+		},
+		Kind: KindWeapon, Armor: 0, IsBulky: true, IsEquipped: true, AttackIdx: 0,
+	}
+	shield := Item{
+		Name: "Shield", Weapon: nil, Kind: KindShield, Armor: 1,
+		IsBulky: false, IsEquipped: true, AttackIdx: 0,
+	}
+	tests := []struct {
+		name       string
+		item       Item
+		modify     func(it *Item)
+		wantErrCnt uint
+	}{
+		{
+			name: "ValidWeapon", item: sword,
+			modify: func(*Item) {}, wantErrCnt: 0,
+		},
+		{
+			name: "ValidShield", item: shield,
+			modify: func(*Item) {}, wantErrCnt: 0,
+		},
+		{
+			name: "ValidGear", item: shield,
+			modify: func(it *Item) {
+				it.Name, it.Kind, it.Armor = "Rope", KindGear, 0
+			},
+			wantErrCnt: 0,
+		},
+		{
+			name: "EmptyName", item: shield,
+			modify: func(it *Item) { it.Name = "" }, wantErrCnt: 1,
+		},
+		{
+			name: "UnknownKind", item: shield,
+			modify: func(it *Item) { it.Kind = Kind(42) }, wantErrCnt: 1,
+		},
+		{
+			name: "WeaponWithoutAttack", item: sword,
+			modify: func(it *Item) { it.Weapon = nil }, wantErrCnt: 1,
+		},
+		{
+			name: "GearWithAttack", item: sword,
+			modify: func(it *Item) { it.Kind = KindGear }, wantErrCnt: 1,
+		},
+		{
+			name: "ArmorWithoutArmor", item: shield,
+			modify:     func(it *Item) { it.Kind, it.Armor = KindArmor, 0 },
+			wantErrCnt: 1,
+		},
+		{
+			name: "WeaponWithArmor", item: sword,
+			modify: func(it *Item) { it.Armor = 1 }, wantErrCnt: 1,
+		},
+		{
+			name: "InvalidWeapon", item: sword,
+			modify: func(it *Item) { it.Weapon.DiceCnt = 0 }, wantErrCnt: 1,
+		},
+		{
+			name: "BulkyWeaponNotBulky", item: sword,
+			modify: func(it *Item) { it.IsBulky = false }, wantErrCnt: 1,
+		},
+		{
+			name: "UnequippedWeaponWithAttackIdx", item: sword,
+			modify:     func(it *Item) { it.IsEquipped, it.AttackIdx = false, 1 },
+			wantErrCnt: 0,
+		},
+		{
+			name: "ShieldWithAttackIdx", item: shield,
+			modify: func(it *Item) { it.AttackIdx = 1 }, wantErrCnt: 1,
+		},
+		{
+			name: "MultipleErrors", item: sword,
+			modify: func(it *Item) {
+				it.Name, it.Kind, it.Armor = "", KindHelmet, 0
+			},
+			wantErrCnt: 3,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			it := test.item.DeepCopy()
+			test.modify(&it)
+			err := it.Validate()
+
+			if test.wantErrCnt == 0 {
+				if err != nil {
+					t.Fatalf("Item.Validate(): want nil, got %v", err)
+				} else {
+					return
+				}
+			}
+
+			if err == nil {
+				t.Fatalf("Item.Validate(): want error, got nil")
+			}
+
+			jointErr, ok := err.(interface{ Unwrap() []error })
+			if !ok {
+				t.Fatalf("Item.Validate(): error must have `Unwrap() []error` method")
+			}
+
+			errs := jointErr.Unwrap()
+			if uint(len(errs)) != test.wantErrCnt {
+				t.Fatalf(
+					"Item.Validate(): want %d errors, got %d: %v",
+					test.wantErrCnt, len(errs), err,
+				)
+			}
+		})
+	}
+}
+
+func TestItemSlots(t *testing.T) {
+	sword := Item{
+		Name: "Longsword",
+		Weapon: &atk.Attack{
+			Name: "Longsword", TargetCharacteristic: atk.STR,
+			Dice: dice.D10, DiceCnt: 1, Charges: -1,
+			IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitBulky,
+			PairedWith: 0,
+			Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+			Recharge:   nil,
+			DmgMod:     0,
+			MinDmg:     0,
+			Usage:      atk.UsageAlways,
+			Area:       atk.AreaZone,
+			MaxTargets: 0,
+		},
+		Kind: KindWeapon, Armor: 0, IsBulky: true, IsEquipped: true, AttackIdx: 0,
+	}
+	shield := Item{
+		Name: "Shield", Weapon: nil, Kind: KindShield, Armor: 1,
+		IsBulky: false, IsEquipped: true, AttackIdx: 0,
+	}
+	if got := sword.Slots(); got != BulkySlots {
+		t.Errorf("Item.Slots(): want %d for bulky item, got %d", BulkySlots, got)
+	}
+	if got := shield.Slots(); got != Slots {
+		t.Errorf("Item.Slots(): want %d, got %d", Slots, got)
+	}
+}
+
+func TestItemEquals(t *testing.T) {
+	sword := Item{
+		Name: "Longsword",
+		Weapon: &atk.Attack{
+			Name: "Longsword", TargetCharacteristic: atk.STR,
+			Dice: dice.D10, DiceCnt: 1, Charges: -1,
+			IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitBulky,
+			PairedWith: 0,
+			Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+			Recharge:   nil,
+			DmgMod:     0,
+			MinDmg:     0,
+			Usage:      atk.UsageAlways,
+			Area:       atk.AreaZone,
+			MaxTargets: 0,
+		},
+		Kind: KindWeapon, Armor: 0, IsBulky: true, IsEquipped: true, AttackIdx: 0,
+	}
+	shield := Item{
+		Name: "Shield", Weapon: nil, Kind: KindShield, Armor: 1,
+		IsBulky: false, IsEquipped: true, AttackIdx: 0,
+	}
+	tests := []struct {
+		name   string
+		item   Item
+		modify func(it *Item)
+		want   bool
+	}{
+		{name: "Equal", item: sword, modify: func(*Item) {}, want: true},
+		{
+			name: "EqualWithoutWeapon", item: shield,
+			modify: func(*Item) {}, want: true,
+		},
+		{
+			name: "DifferentName", item: sword,
+			modify: func(it *Item) { it.Name = "Greatsword" }, want: false,
+		},
+		{
+			name: "DifferentWeapon", item: sword,
+			modify: func(it *Item) { it.Weapon.Dice = dice.D12 }, want: false,
+		},
+		{
+			name: "NilWeapon", item: sword,
+			modify: func(it *Item) { it.Weapon = nil }, want: false,
+		},
+		{
+			name: "DifferentKind", item: shield,
+			modify: func(it *Item) { it.Kind = KindHelmet }, want: false,
+		},
+		{
+			name: "DifferentArmor", item: shield,
+			modify: func(it *Item) { it.Armor = 2 }, want: false,
+		},
+		{
+			name: "DifferentIsBulky", item: shield,
+			modify: func(it *Item) { it.IsBulky = true }, want: false,
+		},
+		{
+			name: "DifferentIsEquipped", item: shield,
+			modify: func(it *Item) { it.IsEquipped = false }, want: false,
+		},
+		{
+			name: "DifferentAttackIdx", item: sword,
+			modify: func(it *Item) { it.AttackIdx = 1 }, want: false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			this := test.item
+			other := test.item.DeepCopy()
+			test.modify(&other)
+			if got := this.Equals(&other); got != test.want {
+				t.Fatalf("Item.Equals() = %t, want %t", got, test.want)
+			}
+		})
+	}
+}
+
+func TestItemDeepCopy(t *testing.T) {
+	original := Item{
+		Name: "Longsword",
+		Weapon: &atk.Attack{
+			Name: "Longsword", TargetCharacteristic: atk.STR,
+			Dice: dice.D10, DiceCnt: 1, Charges: -1,
+			IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitBulky,
+			PairedWith: 0,
+			Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+			Recharge:   nil,
+			DmgMod:     0,
+			MinDmg:     0,
+			Usage:      atk.UsageAlways,
+			Area:       atk.AreaZone,
+			MaxTargets: 0,
+		},
+		Kind: KindWeapon, Armor: 0, IsBulky: true, IsEquipped: true, AttackIdx: 0,
+	}
+	copied := original.DeepCopy()
+
+	if !original.Equals(&copied) {
+		t.Fatalf("Item.DeepCopy() = %v, want %v", copied, original)
+	}
+
+	copied.Name = "Greatsword"
+	copied.Weapon.Dice = dice.D12
+	copied.Kind = KindGear
+	copied.Armor = 1
+	copied.IsBulky = false
+	copied.IsEquipped = false
+
+	if original.Equals(&copied) {
+		t.Errorf("modifying the copy affected the original: %v", original)
+	}
+
+	if original.Name == copied.Name {
+		t.Errorf("original.Name == copied.Name")
+	}
+	if original.Weapon.Equals(copied.Weapon) {
+		t.Errorf("original.Weapon == copied.Weapon")
+	}
+	if original.Kind == copied.Kind {
+		t.Errorf("original.Kind == copied.Kind")
+	}
+	if original.Armor == copied.Armor {
+		t.Errorf("original.Armor == copied.Armor")
+	}
+	if original.IsBulky == copied.IsBulky {
+		t.Errorf("original.IsBulky == copied.IsBulky")
+	}
+	if original.IsEquipped == copied.IsEquipped {
+		t.Errorf("original.IsEquipped == copied.IsEquipped")
+	}
+}
+
+func TestKindText(t *testing.T) {
+	for _, kind := range []Kind{
+		KindGear, KindWeapon, KindArmor, KindShield, KindHelmet,
+	} {
+		t.Run(kind.String(), func(t *testing.T) {
+			text, err := kind.MarshalText()
+			if err != nil {
+				t.Fatalf("Kind.MarshalText() error = %v", err)
+			}
+			var decoded Kind
+			if err := decoded.UnmarshalText(text); err != nil {
+				t.Fatalf("Kind.UnmarshalText(%q) error = %v", text, err)
+			}
+			if decoded != kind {
+				t.Fatalf("Kind.UnmarshalText(%q) = %s, want %s", text, decoded, kind)
+			}
+		})
+	}
+
+	if _, err := Kind(42).MarshalText(); err == nil {
+		t.Fatal("Kind(42).MarshalText(): want error, got nil")
+	}
+
+	var kind Kind
+	if err := kind.UnmarshalText([]byte("helmet")); err != nil {
+		t.Fatalf("Kind.UnmarshalText(helmet) error = %v", err)
+	}
+	if kind != KindHelmet {
+		t.Fatalf("Kind.UnmarshalText(helmet) = %s, want Helmet", kind)
+	}
+	if err := kind.UnmarshalText([]byte("Tool")); err == nil {
+		t.Fatal("Kind.UnmarshalText(Tool): want error, got nil")
+	}
+}
@@ -0,0 +1,91 @@
+package item
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/rozag/cabasi/atk"
+	"github.com/rozag/cabasi/internal/codec"
+)
+
+// itemJSON is the JSON encoding of an Item. Weapon is omitted for items other
+// than weapons, and AttackIdx for items that added no attack.
+type itemJSON struct {
+	Name       string          `json:"name"`
+	Kind       string          `json:"kind"`
+	Weapon     json.RawMessage `json:"weapon,omitempty"`
+	Armor      uint8           `json:"armor"`
+	AttackIdx  uint8           `json:"attackIdx,omitempty"`
+	IsBulky    bool            `json:"isBulky"`
+	IsEquipped bool            `json:"isEquipped"`
+}
+
+// MarshalJSON implements json.Marshaler. Kind is encoded as its string
+// representation, e.g. "Weapon", and the weapon as an atk.Attack.
+func (i *Item) MarshalJSON() ([]byte, error) {
+	var errs []error
+
+	kind, err := i.Kind.MarshalText()
+	if err != nil {
+		errs = append(errs, codec.WrapField("kind", err))
+	}
+
+	var weapon []byte
+	if i.Weapon != nil {
+		if weapon, err = i.Weapon.MarshalJSON(); err != nil {
+			errs = append(errs, codec.WrapField("weapon", err))
+		}
+	}
+
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	data, err := json.Marshal(itemJSON{
+		Name: i.Name, Kind: string(kind), Weapon: weapon, Armor: i.Armor,
+		AttackIdx: i.AttackIdx, IsBulky: i.IsBulky, IsEquipped: i.IsEquipped,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode item: %w", err)
+	}
+	return data, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Errors of the fields are
+// reported as *codec.FieldError. The decoded item isn't validated, see
+// Validate.
+func (i *Item) UnmarshalJSON(data []byte) error {
+	var raw itemJSON
+	if err := codec.DecodeJSON(data, &raw); err != nil {
+		// Suppressing wrapcheck "error returned from external package is
+		// unwrapped" because the error already reports the failed field.
+		return err // nolint:wrapcheck
+	}
+
+	var errs []error
+	it := Item{
+		Name: raw.Name, Weapon: nil, Kind: KindGear, Armor: raw.Armor,
+		AttackIdx: raw.AttackIdx, IsBulky: raw.IsBulky,
+		IsEquipped: raw.IsEquipped,
+	}
+
+	if err := it.Kind.UnmarshalText([]byte(raw.Kind)); err != nil {
+		errs = append(errs, codec.WrapField("kind", err))
+	}
+
+	if len(raw.Weapon) > 0 && string(raw.Weapon) != "null" {
+		var weapon atk.Attack
+		if err := weapon.UnmarshalJSON(raw.Weapon); err != nil {
+			errs = append(errs, codec.WrapField("weapon", err))
+		}
+		it.Weapon = &weapon
+	}
+
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	*i = it
+	return nil
+}
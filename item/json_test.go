@@ -0,0 +1,135 @@
+package item
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/rozag/cabasi/atk"
+	"github.com/rozag/cabasi/dice"
+	"github.com/rozag/cabasi/internal/codec"
+)
+
+func TestItemMarshalJSON(t *testing.T) {
+	shield := Item{
+		Name: "Shield", Weapon: nil, Kind: KindShield, Armor: 1,
+		IsBulky: false, IsEquipped: true, AttackIdx: 0,
+	}
+	data, err := json.Marshal(&shield)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+
+	want := `{"name":"Shield","kind":"Shield","armor":1,` +
+		`"isBulky":false,"isEquipped":true}`
+	if string(data) != want {
+		t.Fatalf("json.Marshal() = %s, want %s", data, want)
+	}
+}
+
+func TestItemJSONRoundTrip(t *testing.T) {
+	sword := Item{
+		Name: "Longsword",
+		Weapon: &atk.Attack{
+			Name: "Longsword", TargetCharacteristic: atk.STR,
+			Dice: dice.D10, DiceCnt: 1, Charges: -1,
+			IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitBulky,
+			PairedWith: 0,
+			Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+			Recharge:   nil,
+			DmgMod:     0,
+			MinDmg:     0,
+			Usage:      atk.UsageAlways,
+			Area:       atk.AreaZone,
+			MaxTargets: 0,
+		},
+		Kind: KindWeapon, Armor: 0, IsBulky: true, IsEquipped: true, AttackIdx: 2,
+	}
+	shield := Item{
+		Name: "Shield", Weapon: nil, Kind: KindShield, Armor: 1,
+		IsBulky: false, IsEquipped: true, AttackIdx: 0,
+	}
+	for _, it := range []Item{sword, shield} {
+		t.Run(it.Name, func(t *testing.T) {
+			data, err := json.Marshal(&it)
+			if err != nil {
+				t.Fatalf("json.Marshal() error = %v", err)
+			}
+
+			var decoded Item
+			if err := json.Unmarshal(data, &decoded); err != nil {
+				t.Fatalf("json.Unmarshal(%s) error = %v", data, err)
+			}
+			if !decoded.Equals(&it) {
+				t.Fatalf(
+					"json.Unmarshal(%s) = %s, want %s",
+					data, decoded.String(), it.String(),
+				)
+			}
+		})
+	}
+}
+
+func TestItemMarshalJSONErrors(t *testing.T) {
+	sword := Item{
+		Name: "Longsword",
+		Weapon: &atk.Attack{
+			Name: "Longsword", TargetCharacteristic: atk.STR,
+			Dice: dice.D10, DiceCnt: 1, Charges: -1,
+			IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitBulky,
+			PairedWith: 0,
+			Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+			Recharge:   nil,
+			DmgMod:     0,
+			MinDmg:     0,
+			Usage:      atk.UsageAlways,
+			Area:       atk.AreaZone,
+			MaxTargets: 0,
+		},
+		Kind: KindWeapon, Armor: 0, IsBulky: true, IsEquipped: true, AttackIdx: 0,
+	}
+	sword.Weapon.Pool = atk.Pool(42)
+	_, err := sword.MarshalJSON()
+	var fieldErr *codec.FieldError
+	if !errors.As(err, &fieldErr) || fieldErr.Path != "weapon.pool" {
+		t.Fatalf("Item.MarshalJSON() error = %v, want weapon.pool error", err)
+	}
+}
+
+func TestItemUnmarshalJSONErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     string
+		wantPath string
+	}{
+		{
+			name:     "UnknownKind",
+			data:     `{"name": "Rope", "kind": "Tool"}`,
+			wantPath: "kind",
+		},
+		{
+			name: "InvalidWeapon",
+			data: `{"name": "Sword", "kind": "Weapon", ` +
+				`"weapon": {"name": "Sword", "dice": "d9"}}`,
+			wantPath: "weapon.dice",
+		},
+		{
+			name:     "WrongType",
+			data:     `{"name": "Shield", "kind": "Shield", "armor": "one"}`,
+			wantPath: "armor",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var got Item
+			err := got.UnmarshalJSON([]byte(test.data))
+			var fieldErr *codec.FieldError
+			if !errors.As(err, &fieldErr) || fieldErr.Path != test.wantPath {
+				t.Fatalf(
+					"Item.UnmarshalJSON() error = %v, want %s field error",
+					err, test.wantPath,
+				)
+			}
+		})
+	}
+}
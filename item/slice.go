@@ -0,0 +1,43 @@
+package item
+
+import "strings"
+
+// ItemSlice is a `[]Item` with helper methods.
+type ItemSlice []Item
+
+// String returns the string representation of the ItemSlice.
+func (is ItemSlice) String() string {
+	var sb strings.Builder
+	sb.WriteString("[]Item{")
+	for i, it := range is {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(it.String())
+	}
+	sb.WriteString("}")
+	return sb.String()
+}
+
+// Equals checks if the ItemSlice is equal to the other ItemSlice.
+func (is ItemSlice) Equals(other ItemSlice) bool {
+	if is == nil && other == nil {
+		return true
+	}
+
+	if is == nil || other == nil {
+		return false
+	}
+
+	if len(is) != len(other) {
+		return false
+	}
+
+	for i := range is {
+		if !is[i].Equals(&other[i]) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,63 @@
+package item
+
+import (
+	"testing"
+
+	"github.com/rozag/cabasi/atk"
+	"github.com/rozag/cabasi/dice"
+)
+
+func TestItemSliceEquals(t *testing.T) {
+	sword := Item{
+		Name: "Longsword",
+		Weapon: &atk.Attack{
+			Name: "Longsword", TargetCharacteristic: atk.STR,
+			Dice: dice.D10, DiceCnt: 1, Charges: -1,
+			IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitBulky,
+			PairedWith: 0,
+			Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+			Recharge:   nil,
+			DmgMod:     0,
+			MinDmg:     0,
+			Usage:      atk.UsageAlways,
+			Area:       atk.AreaZone,
+			MaxTargets: 0,
+		},
+		Kind: KindWeapon, Armor: 0, IsBulky: true, IsEquipped: true, AttackIdx: 0,
+	}
+	shield := Item{
+		Name: "Shield", Weapon: nil, Kind: KindShield, Armor: 1,
+		IsBulky: false, IsEquipped: true, AttackIdx: 0,
+	}
+	tests := []struct {
+		name        string
+		this, other ItemSlice
+		want        bool
+	}{
+		{name: "EqualNil", this: nil, other: nil, want: true},
+		{name: "EqualEmpty", this: ItemSlice{}, other: ItemSlice{}, want: true},
+		{name: "NilNotEqualToEmpty", this: nil, other: ItemSlice{}, want: false},
+		{
+			name: "EqualNormal",
+			this: ItemSlice{sword, shield}, other: ItemSlice{sword, shield},
+			want: true,
+		},
+		{
+			name: "DifferentLengths",
+			this: ItemSlice{sword}, other: ItemSlice{sword, shield},
+			want: false,
+		},
+		{
+			name: "DifferentItems",
+			this: ItemSlice{sword}, other: ItemSlice{shield},
+			want: false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.this.Equals(test.other); got != test.want {
+				t.Fatalf("ItemSlice.Equals() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
@@ -1,46 +1,149 @@
 package pickatk
 
 import (
+	"maps"
+	"slices"
+
+	"github.com/rozag/cabasi/act"
+	"github.com/rozag/cabasi/atk"
 	"github.com/rozag/cabasi/creat"
 )
 
 // MaxDmg is a function that picks an attack that will deal the maximum damage
-// to the defenders.
-// It receives an attacker and a slice of defenders.
-// It returns index of the attack the attacker will use.
-// It returns -1 if the attacker does not attack.
-func MaxDmg(attacker creat.Creature, defenders []creat.Creature) int {
-	if attacker.IsOut() {
-		return -1
+// to the enemies. It never picks supports.
+// It receives an actor, a slice of its allies, a slice of its enemies, and the
+// current round.
+// It returns the action of making the attack. Spells are considered too, but
+// only normal casts that leave the caster at least one free slot, so casting
+// never risks a mishap from overloading. Attacks whose usage conditions don't
+// hold in the actor's situation are never picked. Only the enemies the attack
+// reaches count, and a blast attack counts the enemies of its most crowded
+// area up to its max targets.
+// It returns the move a zone closer to the melee if no attack reaches any
+// enemy, or the charge of the nearest enemy out of the melee if the actor is
+// already engaged.
+// It returns act.None() if the actor does not attack, e.g. is paralysed.
+func MaxDmg(
+	actor creat.Creature,
+	allies, enemies []creat.Creature,
+	round uint,
+) act.Action {
+	if actor.IsOut() || actor.HasCondition(atk.ConditionParalysed) {
+		return act.None()
 	}
 
 	attackableCnt := 0
-	for _, defender := range defenders {
-		if !defender.IsOut() {
+	for _, enemy := range enemies {
+		if !enemy.IsOut() {
 			attackableCnt++
 		}
 	}
 	if attackableCnt == 0 {
-		return -1
+		return act.None()
+	}
+
+	situation := actor.Situation(round, allies, enemies)
+	all := make([]uint, 0, len(enemies))
+	byZone := make(map[atk.Zone][]uint, len(enemies))
+	for idx, enemy := range enemies {
+		// Suppressing gosec "G115 integer overflow conversion int -> uint"
+		// because int index will never overflow a uint variable.
+		all = append(all, uint(idx))                               //nolint:gosec
+		byZone[enemy.Zone] = append(byZone[enemy.Zone], uint(idx)) //nolint:gosec
+	}
+	// targetCnt returns the number of enemies the attack hits at best - a
+	// single one for attacks other than blasts, and the enemies of the most
+	// crowded area for blasts.
+	targetCnt := func(attack atk.Attack) uint {
+		groups := [][]uint{all}
+		if attack.Area == atk.AreaZone {
+			groups = slices.Collect(maps.Values(byZone))
+		}
+
+		maxCnt := uint(0)
+		for _, group := range groups {
+			hits := actor.BlastTargets(&attack, group, enemies)
+			maxCnt = max(maxCnt, uint(len(hits)))
+		}
+
+		if !attack.IsBlast {
+			return min(maxCnt, 1)
+		}
+		return maxCnt
 	}
 
 	maxDmg := uint(0)
-	maxDmgIdx := -1
-	for idx, attack := range attacker.Attacks {
-		if attack.Charges == 0 {
+	isMaxDmgPaired := false
+	maxDmgAction := act.None()
+	for idx, attack := range actor.Attacks {
+		cnt := targetCnt(attack)
+		if attack.Charges == 0 || !attack.Usage.Allows(situation) || cnt == 0 {
 			continue
 		}
 
-		dmg := uint(attack.Dice)
-		if attack.IsBlast {
-			dmg *= uint(len(defenders))
+		// Suppressing gosec "G115 integer overflow conversion int -> uint"
+		// because int index will never overflow a uint variable.
+		attackIdx := uint(idx) //nolint:gosec
+		dmg := attack.MaxDmg()
+		pairedIdx, isPaired := actor.PairedAttackIdx(attackIdx)
+		if isPaired {
+			paired := actor.Attacks[pairedIdx]
+			dmg = max(dmg, paired.MaxDmg())
+		}
+
+		dmg *= cnt
+
+		// Paired attacks keep the highest of both weapons' dice, so they are
+		// preferred over single attacks with the same maximum damage.
+		if dmg > maxDmg || (dmg == maxDmg && isPaired && !isMaxDmgPaired) {
+			maxDmg = dmg
+			isMaxDmgPaired = isPaired
+			maxDmgAction = act.Attack(attackIdx)
 		}
+	}
 
+	for idx, book := range actor.Spellbooks {
+		if actor.FreeSlots() <= book.FatigueCost(false) {
+			continue
+		}
+
+		effect := book.Cast(false)
+		if !effect.Usage.Allows(situation) {
+			continue
+		}
+
+		dmg := effect.MaxDmg() * targetCnt(effect)
 		if dmg > maxDmg {
 			maxDmg = dmg
-			maxDmgIdx = idx
+			// Suppressing gosec "G115 integer overflow conversion int -> uint"
+			// because int index will never overflow a uint variable.
+			maxDmgAction = act.Spell(uint(idx), false) //nolint:gosec
 		}
 	}
 
-	return maxDmgIdx
+	if maxDmgAction.Kind != act.KindNone {
+		return maxDmgAction
+	}
+
+	if actor.Zone > atk.ZoneEngaged {
+		return act.Move(actor.Zone - 1)
+	}
+
+	nearestIdx := -1
+	for idx, enemy := range enemies {
+		if enemy.IsOut() || enemy.Zone == atk.ZoneEngaged {
+			continue
+		}
+
+		if nearestIdx < 0 || enemy.Zone < enemies[nearestIdx].Zone {
+			nearestIdx = idx
+		}
+	}
+	if nearestIdx >= 0 {
+		// Suppressing gosec "G115 integer overflow conversion int -> uint"
+		// because the index is checked to be non-negative.
+		return act.Charge(uint(nearestIdx)) //nolint:gosec
+	}
+
+	return maxDmgAction
 }
@@ -1,73 +1,128 @@
 package pickatk
 
 import (
+	"slices"
 	"testing"
 
+	"github.com/rozag/cabasi/act"
 	"github.com/rozag/cabasi/atk"
 	"github.com/rozag/cabasi/creat"
 	"github.com/rozag/cabasi/dice"
+	"github.com/rozag/cabasi/spell"
 )
 
 func TestMaxDmg(t *testing.T) {
 	spear := atk.Attack{
 		Name: "Spear", TargetCharacteristic: atk.STR,
 		Dice: dice.D6, DiceCnt: 1, Charges: -1,
-		IsBlast: false,
+		IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone, PairedWith: 0,
+		Inflicts: atk.Condition{Kind: atk.ConditionNone, Rounds: 0}, Recharge: nil,
+		DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+		Area: atk.AreaZone, MaxTargets: 0,
+	}
+	fireball := spell.Book{
+		Name: "Fireball",
+		Effect: atk.Attack{
+			Name: "Fireball", TargetCharacteristic: atk.STR,
+			Dice: dice.D8, DiceCnt: 1, Charges: -1,
+			IsBlast: true, Pool: atk.PoolHighest, Traits: atk.TraitMagical,
+			PairedWith: 0,
+			Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+			Recharge:   nil,
+			DmgMod:     0,
+			MinDmg:     0,
+			Usage:      atk.UsageAlways,
+			Area:       atk.AreaZone,
+			MaxTargets: 0,
+		},
 	}
 	player := creat.Creature{
 		ID: "player-0", Name: "John Appleseed", Attacks: []atk.Attack{spear},
 		STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-		IsDetachment: false,
+		IsDetachment: false, Conditions: nil,
+		Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil, Items: nil,
+		Zone: atk.ZoneEngaged,
 	}
 	tests := []struct {
 		name      string
 		defenders []creat.Creature
 		attacker  creat.Creature
-		want      int
+		want      act.Action
 	}{
 		{
 			name:      "NilDefenders",
 			attacker:  player,
 			defenders: nil,
-			want:      -1,
+			want:      act.None(),
 		},
 		{
 			name:      "EmptyDefenders",
 			attacker:  player,
 			defenders: []creat.Creature{},
-			want:      -1,
+			want:      act.None(),
 		},
 		{
 			name: "AttackerIsOut",
 			attacker: creat.Creature{
 				ID: "player-0", Name: "John Appleseed", Attacks: []atk.Attack{spear},
 				STR: 0, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-				IsDetachment: false,
+				IsDetachment: false, Conditions: nil,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil, Zone: atk.ZoneEngaged,
 			},
 			defenders: []creat.Creature{
 				{
 					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
-			want: -1,
+			want: act.None(),
+		},
+		{
+			name: "AttackerIsParalysed",
+			attacker: creat.Creature{
+				ID: "player-0", Name: "John Appleseed", Attacks: []atk.Attack{spear},
+				STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+				IsDetachment: false, Spellbooks: nil, Fatigue: 0, IsDeprived: false,
+				Supports: nil, Items: nil,
+				Zone: atk.ZoneEngaged,
+				Conditions: []atk.Condition{
+					{Kind: atk.ConditionParalysed, Rounds: 1},
+				},
+			},
+			defenders: []creat.Creature{
+				{
+					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
+				},
+			},
+			want: act.None(),
 		},
 		{
 			name: "AttackerHasNoAttacks",
 			attacker: creat.Creature{
 				ID: "player-0", Name: "John Appleseed", Attacks: []atk.Attack{},
 				STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-				IsDetachment: false,
+				IsDetachment: false, Conditions: nil,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil, Zone: atk.ZoneEngaged,
 			},
 			defenders: []creat.Creature{
 				{
 					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
-			want: -1,
+			want: act.None(),
 		},
 		{
 			name:     "AllDefendersOut",
@@ -76,15 +131,19 @@ func TestMaxDmg(t *testing.T) {
 				{
 					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 0, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-1", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 0, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
-			want: -1,
+			want: act.None(),
 		},
 		{
 			name: "NoAttacksLeft",
@@ -94,41 +153,59 @@ func TestMaxDmg(t *testing.T) {
 					{
 						Name: "Fire Bolt", TargetCharacteristic: atk.STR,
 						Dice: dice.D6, DiceCnt: 1, Charges: 0,
-						IsBlast: false,
+						IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+						PairedWith: 0,
+						Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+						Recharge:   nil,
+						DmgMod:     0,
+						MinDmg:     0,
+						Usage:      atk.UsageAlways,
+						Area:       atk.AreaZone,
+						MaxTargets: 0,
 					},
 				},
 				STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-				IsDetachment: false,
+				IsDetachment: false, Conditions: nil,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil, Zone: atk.ZoneEngaged,
 			},
 			defenders: []creat.Creature{
 				{
 					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
-			want: -1,
+			want: act.None(),
 		},
 		{
 			name: "PickSingleAttack",
 			attacker: creat.Creature{
 				ID: "player-0", Name: "John Appleseed", Attacks: []atk.Attack{spear},
 				STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-				IsDetachment: false,
+				IsDetachment: false, Conditions: nil,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil, Zone: atk.ZoneEngaged,
 			},
 			defenders: []creat.Creature{
 				{
 					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-1", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
-			want: 0,
+			want: act.Attack(0),
 		},
 		{
 			name: "PickTheMostPowerfulAttack",
@@ -138,30 +215,52 @@ func TestMaxDmg(t *testing.T) {
 					{
 						Name: "Spear", TargetCharacteristic: atk.STR,
 						Dice: dice.D6, DiceCnt: 1, Charges: -1,
-						IsBlast: false,
+						IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+						PairedWith: 0,
+						Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+						Recharge:   nil,
+						DmgMod:     0,
+						MinDmg:     0,
+						Usage:      atk.UsageAlways,
+						Area:       atk.AreaZone,
+						MaxTargets: 0,
 					},
 					{
 						Name: "Longsword", TargetCharacteristic: atk.STR,
 						Dice: dice.D8, DiceCnt: 1, Charges: -1,
-						IsBlast: false,
+						IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+						PairedWith: 0,
+						Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+						Recharge:   nil,
+						DmgMod:     0,
+						MinDmg:     0,
+						Usage:      atk.UsageAlways,
+						Area:       atk.AreaZone,
+						MaxTargets: 0,
 					},
 				},
 				STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-				IsDetachment: false,
+				IsDetachment: false, Conditions: nil,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil, Zone: atk.ZoneEngaged,
 			},
 			defenders: []creat.Creature{
 				{
 					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-1", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
-			want: 1,
+			want: act.Attack(1),
 		},
 		{
 			name: "PickBlastAttackForMultipleDefenders",
@@ -171,40 +270,72 @@ func TestMaxDmg(t *testing.T) {
 					{
 						Name: "Spear", TargetCharacteristic: atk.STR,
 						Dice: dice.D10, DiceCnt: 1, Charges: -1,
-						IsBlast: false,
+						IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+						PairedWith: 0,
+						Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+						Recharge:   nil,
+						DmgMod:     0,
+						MinDmg:     0,
+						Usage:      atk.UsageAlways,
+						Area:       atk.AreaZone,
+						MaxTargets: 0,
 					},
 					{
 						Name: "Fireball", TargetCharacteristic: atk.STR,
 						Dice: dice.D6, DiceCnt: 1, Charges: 1,
-						IsBlast: true,
+						IsBlast: true, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+						PairedWith: 0,
+						Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+						Recharge:   nil,
+						DmgMod:     0,
+						MinDmg:     0,
+						Usage:      atk.UsageAlways,
+						Area:       atk.AreaZone,
+						MaxTargets: 0,
 					},
 					{
 						Name: "Longsword", TargetCharacteristic: atk.STR,
 						Dice: dice.D12, DiceCnt: 1, Charges: -1,
-						IsBlast: false,
+						IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+						PairedWith: 0,
+						Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+						Recharge:   nil,
+						DmgMod:     0,
+						MinDmg:     0,
+						Usage:      atk.UsageAlways,
+						Area:       atk.AreaZone,
+						MaxTargets: 0,
 					},
 				},
 				STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-				IsDetachment: false,
+				IsDetachment: false, Conditions: nil,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil, Zone: atk.ZoneEngaged,
 			},
 			defenders: []creat.Creature{
 				{
 					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-1", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-2", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
-			want: 1,
+			want: act.Attack(1),
 		},
 		{
 			name: "PickMorePowerfulAttackInsteadOfBlastForSingleDefender",
@@ -214,30 +345,58 @@ func TestMaxDmg(t *testing.T) {
 					{
 						Name: "Spear", TargetCharacteristic: atk.STR,
 						Dice: dice.D10, DiceCnt: 1, Charges: -1,
-						IsBlast: false,
+						IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+						PairedWith: 0,
+						Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+						Recharge:   nil,
+						DmgMod:     0,
+						MinDmg:     0,
+						Usage:      atk.UsageAlways,
+						Area:       atk.AreaZone,
+						MaxTargets: 0,
 					},
 					{
 						Name: "Fireball", TargetCharacteristic: atk.STR,
 						Dice: dice.D6, DiceCnt: 1, Charges: 1,
-						IsBlast: true,
+						IsBlast: true, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+						PairedWith: 0,
+						Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+						Recharge:   nil,
+						DmgMod:     0,
+						MinDmg:     0,
+						Usage:      atk.UsageAlways,
+						Area:       atk.AreaZone,
+						MaxTargets: 0,
 					},
 					{
 						Name: "Longsword", TargetCharacteristic: atk.STR,
 						Dice: dice.D12, DiceCnt: 1, Charges: -1,
-						IsBlast: false,
+						IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+						PairedWith: 0,
+						Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+						Recharge:   nil,
+						DmgMod:     0,
+						MinDmg:     0,
+						Usage:      atk.UsageAlways,
+						Area:       atk.AreaZone,
+						MaxTargets: 0,
 					},
 				},
 				STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-				IsDetachment: false,
+				IsDetachment: false, Conditions: nil,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil, Zone: atk.ZoneEngaged,
 			},
 			defenders: []creat.Creature{
 				{
 					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
-			want: 2,
+			want: act.Attack(2),
 		},
 		{
 			name: "DoNotPickPowerfulAttackWithNoCharges",
@@ -247,30 +406,561 @@ func TestMaxDmg(t *testing.T) {
 					{
 						Name: "Magic Spear", TargetCharacteristic: atk.STR,
 						Dice: dice.D12, DiceCnt: 1, Charges: 0,
-						IsBlast: false,
+						IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+						PairedWith: 0,
+						Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+						Recharge:   nil,
+						DmgMod:     0,
+						MinDmg:     0,
+						Usage:      atk.UsageAlways,
+						Area:       atk.AreaZone,
+						MaxTargets: 0,
 					},
 					{
 						Name: "Knife", TargetCharacteristic: atk.STR,
 						Dice: dice.D6, DiceCnt: 1, Charges: -1,
-						IsBlast: false,
+						IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+						PairedWith: 0,
+						Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+						Recharge:   nil,
+						DmgMod:     0,
+						MinDmg:     0,
+						Usage:      atk.UsageAlways,
+						Area:       atk.AreaZone,
+						MaxTargets: 0,
+					},
+				},
+				STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+				IsDetachment: false, Conditions: nil,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil, Zone: atk.ZoneEngaged,
+			},
+			defenders: []creat.Creature{
+				{
+					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
+				},
+			},
+			want: act.Attack(1),
+		},
+		{
+			name: "PickSummedDicePoolOverBiggerDice",
+			attacker: creat.Creature{
+				ID: "player-0", Name: "John Appleseed",
+				Attacks: []atk.Attack{
+					{
+						Name: "Longsword", TargetCharacteristic: atk.STR,
+						Dice: dice.D10, DiceCnt: 2, Charges: -1,
+						IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+						PairedWith: 0,
+						Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+						Recharge:   nil,
+						DmgMod:     0,
+						MinDmg:     0,
+						Usage:      atk.UsageAlways,
+						Area:       atk.AreaZone,
+						MaxTargets: 0,
+					},
+					{
+						Name: "Flurry", TargetCharacteristic: atk.STR,
+						Dice: dice.D6, DiceCnt: 2, Charges: -1,
+						IsBlast: false, Pool: atk.PoolSum, Traits: atk.TraitNone,
+						PairedWith: 0,
+						Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+						Recharge:   nil,
+						DmgMod:     0,
+						MinDmg:     0,
+						Usage:      atk.UsageAlways,
+						Area:       atk.AreaZone,
+						MaxTargets: 0,
+					},
+				},
+				STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+				IsDetachment: false, Conditions: nil,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil, Zone: atk.ZoneEngaged,
+			},
+			defenders: []creat.Creature{
+				{
+					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
+				},
+			},
+			want: act.Attack(1),
+		},
+		{
+			name: "PickAttackWithDmgMod",
+			attacker: creat.Creature{
+				ID: "player-0", Name: "John Appleseed",
+				Attacks: []atk.Attack{
+					{
+						Name: "Longsword", TargetCharacteristic: atk.STR,
+						Dice: dice.D8, DiceCnt: 1, Charges: -1,
+						IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+						PairedWith: 0,
+						Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+						Recharge:   nil,
+						DmgMod:     0,
+						MinDmg:     0,
+						Usage:      atk.UsageAlways,
+						Area:       atk.AreaZone,
+						MaxTargets: 0,
+					},
+					{
+						Name: "Silver Dagger", TargetCharacteristic: atk.STR,
+						Dice: dice.D6, DiceCnt: 1, Charges: -1,
+						IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+						PairedWith: 0,
+						Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+						Recharge:   nil,
+						DmgMod:     3,
+						MinDmg:     0,
+						Usage:      atk.UsageAlways,
+						Area:       atk.AreaZone,
+						MaxTargets: 0,
+					},
+				},
+				STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+				IsDetachment: false, Conditions: nil,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil, Zone: atk.ZoneEngaged,
+			},
+			defenders: []creat.Creature{
+				{
+					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
+				},
+			},
+			want: act.Attack(1),
+		},
+		{
+			name: "PreferPairedAttackWithSameMaxDmg",
+			attacker: creat.Creature{
+				ID: "player-0", Name: "John Appleseed",
+				Attacks: []atk.Attack{
+					{
+						Name: "Sword", TargetCharacteristic: atk.STR,
+						Dice: dice.D8, DiceCnt: 1, Charges: -1,
+						IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+						PairedWith: 0,
+						Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+						Recharge:   nil,
+						DmgMod:     0,
+						MinDmg:     0,
+						Usage:      atk.UsageAlways,
+						Area:       atk.AreaZone,
+						MaxTargets: 0,
+					},
+					{
+						Name: "Dagger", TargetCharacteristic: atk.STR,
+						Dice: dice.D6, DiceCnt: 1, Charges: -1,
+						IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+						PairedWith: 0,
+						Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+						Recharge:   nil,
+						DmgMod:     0,
+						MinDmg:     0,
+						Usage:      atk.UsageAlways,
+						Area:       atk.AreaZone,
+						MaxTargets: 0,
+					},
+					{
+						Name: "Sword and Dagger", TargetCharacteristic: atk.STR,
+						Dice: dice.D8, DiceCnt: 1, Charges: -1,
+						IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+						PairedWith: 2,
+						Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+						Recharge:   nil,
+						DmgMod:     0,
+						MinDmg:     0,
+						Usage:      atk.UsageAlways,
+						Area:       atk.AreaZone,
+						MaxTargets: 0,
+					},
+				},
+				STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+				IsDetachment: false, Conditions: nil,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil, Zone: atk.ZoneEngaged,
+			},
+			defenders: []creat.Creature{
+				{
+					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
+				},
+			},
+			want: act.Attack(2),
+		},
+		{
+			name: "PairedAttackEstimatedByBiggerDice",
+			attacker: creat.Creature{
+				ID: "player-0", Name: "John Appleseed",
+				Attacks: []atk.Attack{
+					{
+						Name: "Sword", TargetCharacteristic: atk.STR,
+						Dice: dice.D8, DiceCnt: 1, Charges: -1,
+						IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+						PairedWith: 0,
+						Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+						Recharge:   nil,
+						DmgMod:     0,
+						MinDmg:     0,
+						Usage:      atk.UsageAlways,
+						Area:       atk.AreaZone,
+						MaxTargets: 0,
+					},
+					{
+						Name: "Dagger and Greataxe", TargetCharacteristic: atk.STR,
+						Dice: dice.D6, DiceCnt: 1, Charges: -1,
+						IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+						PairedWith: 3,
+						Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+						Recharge:   nil,
+						DmgMod:     0,
+						MinDmg:     0,
+						Usage:      atk.UsageAlways,
+						Area:       atk.AreaZone,
+						MaxTargets: 0,
+					},
+					{
+						Name: "Greataxe", TargetCharacteristic: atk.STR,
+						Dice: dice.D10, DiceCnt: 1, Charges: 1,
+						IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitBulky,
+						PairedWith: 0,
+						Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+						Recharge:   nil,
+						DmgMod:     0,
+						MinDmg:     0,
+						Usage:      atk.UsageAlways,
+						Area:       atk.AreaZone,
+						MaxTargets: 0,
 					},
 				},
 				STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-				IsDetachment: false,
+				IsDetachment: false, Conditions: nil,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil, Zone: atk.ZoneEngaged,
+			},
+			defenders: []creat.Creature{
+				{
+					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
+				},
+			},
+			want: act.Attack(1),
+		},
+		{
+			name: "PickBlastSpell",
+			attacker: creat.Creature{
+				ID: "player-0", Name: "Merlin", Attacks: []atk.Attack{spear},
+				Spellbooks: []spell.Book{fireball}, Conditions: nil,
+				STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+				IsDetachment: false, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil, Zone: atk.ZoneEngaged,
 			},
 			defenders: []creat.Creature{
 				{
 					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
+				{
+					ID: "monster-1", Name: "Root Goblin", Attacks: []atk.Attack{spear},
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
+				},
+			},
+			want: act.Spell(0, false),
+		},
+		{
+			name: "DoNotPickSpellOverloadingCaster",
+			attacker: creat.Creature{
+				ID: "player-0", Name: "Merlin", Attacks: []atk.Attack{spear},
+				Spellbooks: []spell.Book{fireball}, Conditions: nil,
+				STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+				IsDetachment: false, Fatigue: 8, IsDeprived: false, Supports: nil,
+				Items: nil, Zone: atk.ZoneEngaged,
+			},
+			defenders: []creat.Creature{
+				{
+					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
+				},
+				{
+					ID: "monster-1", Name: "Root Goblin", Attacks: []atk.Attack{spear},
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
+				},
+			},
+			want: act.Attack(0),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := MaxDmg(test.attacker, nil, test.defenders, 1)
+			if got != test.want {
+				t.Errorf("MaxDmg() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestMaxDmgUsage(t *testing.T) {
+	noCondition := atk.Condition{Kind: atk.ConditionNone, Rounds: 0}
+	newAttack := func(
+		name string,
+		d dice.Dice,
+		isBlast bool,
+		usage atk.Usage,
+	) atk.Attack {
+		return atk.Attack{
+			Name: name, TargetCharacteristic: atk.STR,
+			Dice: d, DiceCnt: 1, Charges: -1,
+			IsBlast: isBlast, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+			PairedWith: 0, Inflicts: noCondition, Recharge: nil,
+			DmgMod: 0, MinDmg: 0, Usage: usage, Area: atk.AreaZone, MaxTargets: 0,
+		}
+	}
+	ogre := creat.Creature{
+		ID: "monster-0", Name: "Ogre",
+		Attacks: []atk.Attack{
+			newAttack("Club", dice.D8, false, atk.UsageAlways),
+			newAttack("Frenzy", dice.D12, false, atk.UsageBloodied),
+			newAttack("Boulders", dice.D6, true, atk.UsageFirstRound),
+			newAttack("Trample", dice.D10, true, atk.UsageVsDetachments),
+		},
+		STR: 16, DEX: 8, WIL: 8, HP: 6, Armor: 1,
+		IsDetachment: false, Conditions: nil,
+		Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil, Items: nil,
+		Zone: atk.ZoneEngaged,
+	}
+	bloodiedOgre := ogre
+	bloodiedOgre.HP = 0
+	knight := creat.Creature{
+		ID: "player-0", Name: "Knight",
+		Attacks: []atk.Attack{
+			newAttack("Sword", dice.D8, false, atk.UsageAlways),
+		},
+		STR: 12, DEX: 10, WIL: 10, HP: 5, Armor: 2,
+		IsDetachment: false, Conditions: nil,
+		Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil, Items: nil,
+		Zone: atk.ZoneEngaged,
+	}
+	militia := knight
+	militia.Name, militia.IsDetachment = "Militia", true
+	tests := []struct {
+		name     string
+		enemies  []creat.Creature
+		attacker creat.Creature
+		want     act.Action
+		round    uint
+	}{
+		{
+			name:     "OnlyUnrestrictedAttackAvailable",
+			attacker: ogre,
+			enemies:  []creat.Creature{knight},
+			round:    2,
+			want:     act.Attack(0),
+		},
+		{
+			name:     "BloodiedAttackWhileBloodied",
+			attacker: bloodiedOgre,
+			enemies:  []creat.Creature{knight},
+			round:    2,
+			want:     act.Attack(1),
+		},
+		{
+			name:     "FirstRoundBlast",
+			attacker: ogre,
+			enemies:  []creat.Creature{knight, knight, knight},
+			round:    1,
+			want:     act.Attack(2),
+		},
+		{
+			name:     "BlastCountsOnlyDetachments",
+			attacker: ogre,
+			enemies:  []creat.Creature{knight, militia},
+			round:    2,
+			want:     act.Attack(3),
+		},
+		{
+			name:     "BlastAgainstSingleDetachmentIsWeaker",
+			attacker: ogre,
+			enemies:  []creat.Creature{knight, knight, militia},
+			round:    1,
+			want:     act.Attack(2),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			allies := []creat.Creature{test.attacker}
+			got := MaxDmg(test.attacker, allies, test.enemies, test.round)
+			if got != test.want {
+				t.Errorf("MaxDmg() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestMaxDmgZones(t *testing.T) {
+	noCondition := atk.Condition{Kind: atk.ConditionNone, Rounds: 0}
+	newAttack := func(
+		name string,
+		d dice.Dice,
+		isBlast bool,
+		traits atk.Traits,
+	) atk.Attack {
+		return atk.Attack{
+			Name: name, TargetCharacteristic: atk.STR,
+			Dice: d, DiceCnt: 1, Charges: -1,
+			IsBlast: isBlast, Pool: atk.PoolHighest, Traits: traits,
+			PairedWith: 0, Inflicts: noCondition, Recharge: nil,
+			DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+			Area: atk.AreaZone, MaxTargets: 0,
+		}
+	}
+	newGoblin := func(zone atk.Zone) creat.Creature {
+		return creat.Creature{
+			ID: "monster-0", Name: "Root Goblin",
+			Attacks: []atk.Attack{
+				newAttack("Spear", dice.D6, false, atk.TraitNone),
 			},
-			want: 1,
+			STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+			IsDetachment: false, Conditions: nil, Zone: zone,
+			Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil, Items: nil,
+		}
+	}
+	knight := creat.Creature{
+		ID: "player-0", Name: "Knight",
+		Attacks: []atk.Attack{
+			newAttack("Sword", dice.D8, false, atk.TraitNone),
+			newAttack("Sling", dice.D4, false, atk.TraitRanged),
+		},
+		STR: 12, DEX: 10, WIL: 10, HP: 5, Armor: 2,
+		IsDetachment: false, Conditions: nil, Zone: atk.ZoneEngaged,
+		Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil, Items: nil,
+	}
+	farKnight := knight
+	farKnight.Attacks = knight.Attacks[:1]
+	farKnight.Zone = atk.ZoneFar
+	meleeKnight := knight
+	meleeKnight.Attacks = knight.Attacks[:1]
+	archer := creat.Creature{
+		ID: "player-1", Name: "Robin",
+		Attacks: []atk.Attack{
+			newAttack("Longbow", dice.D10, false, atk.TraitRanged),
+			newAttack("Volley", dice.D6, true, atk.TraitRanged),
+		},
+		STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+		IsDetachment: false, Conditions: nil, Zone: atk.ZoneFar,
+		Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil, Items: nil,
+	}
+	hailArcher := archer
+	hailArcher.Attacks = []atk.Attack{
+		archer.Attacks[0],
+		newAttack("Hail of Arrows", dice.D4, true, atk.TraitRanged),
+	}
+	hailArcher.Attacks[1].Area = atk.AreaAll
+	cappedArcher := hailArcher
+	cappedArcher.Attacks = slices.Clone(hailArcher.Attacks)
+	cappedArcher.Attacks[1].MaxTargets = 2
+	scattered := []creat.Creature{
+		newGoblin(atk.ZoneEngaged), newGoblin(atk.ZoneNear),
+		newGoblin(atk.ZoneFar),
+	}
+	tests := []struct {
+		name     string
+		enemies  []creat.Creature
+		attacker creat.Creature
+		want     act.Action
+	}{
+		{
+			name:     "MeleeReachesEngaged",
+			attacker: knight,
+			enemies:  []creat.Creature{newGoblin(atk.ZoneEngaged)},
+			want:     act.Attack(0),
+		},
+		{
+			name:     "RangedWhenMeleeDoesNotReach",
+			attacker: knight,
+			enemies:  []creat.Creature{newGoblin(atk.ZoneNear)},
+			want:     act.Attack(1),
+		},
+		{
+			name:     "MoveCloserWhenNothingReaches",
+			attacker: farKnight,
+			enemies:  []creat.Creature{newGoblin(atk.ZoneEngaged)},
+			want:     act.Move(atk.ZoneNear),
+		},
+		{
+			name:     "ChargeNearestWhenEngagedAndNothingReaches",
+			attacker: meleeKnight,
+			enemies: []creat.Creature{
+				newGoblin(atk.ZoneFar), newGoblin(atk.ZoneNear),
+				newGoblin(atk.ZoneNear),
+			},
+			want: act.Charge(1),
+		},
+		{
+			name:     "BlastCountsSingleZone",
+			attacker: archer,
+			enemies: []creat.Creature{
+				newGoblin(atk.ZoneEngaged), newGoblin(atk.ZoneNear),
+				newGoblin(atk.ZoneFar),
+			},
+			want: act.Attack(0),
+		},
+		{
+			name:     "BlastAgainstCrowdedZone",
+			attacker: archer,
+			enemies: []creat.Creature{
+				newGoblin(atk.ZoneNear), newGoblin(atk.ZoneNear),
+				newGoblin(atk.ZoneFar),
+			},
+			want: act.Attack(1),
+		},
+		{
+			name:     "BlastAreaAllCountsEveryZone",
+			attacker: hailArcher,
+			enemies:  scattered,
+			want:     act.Attack(1),
+		},
+		{
+			name:     "BlastCappedByMaxTargets",
+			attacker: cappedArcher,
+			enemies:  scattered,
+			want:     act.Attack(0),
 		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			if got := MaxDmg(test.attacker, test.defenders); got != test.want {
+			allies := []creat.Creature{test.attacker}
+			got := MaxDmg(test.attacker, allies, test.enemies, 1)
+			if got != test.want {
 				t.Errorf("MaxDmg() = %v, want %v", got, test.want)
 			}
 		})
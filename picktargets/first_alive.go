@@ -3,52 +3,92 @@ package picktargets
 import (
 	"slices"
 
+	"github.com/rozag/cabasi/act"
+	"github.com/rozag/cabasi/atk"
 	"github.com/rozag/cabasi/creat"
 )
 
 // FirstAlive is a function that picks the first available not-out-of-battle
-// defenders for the selected attack.
-// It receives an attacker, a picked attack index, and a slice of defenders.
-// It returns a slice of picked defender indexes.
-// It returns nil if there are no defenders to attack.
+// targets for the picked action - enemies for hostile actions and a single
+// ally for supports. Only the enemies the attack reaches from the actor's
+// zone are targeted, see atk.Attack.Reaches, and attacks usable only against
+// detachments target detachments only. A blast attack targets the enemies of
+// its area around the first one, see creat.Creature.BlastTargets.
+// It receives an actor, a picked action, a slice of its allies, and a slice of
+// its enemies.
+// It returns a slice of picked ally or enemy indexes.
+// It returns nil if there are no targets or the actor is paralysed.
 func FirstAlive(
-	attacker creat.Creature,
-	pickedAttackIdx uint,
-	defenders []creat.Creature,
+	actor creat.Creature,
+	action act.Action,
+	allies, enemies []creat.Creature,
 ) []uint {
-	if attacker.IsOut() {
+	if actor.IsOut() || actor.HasCondition(atk.ConditionParalysed) {
 		return nil
 	}
 
-	if pickedAttackIdx >= uint(len(attacker.Attacks)) {
-		return nil
-	}
+	var targets []creat.Creature
+	targetsCnt := 1
+	isTarget := func(target *creat.Creature) bool { return !target.IsOut() }
+	switch action.Kind {
+	case act.KindAttack, act.KindSpell:
+		attack, ok := actor.Attack(action)
+		if !ok || attack.Charges == 0 {
+			return nil
+		}
 
-	if len(defenders) == 0 {
-		return nil
-	}
+		if attack.IsBlast {
+			candidates := make([]uint, len(enemies))
+			for idx := range candidates {
+				// Suppressing gosec "G115 integer overflow conversion int -> uint"
+				// because int index will never overflow a uint variable.
+				candidates[idx] = uint(idx) //nolint:gosec
+			}
+			indexes := actor.BlastTargets(&attack, candidates, enemies)
+			if len(indexes) == 0 {
+				return nil
+			}
+			return slices.Clip(indexes)
+		}
 
-	attack := attacker.Attacks[pickedAttackIdx]
-	if attack.Charges == 0 {
+		targets = enemies
+		isTarget = func(target *creat.Creature) bool {
+			return !target.IsOut() &&
+				attack.Reaches(actor.Zone, target.Zone) &&
+				(!attack.Usage.Has(atk.UsageVsDetachments) || target.IsDetachment)
+		}
+		if actor.IsDetachment {
+			targetsCnt = len(enemies)
+		}
+	case act.KindSupport:
+		if !actor.CanUse(action.Idx) {
+			return nil
+		}
+
+		targets = allies
+	case act.KindNone, act.KindMove, act.KindCharge:
+		return nil
+	default:
 		return nil
 	}
 
-	targetsCnt := 1
-	if attack.IsBlast || attacker.IsDetachment {
-		targetsCnt = len(defenders)
+	if len(targets) == 0 {
+		return nil
 	}
 
 	indexes := make([]uint, 0, targetsCnt)
-	for idx, defender := range defenders {
+	for idx, target := range targets {
 		if len(indexes) >= targetsCnt {
 			break
 		}
 
-		if !defender.IsOut() {
-			// Suppressing gosec "G115 integer overflow conversion int -> uint"
-			// because int index will never overflow a uint variable.
-			indexes = append(indexes, uint(idx)) //nolint:gosec
+		if !isTarget(&target) {
+			continue
 		}
+
+		// Suppressing gosec "G115 integer overflow conversion int -> uint"
+		// because int index will never overflow a uint variable.
+		indexes = append(indexes, uint(idx)) //nolint:gosec
 	}
 
 	if len(indexes) == 0 {
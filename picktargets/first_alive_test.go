@@ -4,92 +4,156 @@ import (
 	"slices"
 	"testing"
 
+	"github.com/rozag/cabasi/act"
 	"github.com/rozag/cabasi/atk"
 	"github.com/rozag/cabasi/creat"
 	"github.com/rozag/cabasi/dice"
+	"github.com/rozag/cabasi/spell"
 )
 
 func TestFirstAlive(t *testing.T) {
 	spear := atk.Attack{
 		Name: "Spear", TargetCharacteristic: atk.STR,
 		Dice: dice.D6, DiceCnt: 1, Charges: -1,
-		IsBlast: false,
+		IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone, PairedWith: 0,
+		Inflicts: atk.Condition{Kind: atk.ConditionNone, Rounds: 0}, Recharge: nil,
+		DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+		Area: atk.AreaZone, MaxTargets: 0,
 	}
+	fireball := spell.Book{
+		Name: "Fireball",
+		Effect: atk.Attack{
+			Name: "Fireball", TargetCharacteristic: atk.STR,
+			Dice: dice.D8, DiceCnt: 1, Charges: -1,
+			IsBlast: true, Pool: atk.PoolHighest, Traits: atk.TraitMagical,
+			PairedWith: 0,
+			Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+			Recharge:   nil,
+			DmgMod:     0,
+			MinDmg:     0,
+			Usage:      atk.UsageAlways,
+			Area:       atk.AreaZone,
+			MaxTargets: 0,
+		},
+	}
+	pike, volley := spear, spear
+	pike.Name, pike.Usage = "Pike", atk.UsageVsDetachments
+	volley.Name, volley.IsBlast, volley.Traits = "Volley", true, atk.TraitRanged
+	hail := volley
+	hail.Name, hail.Area, hail.MaxTargets = "Hail of Arrows", atk.AreaAll, 2
 	player0 := creat.Creature{
 		ID: "player-0", Name: "John Appleseed", Attacks: []atk.Attack{spear},
 		STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-		IsDetachment: false,
+		IsDetachment: false, Conditions: nil,
+		Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil, Items: nil,
+		Zone: atk.ZoneEngaged,
 	}
 	tests := []struct {
-		name            string
-		defenders       []creat.Creature
-		want            []uint
-		attacker        creat.Creature
-		pickedAttackIdx uint
+		name      string
+		defenders []creat.Creature
+		want      []uint
+		attacker  creat.Creature
+		action    act.Action
 	}{
 		{
 			name: "AttackerIsOut",
 			attacker: creat.Creature{
 				ID: "player-0", Name: "John Appleseed", Attacks: []atk.Attack{spear},
 				STR: 0, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-				IsDetachment: false,
+				IsDetachment: false, Conditions: nil,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil, Zone: atk.ZoneEngaged,
+			},
+			action: act.Attack(0),
+			defenders: []creat.Creature{
+				{
+					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
+				},
+			},
+			want: nil,
+		},
+		{
+			name: "AttackerIsParalysed",
+			attacker: creat.Creature{
+				ID: "player-0", Name: "John Appleseed", Attacks: []atk.Attack{spear},
+				STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+				IsDetachment: false, Spellbooks: nil, Fatigue: 0, IsDeprived: false,
+				Supports: nil, Items: nil,
+				Zone: atk.ZoneEngaged,
+				Conditions: []atk.Condition{
+					{Kind: atk.ConditionParalysed, Rounds: 1},
+				},
 			},
-			pickedAttackIdx: 0,
+			action: act.Attack(0),
 			defenders: []creat.Creature{
 				{
 					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 			want: nil,
 		},
 		{
-			name:            "InvalidPickedAttackIdx",
-			attacker:        player0,
-			pickedAttackIdx: 1,
+			name:     "InvalidPickedAttackIdx",
+			attacker: player0,
+			action:   act.Attack(1),
 			defenders: []creat.Creature{
 				{
 					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 			want: nil,
 		},
 		{
-			name:            "NilDefenders",
-			attacker:        player0,
-			pickedAttackIdx: 0,
-			defenders:       nil,
-			want:            nil,
+			name:      "NilDefenders",
+			attacker:  player0,
+			action:    act.Attack(0),
+			defenders: nil,
+			want:      nil,
 		},
 		{
-			name:            "EmptyDefenders",
-			attacker:        player0,
-			pickedAttackIdx: 0,
-			defenders:       []creat.Creature{},
-			want:            nil,
+			name:      "EmptyDefenders",
+			attacker:  player0,
+			action:    act.Attack(0),
+			defenders: []creat.Creature{},
+			want:      nil,
 		},
 		{
-			name:            "AllDefendersOut",
-			attacker:        player0,
-			pickedAttackIdx: 0,
+			name:     "AllDefendersOut",
+			attacker: player0,
+			action:   act.Attack(0),
 			defenders: []creat.Creature{
 				{
 					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 0, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-1", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 0, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-2", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 0, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 			want: nil,
@@ -102,54 +166,74 @@ func TestFirstAlive(t *testing.T) {
 					{
 						Name: "Sword", TargetCharacteristic: atk.STR,
 						Dice: dice.D6, DiceCnt: 1, Charges: 0,
-						IsBlast: false,
+						IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+						PairedWith: 0,
+						Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+						Recharge:   nil,
+						DmgMod:     0,
+						MinDmg:     0,
+						Usage:      atk.UsageAlways,
+						Area:       atk.AreaZone,
+						MaxTargets: 0,
 					},
 				},
 				STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-				IsDetachment: false,
+				IsDetachment: false, Conditions: nil,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil, Zone: atk.ZoneEngaged,
 			},
-			pickedAttackIdx: 0,
+			action: act.Attack(0),
 			defenders: []creat.Creature{
 				{
 					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 			want: nil,
 		},
 		{
-			name:            "PickFirstDefender",
-			attacker:        player0,
-			pickedAttackIdx: 0,
+			name:     "PickFirstDefender",
+			attacker: player0,
+			action:   act.Attack(0),
 			defenders: []creat.Creature{
 				{
 					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-1", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 			want: []uint{0},
 		},
 		{
-			name:            "SkipFirstOutOfBattleDefenderAndPickSecond",
-			attacker:        player0,
-			pickedAttackIdx: 0,
+			name:     "SkipFirstOutOfBattleDefenderAndPickSecond",
+			attacker: player0,
+			action:   act.Attack(0),
 			defenders: []creat.Creature{
 				{
 					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 0, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-1", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 			want: []uint{1},
@@ -162,23 +246,37 @@ func TestFirstAlive(t *testing.T) {
 					{
 						Name: "Fireball", TargetCharacteristic: atk.STR,
 						Dice: dice.D8, DiceCnt: 1, Charges: 1,
-						IsBlast: true,
+						IsBlast: true, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+						PairedWith: 0,
+						Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+						Recharge:   nil,
+						DmgMod:     0,
+						MinDmg:     0,
+						Usage:      atk.UsageAlways,
+						Area:       atk.AreaZone,
+						MaxTargets: 0,
 					},
 				},
 				STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-				IsDetachment: false,
+				IsDetachment: false, Conditions: nil,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil, Zone: atk.ZoneEngaged,
 			},
-			pickedAttackIdx: 0,
+			action: act.Attack(0),
 			defenders: []creat.Creature{
 				{
 					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-1", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 			want: []uint{0, 1},
@@ -189,19 +287,25 @@ func TestFirstAlive(t *testing.T) {
 				ID: "player-0", Name: "John Appleseed",
 				Attacks: []atk.Attack{spear},
 				STR:     8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-				IsDetachment: true,
+				IsDetachment: true, Conditions: nil,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil, Zone: atk.ZoneEngaged,
 			},
-			pickedAttackIdx: 0,
+			action: act.Attack(0),
 			defenders: []creat.Creature{
 				{
 					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-1", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: false,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 			},
 			want: []uint{0, 1},
@@ -212,19 +316,214 @@ func TestFirstAlive(t *testing.T) {
 				ID: "player-0", Name: "John Appleseed",
 				Attacks: []atk.Attack{spear},
 				STR:     8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-				IsDetachment: true,
+				IsDetachment: true, Conditions: nil,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil, Zone: atk.ZoneEngaged,
 			},
-			pickedAttackIdx: 0,
+			action: act.Attack(0),
 			defenders: []creat.Creature{
 				{
 					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: true,
+					IsDetachment: true, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
 				},
 				{
 					ID: "monster-1", Name: "Root Goblin", Attacks: []atk.Attack{spear},
 					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
-					IsDetachment: true,
+					IsDetachment: true, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
+				},
+			},
+			want: []uint{0, 1},
+		},
+		{
+			name: "PickSeveralDefendersForBlastSpell",
+			attacker: creat.Creature{
+				ID: "player-0", Name: "Merlin", Attacks: []atk.Attack{spear},
+				Spellbooks: []spell.Book{fireball}, Conditions: nil,
+				STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+				IsDetachment: false, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil, Zone: atk.ZoneEngaged,
+			},
+			action: act.Spell(0, false),
+			defenders: []creat.Creature{
+				{
+					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
+				},
+				{
+					ID: "monster-1", Name: "Root Goblin", Attacks: []atk.Attack{spear},
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
+				},
+			},
+			want: []uint{0, 1},
+		},
+		{
+			name: "PickOnlyDetachmentsForAttackVsDetachments",
+			attacker: creat.Creature{
+				ID: "player-0", Name: "John Appleseed", Attacks: []atk.Attack{pike},
+				STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+				IsDetachment: false, Conditions: nil,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil, Zone: atk.ZoneEngaged,
+			},
+			action: act.Attack(0),
+			defenders: []creat.Creature{
+				{
+					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+					IsDetachment: false, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
+				},
+				{
+					ID: "monster-1", Name: "Root Goblin", Attacks: []atk.Attack{spear},
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+					IsDetachment: true, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
+				},
+				{
+					ID: "monster-2", Name: "Root Goblin", Attacks: []atk.Attack{spear},
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+					IsDetachment: true, Conditions: nil,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil, Zone: atk.ZoneEngaged,
+				},
+			},
+			want: []uint{1},
+		},
+		{
+			name: "SkipDefendersOutOfReach",
+			attacker: creat.Creature{
+				ID: "player-0", Name: "John Appleseed", Attacks: []atk.Attack{spear},
+				STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+				IsDetachment: false, Conditions: nil, Zone: atk.ZoneEngaged,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil,
+			},
+			action: act.Attack(0),
+			defenders: []creat.Creature{
+				{
+					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+					IsDetachment: false, Conditions: nil, Zone: atk.ZoneNear,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil,
+				},
+				{
+					ID: "monster-1", Name: "Root Goblin", Attacks: []atk.Attack{spear},
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+					IsDetachment: false, Conditions: nil, Zone: atk.ZoneEngaged,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil,
+				},
+			},
+			want: []uint{1},
+		},
+		{
+			name: "NoDefendersInReach",
+			attacker: creat.Creature{
+				ID: "player-0", Name: "John Appleseed", Attacks: []atk.Attack{spear},
+				STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+				IsDetachment: false, Conditions: nil, Zone: atk.ZoneEngaged,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil,
+			},
+			action: act.Attack(0),
+			defenders: []creat.Creature{
+				{
+					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+					IsDetachment: false, Conditions: nil, Zone: atk.ZoneNear,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil,
+				},
+				{
+					ID: "monster-1", Name: "Root Goblin", Attacks: []atk.Attack{spear},
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+					IsDetachment: false, Conditions: nil, Zone: atk.ZoneFar,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil,
+				},
+			},
+			want: nil,
+		},
+		{
+			name: "PickDefendersInSingleZoneForBlastAttack",
+			attacker: creat.Creature{
+				ID: "player-0", Name: "John Appleseed", Attacks: []atk.Attack{volley},
+				STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+				IsDetachment: false, Conditions: nil, Zone: atk.ZoneEngaged,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil,
+			},
+			action: act.Attack(0),
+			defenders: []creat.Creature{
+				{
+					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+					IsDetachment: false, Conditions: nil, Zone: atk.ZoneNear,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil,
+				},
+				{
+					ID: "monster-1", Name: "Root Goblin", Attacks: []atk.Attack{spear},
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+					IsDetachment: false, Conditions: nil, Zone: atk.ZoneFar,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil,
+				},
+				{
+					ID: "monster-2", Name: "Root Goblin", Attacks: []atk.Attack{spear},
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+					IsDetachment: false, Conditions: nil, Zone: atk.ZoneNear,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil,
+				},
+			},
+			want: []uint{0, 2},
+		},
+		{
+			name: "PickUpToMaxTargetsInAnyZoneForBlastAttack",
+			attacker: creat.Creature{
+				ID: "player-0", Name: "John Appleseed", Attacks: []atk.Attack{hail},
+				STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+				IsDetachment: false, Conditions: nil, Zone: atk.ZoneEngaged,
+				Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+				Items: nil,
+			},
+			action: act.Attack(0),
+			defenders: []creat.Creature{
+				{
+					ID: "monster-0", Name: "Root Goblin", Attacks: []atk.Attack{spear},
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+					IsDetachment: false, Conditions: nil, Zone: atk.ZoneNear,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil,
+				},
+				{
+					ID: "monster-1", Name: "Root Goblin", Attacks: []atk.Attack{spear},
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+					IsDetachment: false, Conditions: nil, Zone: atk.ZoneFar,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil,
+				},
+				{
+					ID: "monster-2", Name: "Root Goblin", Attacks: []atk.Attack{spear},
+					STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+					IsDetachment: false, Conditions: nil, Zone: atk.ZoneNear,
+					Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil,
+					Items: nil,
 				},
 			},
 			want: []uint{0, 1},
@@ -232,10 +531,94 @@ func TestFirstAlive(t *testing.T) {
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			got := FirstAlive(test.attacker, test.pickedAttackIdx, test.defenders)
+			got := FirstAlive(test.attacker, test.action, nil, test.defenders)
 			if !slices.Equal(got, test.want) {
 				t.Fatalf("PickTargetsFirstAlive() = %v, want %v", got, test.want)
 			}
 		})
 	}
 }
+
+func TestFirstAliveSupport(t *testing.T) {
+	spear := atk.Attack{
+		Name: "Spear", TargetCharacteristic: atk.STR,
+		Dice: dice.D6, DiceCnt: 1, Charges: -1,
+		IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone, PairedWith: 0,
+		Inflicts: atk.Condition{Kind: atk.ConditionNone, Rounds: 0}, Recharge: nil,
+		DmgMod: 0, MinDmg: 0, Usage: atk.UsageAlways,
+		Area: atk.AreaZone, MaxTargets: 0,
+	}
+	potion := act.Support{
+		Name: "Healing Potion", Kind: act.SupportHeal,
+		Dice: dice.D6, DiceCnt: 1, Rounds: 0, Charges: 1,
+	}
+	cleric := creat.Creature{
+		ID: "player-0", Name: "Tuck", Attacks: []atk.Attack{spear},
+		STR: 8, DEX: 14, WIL: 8, HP: 4, Armor: 0,
+		IsDetachment: false, Conditions: nil,
+		Spellbooks: nil, Fatigue: 0, IsDeprived: false,
+		Supports: []act.Support{potion}, Items: nil, Zone: atk.ZoneEngaged,
+	}
+	outAlly := creat.Creature{
+		ID: "player-1", Name: "John Appleseed", Attacks: []atk.Attack{spear},
+		STR: 0, DEX: 14, WIL: 8, HP: 0, Armor: 0,
+		IsDetachment: false, Conditions: nil,
+		Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil, Items: nil,
+		Zone: atk.ZoneEngaged,
+	}
+	ally := creat.Creature{
+		ID: "player-2", Name: "Jane Appleseed", Attacks: []atk.Attack{spear},
+		STR: 8, DEX: 14, WIL: 8, HP: 2, Armor: 0,
+		IsDetachment: false, Conditions: nil,
+		Spellbooks: nil, Fatigue: 0, IsDeprived: false, Supports: nil, Items: nil,
+		Zone: atk.ZoneEngaged,
+	}
+	emptyPotion := potion
+	emptyPotion.Charges = 0
+	clericWithEmptyPotion := cleric.DeepCopy()
+	clericWithEmptyPotion.Supports = []act.Support{emptyPotion}
+	tests := []struct {
+		name   string
+		allies []creat.Creature
+		want   []uint
+		actor  creat.Creature
+		action act.Action
+	}{
+		{
+			name:   "SkipOutAlly",
+			actor:  cleric,
+			action: act.Use(0),
+			allies: []creat.Creature{outAlly, ally, cleric},
+			want:   []uint{1},
+		},
+		{
+			name:   "NoCharges",
+			actor:  clericWithEmptyPotion,
+			action: act.Use(0),
+			allies: []creat.Creature{ally, clericWithEmptyPotion},
+			want:   nil,
+		},
+		{
+			name:   "UnknownSupport",
+			actor:  cleric,
+			action: act.Use(1),
+			allies: []creat.Creature{ally, cleric},
+			want:   nil,
+		},
+		{
+			name:   "None",
+			actor:  cleric,
+			action: act.None(),
+			allies: []creat.Creature{ally, cleric},
+			want:   nil,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := FirstAlive(test.actor, test.action, test.allies, nil)
+			if !slices.Equal(got, test.want) {
+				t.Fatalf("FirstAlive() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,97 @@
+// Package spell provides spellbooks - inventory items holding a single spell
+// each. Casting a spell costs Fatigue instead of charges.
+package spell
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/rozag/cabasi/atk"
+	"github.com/rozag/cabasi/internal/codec"
+)
+
+const (
+	// FatigueCost is the Fatigue taken to cast a spell.
+	FatigueCost = 1
+	// EnhancedFatigueCost is the Fatigue taken to cast an enhanced spell.
+	EnhancedFatigueCost = 2
+)
+
+// Book represents a spellbook. Effect is the attack the spell makes when cast,
+// it has infinite charges because casting is limited by Fatigue only.
+type Book struct {
+	Name   string
+	Effect atk.Attack
+}
+
+// String returns the string representation of the Book.
+func (b *Book) String() string {
+	return fmt.Sprintf("Book{Name: %q, Effect: %s}", b.Name, b.Effect.String())
+}
+
+// Validate checks if the freshly created spellbook is valid. It returns an
+// error with `Unwrap() []error` method to get all the errors or `nil` if the
+// spellbook is valid. The errors are reported as *codec.FieldError, e.g.
+// "effect.diceCnt".
+func (b *Book) Validate() error {
+	var errs []error
+
+	if len(b.Name) == 0 {
+		errs = append(
+			errs, codec.WrapField("name", errors.New("spellbook must have a name")),
+		)
+	}
+
+	if err := b.Effect.Validate(); err != nil {
+		errs = append(errs, codec.WrapField("effect", err))
+	}
+
+	if b.Effect.Charges >= 0 {
+		errs = append(errs, codec.WrapField(
+			"effect.charges", errors.New("effect must have infinite charges"),
+		))
+	}
+
+	if b.Effect.PairedWith != 0 {
+		errs = append(errs, codec.WrapField(
+			"effect.pairedWith", errors.New("effect cannot be paired"),
+		))
+	}
+
+	if b.Effect.Recharge != nil {
+		errs = append(errs, codec.WrapField(
+			"effect.recharge", errors.New("effect cannot recharge"),
+		))
+	}
+
+	return errors.Join(errs...)
+}
+
+// Cast returns the attack the spell makes. An enhanced spell rolls an extra
+// die.
+func (b *Book) Cast(isEnhanced bool) atk.Attack {
+	effect := b.Effect.DeepCopy()
+	if isEnhanced && effect.DiceCnt < math.MaxUint8 {
+		effect.DiceCnt++
+	}
+	return effect
+}
+
+// FatigueCost returns the Fatigue taken to cast the spell.
+func (b *Book) FatigueCost(isEnhanced bool) uint8 {
+	if isEnhanced {
+		return EnhancedFatigueCost
+	}
+	return FatigueCost
+}
+
+// Equals checks if the Book is equal to the other Book.
+func (b *Book) Equals(other *Book) bool {
+	return b.Name == other.Name && b.Effect.Equals(&other.Effect)
+}
+
+// DeepCopy creates a deep copy of the Book.
+func (b *Book) DeepCopy() Book {
+	return Book{Name: b.Name, Effect: b.Effect.DeepCopy()}
+}
@@ -0,0 +1,211 @@
+package spell
+
+import (
+	"testing"
+
+	"github.com/rozag/cabasi/atk"
+	"github.com/rozag/cabasi/dice"
+)
+
+func TestBookValidate(t *testing.T) {
+	fireball := Book{
+		Name: "Fireball",
+		Effect: atk.Attack{
+			Name: "Fireball", TargetCharacteristic: atk.STR,
+			Dice: dice.D8, DiceCnt: 1, Charges: -1,
+			IsBlast: true, Pool: atk.PoolHighest, Traits: atk.TraitMagical,
+			PairedWith: 0,
+			Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+			Recharge:   nil,
+			DmgMod:     0,
+			MinDmg:     0,
+			Usage:      atk.UsageAlways,
+			Area:       atk.AreaZone,
+			MaxTargets: 0,
+		},
+	}
+	tests := []struct {
+		name       string
+		modify     func(b *Book)
+		wantErrCnt uint
+	}{
+		{name: "ValidBook", modify: func(*Book) {}, wantErrCnt: 0},
+		{name: "EmptyName", modify: func(b *Book) { b.Name = "" }, wantErrCnt: 1},
+		{
+			name:       "InvalidEffect",
+			modify:     func(b *Book) { b.Effect.DiceCnt = 0 },
+			wantErrCnt: 1,
+		},
+		{
+			name:       "EffectWithCharges",
+			modify:     func(b *Book) { b.Effect.Charges = 3 },
+			wantErrCnt: 1,
+		},
+		{
+			name:       "PairedEffect",
+			modify:     func(b *Book) { b.Effect.PairedWith = 1 },
+			wantErrCnt: 1,
+		},
+		{
+			name: "RechargingEffect",
+			modify: func(b *Book) {
+				b.Effect.Charges = 1
+				b.Effect.Recharge = &atk.Recharge{
+					Kind: atk.RechargeOnRest, Rounds: 0, Dice: 0, Threshold: 0,
+					MaxCharges: 1, Elapsed: 0,
+				}
+			},
+			wantErrCnt: 2,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			book := fireball.DeepCopy()
+			test.modify(&book)
+			err := book.Validate()
+
+			if test.wantErrCnt == 0 {
+				if err != nil {
+					t.Fatalf("Book.Validate(): want nil, got %v", err)
+				} else {
+					return
+				}
+			}
+
+			if err == nil {
+				t.Fatalf("Book.Validate(): want error, got nil")
+			}
+
+			jointErr, ok := err.(interface{ Unwrap() []error })
+			if !ok {
+				t.Fatalf("Book.Validate(): error must have `Unwrap() []error` method")
+			}
+
+			errs := jointErr.Unwrap()
+			if uint(len(errs)) != test.wantErrCnt {
+				t.Fatalf(
+					"Book.Validate(): want %d errors, got %d: %v",
+					test.wantErrCnt, len(errs), err,
+				)
+			}
+		})
+	}
+}
+
+func TestBookCast(t *testing.T) {
+	book := Book{
+		Name: "Fireball",
+		Effect: atk.Attack{
+			Name: "Fireball", TargetCharacteristic: atk.STR,
+			Dice: dice.D8, DiceCnt: 1, Charges: -1,
+			IsBlast: true, Pool: atk.PoolHighest, Traits: atk.TraitMagical,
+			PairedWith: 0,
+			Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+			Recharge:   nil,
+			DmgMod:     0,
+			MinDmg:     0,
+			Usage:      atk.UsageAlways,
+			Area:       atk.AreaZone,
+			MaxTargets: 0,
+		},
+	}
+
+	if got := book.Cast(false); !got.Equals(&book.Effect) {
+		t.Errorf("Book.Cast(false) = %v, want %v", &got, &book.Effect)
+	}
+
+	enhanced := book.Cast(true)
+	if enhanced.DiceCnt != 2 {
+		t.Errorf("Book.Cast(true): want 2 dice, got %d", enhanced.DiceCnt)
+	}
+	if book.Effect.DiceCnt != 1 {
+		t.Errorf("Book.Cast(true): effect mutated: %v", &book.Effect)
+	}
+}
+
+func TestBookFatigueCost(t *testing.T) {
+	book := Book{
+		Name: "Fireball",
+		Effect: atk.Attack{
+			Name: "Fireball", TargetCharacteristic: atk.STR,
+			Dice: dice.D8, DiceCnt: 1, Charges: -1,
+			IsBlast: true, Pool: atk.PoolHighest, Traits: atk.TraitMagical,
+			PairedWith: 0,
+			Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+			Recharge:   nil,
+			DmgMod:     0,
+			MinDmg:     0,
+			Usage:      atk.UsageAlways,
+			Area:       atk.AreaZone,
+			MaxTargets: 0,
+		},
+	}
+	if got := book.FatigueCost(false); got != FatigueCost {
+		t.Errorf("Book.FatigueCost(false) = %d, want %d", got, FatigueCost)
+	}
+	if got := book.FatigueCost(true); got != EnhancedFatigueCost {
+		t.Errorf("Book.FatigueCost(true) = %d, want %d", got, EnhancedFatigueCost)
+	}
+}
+
+func TestBookEquals(t *testing.T) {
+	this := Book{
+		Name: "Fireball",
+		Effect: atk.Attack{
+			Name: "Fireball", TargetCharacteristic: atk.STR,
+			Dice: dice.D8, DiceCnt: 1, Charges: -1,
+			IsBlast: true, Pool: atk.PoolHighest, Traits: atk.TraitMagical,
+			PairedWith: 0,
+			Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+			Recharge:   nil,
+			DmgMod:     0,
+			MinDmg:     0,
+			Usage:      atk.UsageAlways,
+			Area:       atk.AreaZone,
+			MaxTargets: 0,
+		},
+	}
+	other := this.DeepCopy()
+	if !this.Equals(&other) {
+		t.Errorf("Book.Equals() = false, want true")
+	}
+
+	other.Effect.Dice = dice.D10
+	if this.Equals(&other) {
+		t.Errorf("Book.Equals() = true, want false")
+	}
+}
+
+func TestBookDeepCopy(t *testing.T) {
+	original := Book{
+		Name: "Fireball",
+		Effect: atk.Attack{
+			Name: "Fireball", TargetCharacteristic: atk.STR,
+			Dice: dice.D8, DiceCnt: 1, Charges: -1,
+			IsBlast: true, Pool: atk.PoolHighest, Traits: atk.TraitMagical,
+			PairedWith: 0,
+			Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+			Recharge:   nil,
+			DmgMod:     0,
+			MinDmg:     0,
+			Usage:      atk.UsageAlways,
+			Area:       atk.AreaZone,
+			MaxTargets: 0,
+		},
+	}
+	copied := original.DeepCopy()
+
+	if !original.Equals(&copied) {
+		t.Fatalf("Book.DeepCopy() = %v, want %v", &copied, &original)
+	}
+
+	copied.Name = "Lightning Bolt"
+	copied.Effect.Name = "Lightning Bolt"
+
+	if original.Name == copied.Name {
+		t.Errorf("original.Name == copied.Name")
+	}
+	if original.Effect.Equals(&copied.Effect) {
+		t.Errorf("original.Effect == copied.Effect")
+	}
+}
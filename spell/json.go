@@ -0,0 +1,63 @@
+package spell
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/rozag/cabasi/atk"
+	"github.com/rozag/cabasi/internal/codec"
+)
+
+// bookJSON is the JSON encoding of a Book.
+type bookJSON struct {
+	Name   string          `json:"name"`
+	Effect json.RawMessage `json:"effect"`
+}
+
+// MarshalJSON implements json.Marshaler, the effect is encoded as an
+// atk.Attack.
+func (b *Book) MarshalJSON() ([]byte, error) {
+	var errs []error
+
+	effect, err := b.Effect.MarshalJSON()
+	if err != nil {
+		errs = append(errs, codec.WrapField("effect", err))
+	}
+
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	data, err := json.Marshal(bookJSON{Name: b.Name, Effect: effect})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode spellbook: %w", err)
+	}
+	return data, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Errors of the fields are
+// reported as *codec.FieldError. The decoded spellbook isn't validated, see
+// Validate.
+func (b *Book) UnmarshalJSON(data []byte) error {
+	var raw bookJSON
+	if err := codec.DecodeJSON(data, &raw); err != nil {
+		// Suppressing wrapcheck "error returned from external package is
+		// unwrapped" because the error already reports the failed field.
+		return err // nolint:wrapcheck
+	}
+
+	var errs []error
+
+	var effect atk.Attack
+	if err := effect.UnmarshalJSON(raw.Effect); err != nil {
+		errs = append(errs, codec.WrapField("effect", err))
+	}
+
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	*b = Book{Name: raw.Name, Effect: effect}
+	return nil
+}
@@ -0,0 +1,78 @@
+package spell
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/rozag/cabasi/atk"
+	"github.com/rozag/cabasi/dice"
+	"github.com/rozag/cabasi/internal/codec"
+)
+
+func TestBookJSONRoundTrip(t *testing.T) {
+	book := Book{
+		Name: "Fireball",
+		Effect: atk.Attack{
+			Name: "Fireball", TargetCharacteristic: atk.STR,
+			Dice: dice.D8, DiceCnt: 1, Charges: -1,
+			IsBlast: true, Pool: atk.PoolHighest, Traits: atk.TraitMagical,
+			PairedWith: 0,
+			Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+			Recharge:   nil,
+			DmgMod:     0,
+			MinDmg:     0,
+			Usage:      atk.UsageAlways,
+			Area:       atk.AreaZone,
+			MaxTargets: 0,
+		},
+	}
+	data, err := json.Marshal(&book)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+
+	var decoded Book
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("json.Unmarshal(%s) error = %v", data, err)
+	}
+	if !decoded.Equals(&book) {
+		t.Fatalf(
+			"json.Unmarshal(%s) = %s, want %s",
+			data, decoded.String(), book.String(),
+		)
+	}
+}
+
+func TestBookUnmarshalJSONErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     string
+		wantPath string
+	}{
+		{
+			name: "InvalidEffect",
+			data: `{"name": "Fireball", ` +
+				`"effect": {"name": "Fireball", "dice": "d8", "area": "Cone"}}`,
+			wantPath: "effect.area",
+		},
+		{
+			name:     "UnknownField",
+			data:     `{"name": "Fireball", "school": "Evocation"}`,
+			wantPath: "school",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var got Book
+			err := got.UnmarshalJSON([]byte(test.data))
+			var fieldErr *codec.FieldError
+			if !errors.As(err, &fieldErr) || fieldErr.Path != test.wantPath {
+				t.Fatalf(
+					"Book.UnmarshalJSON() error = %v, want %s field error",
+					err, test.wantPath,
+				)
+			}
+		})
+	}
+}
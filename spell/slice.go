@@ -0,0 +1,43 @@
+package spell
+
+import "strings"
+
+// BookSlice is a `[]Book` with helper methods.
+type BookSlice []Book
+
+// String returns the string representation of the BookSlice.
+func (bs BookSlice) String() string {
+	var sb strings.Builder
+	sb.WriteString("[]Book{")
+	for i, book := range bs {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(book.String())
+	}
+	sb.WriteString("}")
+	return sb.String()
+}
+
+// Equals checks if the BookSlice is equal to the other BookSlice.
+func (bs BookSlice) Equals(other BookSlice) bool {
+	if bs == nil && other == nil {
+		return true
+	}
+
+	if bs == nil || other == nil {
+		return false
+	}
+
+	if len(bs) != len(other) {
+		return false
+	}
+
+	for i := range bs {
+		if !bs[i].Equals(&other[i]) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,60 @@
+package spell
+
+import (
+	"testing"
+
+	"github.com/rozag/cabasi/atk"
+	"github.com/rozag/cabasi/dice"
+)
+
+func TestBookSliceEquals(t *testing.T) {
+	fireball := Book{
+		Name: "Fireball",
+		Effect: atk.Attack{
+			Name: "Fireball", TargetCharacteristic: atk.STR,
+			Dice: dice.D8, DiceCnt: 1, Charges: -1,
+			IsBlast: true, Pool: atk.PoolHighest, Traits: atk.TraitMagical,
+			PairedWith: 0,
+			Inflicts:   atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+			Recharge:   nil,
+			DmgMod:     0,
+			MinDmg:     0,
+			Usage:      atk.UsageAlways,
+			Area:       atk.AreaZone,
+			MaxTargets: 0,
+		},
+	}
+	bolt := fireball.DeepCopy()
+	bolt.Name = "Lightning Bolt"
+	tests := []struct {
+		name        string
+		this, other BookSlice
+		want        bool
+	}{
+		{name: "EqualNil", this: nil, other: nil, want: true},
+		{name: "EqualEmpty", this: BookSlice{}, other: BookSlice{}, want: true},
+		{name: "NilNotEqualToEmpty", this: nil, other: BookSlice{}, want: false},
+		{
+			name: "EqualNormal",
+			this: BookSlice{fireball, bolt}, other: BookSlice{fireball, bolt},
+			want: true,
+		},
+		{
+			name: "DifferentLengths",
+			this: BookSlice{fireball}, other: BookSlice{fireball, bolt},
+			want: false,
+		},
+		{
+			name: "DifferentBooks",
+			this: BookSlice{fireball}, other: BookSlice{bolt},
+			want: false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.this.Equals(test.other); got != test.want {
+				t.Fatalf("BookSlice.Equals() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
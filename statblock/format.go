@@ -0,0 +1,199 @@
+package statblock
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/rozag/cabasi/atk"
+	"github.com/rozag/cabasi/creat"
+	"github.com/rozag/cabasi/internal/codec"
+)
+
+// Format renders the creature as a canonical stat block Parse understands: the
+// name (if any), HP, Armor (if any), STR, DEX, WIL, "detachment" (if it is
+// one), and attacks. An attack lists its dice, target characteristic (if not
+// STR), "blast", traits, minimum damage (if any), and charges (if limited).
+// The ID is left out, Parse leaves it empty for the caller to assign.
+//
+// Format returns an error if Parse wouldn't give the creature back, e.g. for
+// recharge rules stat blocks can't express or for names with the separators
+// of a stat block. Errors of the fields are reported as *codec.FieldError,
+// e.g. "attacks[0].recharge".
+func Format(c *creat.Creature) (string, error) {
+	if err := checkFormat(c); err != nil {
+		return "", err
+	}
+
+	parts := []string{fmt.Sprintf("%d HP", c.HP)}
+	if c.Armor > 0 {
+		parts = append(parts, fmt.Sprintf("%d Armor", c.Armor))
+	}
+	parts = append(
+		parts,
+		fmt.Sprintf("%d STR", c.STR),
+		fmt.Sprintf("%d DEX", c.DEX),
+		fmt.Sprintf("%d WIL", c.WIL),
+	)
+	if c.IsDetachment {
+		parts = append(parts, "detachment")
+	}
+	for i := range c.Attacks {
+		parts = append(parts, formatAttack(&c.Attacks[i]))
+	}
+
+	text := strings.Join(parts, ", ")
+	if len(c.Name) > 0 {
+		text = c.Name + ": " + text
+	}
+	return text, nil
+}
+
+// inexpressible is the error message of a property stat blocks can't express.
+const inexpressible = "stat blocks can't express it"
+
+// checkFormat checks that the stat block of the creature parses back to the
+// creature, see Format.
+func checkFormat(c *creat.Creature) error {
+	var errs []error
+
+	if err := checkName(c.Name, ",():"); err != nil {
+		errs = append(errs, codec.WrapField("name", err))
+	}
+	if c.EffectiveMaxHP() != c.HP {
+		errs = append(errs, codec.WrapField("maxHP", fmt.Errorf(
+			"max HP must equal HP %d, got %d", c.HP, c.MaxHP,
+		)))
+	}
+
+	for _, field := range []struct {
+		name  string
+		isSet bool
+	}{
+		{name: "spellbooks", isSet: len(c.Spellbooks) > 0},
+		{name: "supports", isSet: len(c.Supports) > 0},
+		{name: "items", isSet: len(c.Items) > 0},
+		{name: "conditions", isSet: len(c.Conditions) > 0},
+		{name: "scars", isSet: len(c.Scars) > 0},
+		{name: "fatigue", isSet: c.Fatigue > 0},
+		{name: "zone", isSet: c.Zone != atk.ZoneEngaged},
+		{name: "isDeprived", isSet: c.IsDeprived},
+	} {
+		if field.isSet {
+			errs = append(
+				errs, codec.WrapField(field.name, errors.New(inexpressible)),
+			)
+		}
+	}
+
+	for idx := range c.Attacks {
+		if err := checkAttackFormat(&c.Attacks[idx]); err != nil {
+			path := fmt.Sprintf("attacks[%d]", idx)
+			errs = append(errs, codec.WrapField(path, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// checkAttackFormat checks that the attack parses back from its stat block,
+// see Format.
+func checkAttackFormat(a *atk.Attack) error {
+	var errs []error
+
+	if len(a.Name) == 0 {
+		errs = append(
+			errs, codec.WrapField("name", errors.New("name must not be empty")),
+		)
+	} else if err := checkName(a.Name, ",()"); err != nil {
+		errs = append(errs, codec.WrapField("name", err))
+	}
+
+	for _, field := range []struct {
+		name  string
+		isSet bool
+	}{
+		{name: "charges", isSet: a.Charges < -1},
+		{name: "pool", isSet: a.Pool != atk.PoolHighest},
+		{name: "usage", isSet: a.Usage != atk.UsageAlways},
+		{name: "inflicts", isSet: a.Inflicts.Kind != atk.ConditionNone},
+		{name: "recharge", isSet: a.Recharge != nil},
+		{name: "pairedWith", isSet: a.PairedWith != 0},
+		{name: "area", isSet: a.Area != atk.AreaZone},
+		{name: "maxTargets", isSet: a.MaxTargets != 0},
+	} {
+		if field.isSet {
+			errs = append(
+				errs, codec.WrapField(field.name, errors.New(inexpressible)),
+			)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// checkName checks that the name parses back as is - it has none of the
+// separators and no white space around it.
+func checkName(name, separators string) error {
+	if strings.ContainsAny(name, separators) {
+		return fmt.Errorf(
+			"name must not contain any of %q, got %q", separators, name,
+		)
+	}
+	if trimmed := strings.Trim(name, " \t\n"); trimmed != name {
+		return fmt.Errorf("name must not have white space around, got %q", name)
+	}
+	return nil
+}
+
+// formatAttack renders the attack as "name (properties)".
+func formatAttack(a *atk.Attack) string {
+	dice := fmt.Sprintf("d%d", uint8(a.Dice))
+	if a.DiceCnt != 1 {
+		dice = fmt.Sprintf("%d%s", a.DiceCnt, dice)
+	}
+	if a.DmgMod != 0 {
+		dice = fmt.Sprintf("%s%+d", dice, a.DmgMod)
+	}
+
+	props := []string{dice}
+	if a.TargetCharacteristic != atk.STR {
+		props = append(props, a.TargetCharacteristic.String())
+	}
+	if a.IsBlast {
+		props = append(props, "blast")
+	}
+	for _, trait := range traits() {
+		if a.Traits.Has(trait) {
+			props = append(props, words(trait.String()))
+		}
+	}
+	if a.MinDmg > 0 {
+		props = append(props, fmt.Sprintf("min %d", a.MinDmg))
+	}
+	switch {
+	case a.Charges == 1:
+		props = append(props, "1 charge")
+	case a.Charges >= 0:
+		props = append(props, fmt.Sprintf("%d charges", a.Charges))
+	}
+
+	return fmt.Sprintf("%s (%s)", a.Name, strings.Join(props, ", "))
+}
+
+// words splits the CamelCase name into lowercase words, e.g. "armor piercing"
+// for "ArmorPiercing".
+func words(name string) string {
+	var sb strings.Builder
+	for idx, r := range name {
+		if unicode.IsUpper(r) {
+			if idx > 0 {
+				sb.WriteByte(' ')
+			}
+			r = unicode.ToLower(r)
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
@@ -0,0 +1,282 @@
+package statblock
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/rozag/cabasi/atk"
+	"github.com/rozag/cabasi/creat"
+	"github.com/rozag/cabasi/dice"
+	"github.com/rozag/cabasi/internal/codec"
+)
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		name     string
+		creature creat.Creature
+		want     string
+	}{
+		{
+			name: "Individual",
+			creature: creat.Creature{
+				ID: "", Name: "Root Goblin",
+				Attacks: []atk.Attack{
+					{
+						Name: "spear", TargetCharacteristic: atk.STR,
+						Dice: dice.D6, DiceCnt: 1, Charges: -1,
+						IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+						PairedWith: 0, Recharge: nil, DmgMod: 0, MinDmg: 0,
+						Usage: atk.UsageAlways, Area: atk.AreaZone, MaxTargets: 0,
+						Inflicts: atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+					},
+				},
+				Spellbooks: nil, Supports: nil, Items: nil, Conditions: nil,
+				STR: 8, DEX: 14, WIL: 8, HP: 4, MaxHP: 4, Scars: nil,
+				Armor: 0, Fatigue: 0, Zone: atk.ZoneEngaged,
+				IsDetachment: false, IsDeprived: false,
+			},
+			want: "Root Goblin: 4 HP, 8 STR, 14 DEX, 8 WIL, spear (d6)",
+		},
+		{
+			name: "Nameless",
+			creature: creat.Creature{
+				ID: "", Name: "",
+				Attacks: []atk.Attack{
+					{
+						Name: "bite", TargetCharacteristic: atk.STR,
+						Dice: dice.D8, DiceCnt: 1, Charges: -1,
+						IsBlast: true, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+						PairedWith: 0, Recharge: nil, DmgMod: 0, MinDmg: 0,
+						Usage: atk.UsageAlways, Area: atk.AreaZone, MaxTargets: 0,
+						Inflicts: atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+					},
+				},
+				Spellbooks: nil, Supports: nil, Items: nil, Conditions: nil,
+				STR: 12, DEX: 10, WIL: 10, HP: 2, MaxHP: 2, Scars: nil,
+				Armor: 1, Fatigue: 0, Zone: atk.ZoneEngaged,
+				IsDetachment: false, IsDeprived: false,
+			},
+			want: "2 HP, 1 Armor, 12 STR, 10 DEX, 10 WIL, bite (d8, blast)",
+		},
+		{
+			name: "AllProperties",
+			creature: creat.Creature{
+				ID: "", Name: "Dragon",
+				Attacks: []atk.Attack{
+					{
+						Name: "claws", TargetCharacteristic: atk.STR,
+						Dice: dice.D10, DiceCnt: 1, Charges: 3,
+						IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+						PairedWith: 0, Recharge: nil, DmgMod: -1, MinDmg: 0,
+						Usage: atk.UsageAlways, Area: atk.AreaZone, MaxTargets: 0,
+						Inflicts: atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+					},
+					{
+						Name: "fire breath", TargetCharacteristic: atk.WIL,
+						Dice: dice.D12, DiceCnt: 2, Charges: 1,
+						IsBlast: true, Pool: atk.PoolHighest,
+						Traits: atk.TraitMagical | atk.TraitArmorPiercing, PairedWith: 0,
+						Recharge: nil, DmgMod: 1, MinDmg: 2,
+						Usage: atk.UsageAlways, Area: atk.AreaZone, MaxTargets: 0,
+						Inflicts: atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+					},
+				},
+				Spellbooks: nil, Supports: nil, Items: nil, Conditions: nil,
+				STR: 18, DEX: 14, WIL: 16, HP: 18, MaxHP: 18, Scars: nil,
+				Armor: 3, Fatigue: 0, Zone: atk.ZoneEngaged,
+				IsDetachment: false, IsDeprived: false,
+			},
+			want: "Dragon: 18 HP, 3 Armor, 18 STR, 14 DEX, 16 WIL, " +
+				"claws (d10-1, 3 charges), fire breath (2d12+1, WIL, blast, " +
+				"armor piercing, magical, min 2, 1 charge)",
+		},
+		{
+			name: "Detachment",
+			creature: creat.Creature{
+				ID: "", Name: "Bandit Gang",
+				Attacks: []atk.Attack{
+					{
+						Name: "shortswords", TargetCharacteristic: atk.STR,
+						Dice: dice.D6, DiceCnt: 1, Charges: -1,
+						IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+						PairedWith: 0, Recharge: nil, DmgMod: 0, MinDmg: 0,
+						Usage: atk.UsageAlways, Area: atk.AreaZone, MaxTargets: 0,
+						Inflicts: atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+					},
+				},
+				Spellbooks: nil, Supports: nil, Items: nil, Conditions: nil,
+				STR: 10, DEX: 10, WIL: 10, HP: 8, MaxHP: 8, Scars: nil,
+				Armor: 0, Fatigue: 0, Zone: atk.ZoneEngaged,
+				IsDetachment: true, IsDeprived: false,
+			},
+			want: "Bandit Gang: 8 HP, 10 STR, 10 DEX, 10 WIL, detachment, " +
+				"shortswords (d6)",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := Format(&test.creature)
+			if err != nil {
+				t.Fatalf("Format(): want nil error, got %v", err)
+			}
+			if got != test.want {
+				t.Fatalf("Format() = %q, want %q", got, test.want)
+			}
+
+			parsed, err := Parse(got)
+			if err != nil {
+				t.Fatalf("Parse(Format()): want nil error, got %v", err)
+			}
+			if !parsed.Equals(&test.creature) {
+				t.Fatalf("Parse(Format()) = %v, want %v", &parsed, &test.creature)
+			}
+		})
+	}
+}
+
+func TestFormatRoundTrip(t *testing.T) {
+	goblin := creat.Creature{
+		ID: "", Name: "Root Goblin",
+		Attacks: []atk.Attack{
+			{
+				Name: "spear", TargetCharacteristic: atk.STR,
+				Dice: dice.D6, DiceCnt: 1, Charges: -1,
+				IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+				PairedWith: 0, Recharge: nil, DmgMod: 0, MinDmg: 0,
+				Usage: atk.UsageAlways, Area: atk.AreaZone, MaxTargets: 0,
+				Inflicts: atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+			},
+			{
+				Name: "kick", TargetCharacteristic: atk.STR,
+				Dice: dice.D4, DiceCnt: 1, Charges: -1,
+				IsBlast: true, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+				PairedWith: 0, Recharge: nil, DmgMod: 0, MinDmg: 0,
+				Usage: atk.UsageAlways, Area: atk.AreaZone, MaxTargets: 0,
+				Inflicts: atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+			},
+		},
+		Spellbooks: nil, Supports: nil, Items: nil, Conditions: nil,
+		STR: 8, DEX: 14, WIL: 8, HP: 4, MaxHP: 0, Scars: nil,
+		Armor: 0, Fatigue: 0, Zone: atk.ZoneEngaged,
+		IsDetachment: false, IsDeprived: false,
+	}
+	tests := []struct {
+		name      string
+		modify    func(c *creat.Creature)
+		wantPaths []string
+	}{
+		{
+			name:      "MaxHPNotSet",
+			modify:    func(*creat.Creature) {},
+			wantPaths: nil,
+		},
+		{
+			name:      "MaxHPEqualToHP",
+			modify:    func(c *creat.Creature) { c.MaxHP = c.HP },
+			wantPaths: nil,
+		},
+		{
+			name:      "MaxHPAboveHP",
+			modify:    func(c *creat.Creature) { c.MaxHP = 6 },
+			wantPaths: []string{"maxHP"},
+		},
+		{
+			name: "NamesWithSeparators",
+			modify: func(c *creat.Creature) {
+				c.Name = "Goblin: the Elder"
+				c.Attacks[0].Name = "spear, long"
+				c.Attacks[1].Name = "kick (low)"
+			},
+			wantPaths: []string{"name", "attacks[0].name", "attacks[1].name"},
+		},
+		{
+			name: "NamesWithWhiteSpaceAround",
+			modify: func(c *creat.Creature) {
+				c.Name = "Root Goblin "
+				c.Attacks[0].Name = " spear"
+			},
+			wantPaths: []string{"name", "attacks[0].name"},
+		},
+		{
+			name: "AttackProperties",
+			modify: func(c *creat.Creature) {
+				c.Attacks[0].Pool = atk.PoolSum
+				c.Attacks[0].Usage = atk.UsageVsDetachments
+				c.Attacks[0].Inflicts = atk.Condition{
+					Kind: atk.ConditionPoisoned, Rounds: 2,
+				}
+				c.Attacks[0].Recharge = &atk.Recharge{
+					Kind: atk.RechargeEveryNRounds, Dice: 0, Rounds: 1, Threshold: 0,
+					MaxCharges: 1, Elapsed: 0,
+				}
+				c.Attacks[0].Charges = 0
+				c.Attacks[1].PairedWith = 1
+				c.Attacks[1].Area = atk.AreaAll
+				c.Attacks[1].MaxTargets = 2
+			},
+			wantPaths: []string{
+				"attacks[0].pool", "attacks[0].usage", "attacks[0].inflicts",
+				"attacks[0].recharge", "attacks[1].pairedWith", "attacks[1].area",
+				"attacks[1].maxTargets",
+			},
+		},
+		{
+			name: "CreatureProperties",
+			modify: func(c *creat.Creature) {
+				c.Conditions = []atk.Condition{
+					{Kind: atk.ConditionBlinded, Rounds: 1},
+				}
+				c.Scars = []creat.Scar{{Detail: "", Kind: creat.ScarDoomed}}
+				c.Fatigue = 1
+				c.Zone = atk.ZoneNear
+				c.IsDeprived = true
+			},
+			wantPaths: []string{
+				"conditions", "scars", "fatigue", "zone", "isDeprived",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := goblin.DeepCopy()
+			test.modify(&c)
+
+			text, err := Format(&c)
+			if paths := fieldPaths(err); !slices.Equal(paths, test.wantPaths) {
+				t.Fatalf(
+					"Format(): want paths %v, got %v (%v)",
+					test.wantPaths, paths, err,
+				)
+			}
+			if err != nil {
+				return
+			}
+
+			parsed, err := Parse(text)
+			if err != nil {
+				t.Fatalf("Parse(Format()): want nil error, got %v", err)
+			}
+			c.MaxHP = c.EffectiveMaxHP()
+			if !parsed.Equals(&c) {
+				t.Fatalf("Parse(Format()) = %v, want %v", &parsed, &c)
+			}
+		})
+	}
+}
+
+func fieldPaths(err error) []string {
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		var result []string
+		for _, err := range joined.Unwrap() {
+			result = append(result, fieldPaths(err)...)
+		}
+		return result
+	}
+
+	var fieldErr *codec.FieldError
+	if errors.As(err, &fieldErr) {
+		return []string{fieldErr.Path}
+	}
+	return nil
+}
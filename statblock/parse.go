@@ -0,0 +1,415 @@
+// Package statblock parses and formats Cairn-style stat blocks - one-liners
+// published adventures give monsters as, e.g.
+//
+//	Root Goblin: 4 HP, 8 STR, 14 DEX, 8 WIL, spear (d6)
+//
+// A stat block is an optional name followed by a colon and a comma-separated
+// list of parts: characteristics ("8 STR", "1 Armor", "4 HP"), the word
+// "detachment", and attacks. An attack is a name followed by its properties in
+// parentheses: dice ("d6", "2d8", "d6+1"), the target characteristic ("WIL"),
+// "blast", traits ("ranged", "armor piercing"), charges ("1 charge",
+// "3 charges"), and the minimum damage ("min 2").
+package statblock
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/rozag/cabasi/atk"
+	"github.com/rozag/cabasi/creat"
+	"github.com/rozag/cabasi/dice"
+)
+
+// DefaultCharacteristic is the value of a characteristic missing from a stat
+// block.
+const DefaultCharacteristic = 10
+
+// ParseError is an error in a stat block. Offset is the byte offset of the
+// erroneous part in the text.
+type ParseError struct {
+	Msg    string
+	Offset int
+}
+
+// Error returns the error message prefixed with the offset.
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("offset %d: %s", e.Offset, e.Msg)
+}
+
+// span is a part of a stat block along with its byte offset in the text.
+type span struct {
+	text   string
+	offset int
+}
+
+// Parse parses the stat block text into a creature. The creature's ID is left
+// empty for the caller to assign. Missing STR, DEX, and WIL are
+// DefaultCharacteristic, missing Armor is 0, while HP and at least one attack
+// are required, max HP is the HP. Every attack has unlimited charges unless
+// stated otherwise.
+//
+// Parse doesn't validate the creature, see creat.Creature.Validate. It returns
+// an error with `Unwrap() []error` method to get all the errors, each of them
+// is a *ParseError.
+func Parse(text string) (creat.Creature, error) {
+	creature := creat.Creature{
+		ID: "", Name: "", Attacks: nil, Spellbooks: nil, Supports: nil,
+		Items: nil, Conditions: nil,
+		STR: DefaultCharacteristic, DEX: DefaultCharacteristic,
+		WIL: DefaultCharacteristic, HP: 0,
+		MaxHP: 0, Scars: nil, Armor: 0, Fatigue: 0,
+		Zone: atk.ZoneEngaged, IsDetachment: false, IsDeprived: false,
+	}
+	var errs []error
+
+	body := span{text: text, offset: 0}
+	if idx := strings.IndexByte(text, ':'); idx >= 0 &&
+		!strings.ContainsAny(text[:idx], ",()") {
+		name := trim(span{text: text[:idx], offset: 0})
+		if len(name.text) == 0 {
+			errs = append(errs, newError(name, "name must not be empty"))
+		}
+		creature.Name = name.text
+		body = span{text: text[idx+1:], offset: idx + 1}
+	}
+
+	parts, err := split(body)
+	if err != nil {
+		return creature, errors.Join(append(errs, err)...)
+	}
+
+	seen := make(map[string]struct{})
+	hasHP := false
+	for _, part := range parts {
+		switch {
+		case len(part.text) == 0:
+			errs = append(errs, newError(part, "empty part"))
+		case strings.HasSuffix(part.text, ")"):
+			attack, attackErrs := parseAttack(part)
+			errs = append(errs, attackErrs...)
+			creature.Attacks = append(creature.Attacks, attack)
+		case strings.EqualFold(part.text, "detachment"):
+			if _, ok := seen["detachment"]; ok {
+				errs = append(errs, newError(part, "duplicate detachment"))
+			}
+			seen["detachment"] = struct{}{}
+			creature.IsDetachment = true
+		default:
+			value, stat, err := parseValue(part)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+
+			key := strings.ToUpper(stat.text)
+			if _, ok := seen[key]; ok {
+				errs = append(errs, newError(stat, "duplicate "+stat.text))
+				continue
+			}
+			seen[key] = struct{}{}
+
+			switch key {
+			case "HP":
+				creature.HP, creature.MaxHP, hasHP = value, value, true
+			case "ARMOR":
+				creature.Armor = value
+			case "STR":
+				creature.STR = value
+			case "DEX":
+				creature.DEX = value
+			case "WIL":
+				creature.WIL = value
+			default:
+				errs = append(errs, newError(stat, "unknown characteristic "+
+					strconv.Quote(stat.text)))
+			}
+		}
+	}
+
+	if !hasHP {
+		errs = append(errs, newError(body, "HP is required"))
+	}
+	if len(creature.Attacks) == 0 {
+		errs = append(errs, newError(body, "at least one attack is required"))
+	}
+
+	return creature, errors.Join(errs...)
+}
+
+// parseAttack parses an attack written as "name (properties)".
+func parseAttack(part span) (atk.Attack, []error) {
+	attack := atk.Attack{
+		Name: "", TargetCharacteristic: atk.STR,
+		Dice: 0, DiceCnt: 0, Charges: -1,
+		IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+		PairedWith: 0, Recharge: nil, DmgMod: 0, MinDmg: 0,
+		Usage: atk.UsageAlways, Area: atk.AreaZone, MaxTargets: 0,
+		Inflicts: atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+	}
+
+	open := strings.IndexByte(part.text, '(')
+	if open < 0 {
+		return attack, []error{newError(part, "unbalanced parentheses")}
+	}
+
+	var errs []error
+	name := trim(span{text: part.text[:open], offset: part.offset})
+	if len(name.text) == 0 {
+		errs = append(errs, newError(name, "attack must have a name"))
+	}
+	attack.Name = name.text
+
+	inner := span{
+		text:   part.text[open+1 : len(part.text)-1],
+		offset: part.offset + open + 1,
+	}
+	props, err := split(inner)
+	if err != nil {
+		return attack, append(errs, err)
+	}
+
+	seen := make(map[string]struct{})
+	isValid := true
+	for _, prop := range props {
+		key, err := parseProperty(prop, &attack)
+		if err != nil {
+			errs = append(errs, err)
+			isValid = false
+			continue
+		}
+
+		if _, ok := seen[key]; ok {
+			errs = append(errs, newError(prop, "duplicate "+key))
+		}
+		seen[key] = struct{}{}
+	}
+
+	// Invalid properties may be misspelled dice, so the missing dice are
+	// reported only if all the properties are valid.
+	if _, ok := seen["dice"]; !ok && isValid {
+		errs = append(errs, newError(name, "attack must have dice"))
+	}
+
+	return attack, errs
+}
+
+// parseProperty parses a single attack property into the attack and returns
+// the key of the property used to detect duplicates.
+func parseProperty(prop span, attack *atk.Attack) (string, error) {
+	lower := strings.ToLower(prop.text)
+	if len(lower) == 0 {
+		return "", newError(prop, "empty property")
+	}
+
+	if lower == "blast" {
+		attack.IsBlast = true
+		return lower, nil
+	}
+
+	for _, c := range []atk.Characteristic{atk.STR, atk.DEX, atk.WIL} {
+		if strings.EqualFold(prop.text, c.String()) {
+			attack.TargetCharacteristic = c
+			return "target", nil
+		}
+	}
+
+	if trait, ok := parseTrait(lower); ok {
+		attack.Traits |= trait
+		return lower, nil
+	}
+
+	const minPrefix = "min "
+	if rest, ok := strings.CutPrefix(lower, minPrefix); ok {
+		value, err := parseUint8(
+			span{text: rest, offset: prop.offset + len(minPrefix)},
+		)
+		if err != nil {
+			return "", err
+		}
+		attack.MinDmg = value
+		return "min", nil
+	}
+
+	if value, unit, err := parseValue(prop); err == nil &&
+		(strings.EqualFold(unit.text, "charge") ||
+			strings.EqualFold(unit.text, "charges")) {
+		if value > math.MaxInt8 {
+			return "", newError(prop, "too many charges")
+		}
+		// Suppressing gosec "G115: integer overflow conversion uint8 -> int8"
+		// because the value is checked to be in [0,MaxInt8].
+		attack.Charges = int8(value) //nolint:gosec
+		return "charges", nil
+	}
+
+	if err := parseDice(prop, attack); err != nil {
+		return "", err
+	}
+	return "dice", nil
+}
+
+// parseDice parses dice written as "d6", "2d6", or "d6+1" into the attack.
+// Properties that don't look like dice - digits, "d", and a digit - are
+// reported as unknown properties, e.g. a misspelled trait.
+func parseDice(prop span, attack *atk.Attack) error {
+	lower := strings.ToLower(prop.text)
+	cntText, rest, ok := strings.Cut(lower, "d")
+	if !ok || !isDigits(cntText) || len(rest) == 0 || !isDigits(rest[:1]) {
+		return newError(prop, "unknown property "+strconv.Quote(prop.text))
+	}
+
+	cnt := uint8(1)
+	if len(cntText) > 0 {
+		value, err := parseUint8(span{text: cntText, offset: prop.offset})
+		if err != nil {
+			return err
+		}
+		cnt = value
+	}
+
+	sidesText, modText := rest, ""
+	if idx := strings.IndexAny(rest, "+-"); idx >= 0 {
+		sidesText, modText = rest[:idx], rest[idx:]
+	}
+
+	sides, err := strconv.ParseUint(sidesText, 10, 8)
+	// Suppressing gosec "G115: integer overflow conversion uint64 -> uint8"
+	// because ParseUint is limited to 8 bits.
+	d := dice.Dice(sides) //nolint:gosec
+	if err != nil || !isKnownDice(d) {
+		return newError(prop, "unknown dice "+strconv.Quote(prop.text))
+	}
+
+	mod := int64(0)
+	if len(modText) > 0 {
+		mod, err = strconv.ParseInt(modText, 10, 8)
+		if err != nil {
+			return newError(prop, "invalid modifier "+strconv.Quote(modText))
+		}
+	}
+
+	// Suppressing gosec "G115: integer overflow conversion int64 -> int8"
+	// because ParseInt is limited to 8 bits.
+	attack.Dice, attack.DiceCnt, attack.DmgMod = d, cnt, int8(mod) //nolint:gosec
+	return nil
+}
+
+// isDigits checks if the text consists of ASCII digits only, an empty text
+// does.
+func isDigits(text string) bool {
+	return len(strings.Trim(text, "0123456789")) == 0
+}
+
+// isKnownDice checks if the dice is one of the known dice.
+func isKnownDice(d dice.Dice) bool {
+	switch d {
+	case dice.D4, dice.D6, dice.D8, dice.D10, dice.D12, dice.D20:
+		return true
+	default:
+		return false
+	}
+}
+
+// parseTrait parses a single trait written in lowercase, with or without
+// spaces between words, e.g. "armor piercing".
+func parseTrait(lower string) (atk.Traits, bool) {
+	for _, trait := range traits() {
+		if strings.ReplaceAll(lower, " ", "") == strings.ToLower(trait.String()) {
+			return trait, true
+		}
+	}
+	return atk.TraitNone, false
+}
+
+// traits returns all the single known traits in their canonical order.
+func traits() []atk.Traits {
+	return []atk.Traits{
+		atk.TraitArmorPiercing, atk.TraitBulky, atk.TraitRanged,
+		atk.TraitReach, atk.TraitSilvered, atk.TraitMagical,
+	}
+}
+
+// parseValue parses a part written as "number unit", e.g. "8 STR".
+func parseValue(part span) (uint8, span, error) {
+	var none span
+	numText, unitText, ok := strings.Cut(part.text, " ")
+	if !ok {
+		return 0, none, newError(part, "unknown part "+strconv.Quote(part.text))
+	}
+
+	value, err := parseUint8(span{text: numText, offset: part.offset})
+	if err != nil {
+		return 0, none, err
+	}
+
+	unit := trim(span{text: unitText, offset: part.offset + len(numText) + 1})
+	return value, unit, nil
+}
+
+// parseUint8 parses a number from 0 to 255.
+func parseUint8(part span) (uint8, error) {
+	value, err := strconv.ParseUint(part.text, 10, 8)
+	if err != nil {
+		return 0, newError(part, "invalid number "+strconv.Quote(part.text))
+	}
+	// Suppressing gosec "G115: integer overflow conversion uint64 -> uint8"
+	// because ParseUint is limited to 8 bits.
+	return uint8(value), nil //nolint:gosec
+}
+
+// split splits the span by the commas outside parentheses and trims the
+// parts. It reports unbalanced parentheses.
+func split(s span) ([]span, error) {
+	var parts []span
+	depth, start, open := 0, 0, 0
+	for idx, r := range s.text {
+		switch r {
+		case '(':
+			if depth == 0 {
+				open = idx
+			}
+			depth++
+		case ')':
+			depth--
+			if depth < 0 {
+				return nil, newError(
+					span{text: ")", offset: s.offset + idx}, "unbalanced parentheses",
+				)
+			}
+		case ',':
+			if depth == 0 {
+				parts = append(parts, trim(span{
+					text: s.text[start:idx], offset: s.offset + start,
+				}))
+				start = idx + 1
+			}
+		}
+	}
+
+	if depth > 0 {
+		return nil, newError(
+			span{text: "(", offset: s.offset + open}, "unbalanced parentheses",
+		)
+	}
+
+	parts = append(parts, trim(span{
+		text: s.text[start:], offset: s.offset + start,
+	}))
+	return parts, nil
+}
+
+// trim trims the white space around the span's text keeping its offset
+// pointing at the first remaining byte.
+func trim(s span) span {
+	trimmed := strings.TrimLeft(s.text, " \t\n")
+	offset := s.offset + len(s.text) - len(trimmed)
+	return span{text: strings.TrimRight(trimmed, " \t\n"), offset: offset}
+}
+
+// newError creates a new *ParseError at the span's offset.
+func newError(s span, msg string) *ParseError {
+	return &ParseError{Msg: msg, Offset: s.offset}
+}
@@ -0,0 +1,230 @@
+package statblock
+
+import (
+	"errors"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/rozag/cabasi/atk"
+	"github.com/rozag/cabasi/creat"
+	"github.com/rozag/cabasi/dice"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want creat.Creature
+	}{
+		{
+			name: "Individual",
+			text: "Root Goblin: 4 HP, 8 STR, 14 DEX, 8 WIL, spear (d6)",
+			want: creat.Creature{
+				ID: "", Name: "Root Goblin",
+				Attacks: []atk.Attack{
+					{
+						Name: "spear", TargetCharacteristic: atk.STR,
+						Dice: dice.D6, DiceCnt: 1, Charges: -1,
+						IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+						PairedWith: 0, Recharge: nil, DmgMod: 0, MinDmg: 0,
+						Usage: atk.UsageAlways, Area: atk.AreaZone, MaxTargets: 0,
+						Inflicts: atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+					},
+				},
+				Spellbooks: nil, Supports: nil, Items: nil, Conditions: nil,
+				STR: 8, DEX: 14, WIL: 8, HP: 4, MaxHP: 4, Scars: nil,
+				Armor: 0, Fatigue: 0, Zone: atk.ZoneEngaged,
+				IsDetachment: false, IsDeprived: false,
+			},
+		},
+		{
+			name: "NamelessWithDefaults",
+			text: "2 HP, 1 Armor, 12 STR, bite (d8, blast)",
+			want: creat.Creature{
+				ID: "", Name: "",
+				Attacks: []atk.Attack{
+					{
+						Name: "bite", TargetCharacteristic: atk.STR,
+						Dice: dice.D8, DiceCnt: 1, Charges: -1,
+						IsBlast: true, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+						PairedWith: 0, Recharge: nil, DmgMod: 0, MinDmg: 0,
+						Usage: atk.UsageAlways, Area: atk.AreaZone, MaxTargets: 0,
+						Inflicts: atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+					},
+				},
+				Spellbooks: nil, Supports: nil, Items: nil, Conditions: nil,
+				STR: 12, DEX: 10, WIL: 10, HP: 2, MaxHP: 2, Scars: nil,
+				Armor: 1, Fatigue: 0, Zone: atk.ZoneEngaged,
+				IsDetachment: false, IsDeprived: false,
+			},
+		},
+		{
+			name: "AllProperties",
+			text: "Dragon: 18 hp, 3 armor, 18 str, 14 dex, 16 wil, " +
+				"claws (D10-1, 3 charges), fire breath (2d12+1, WIL, blast, " +
+				"magical, armorpiercing, min 2, 1 charge)",
+			want: creat.Creature{
+				ID: "", Name: "Dragon",
+				Attacks: []atk.Attack{
+					{
+						Name: "claws", TargetCharacteristic: atk.STR,
+						Dice: dice.D10, DiceCnt: 1, Charges: 3,
+						IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+						PairedWith: 0, Recharge: nil, DmgMod: -1, MinDmg: 0,
+						Usage: atk.UsageAlways, Area: atk.AreaZone, MaxTargets: 0,
+						Inflicts: atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+					},
+					{
+						Name: "fire breath", TargetCharacteristic: atk.WIL,
+						Dice: dice.D12, DiceCnt: 2, Charges: 1,
+						IsBlast: true, Pool: atk.PoolHighest,
+						Traits: atk.TraitMagical | atk.TraitArmorPiercing, PairedWith: 0,
+						Recharge: nil, DmgMod: 1, MinDmg: 2,
+						Usage: atk.UsageAlways, Area: atk.AreaZone, MaxTargets: 0,
+						Inflicts: atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+					},
+				},
+				Spellbooks: nil, Supports: nil, Items: nil, Conditions: nil,
+				STR: 18, DEX: 14, WIL: 16, HP: 18, MaxHP: 18, Scars: nil,
+				Armor: 3, Fatigue: 0, Zone: atk.ZoneEngaged,
+				IsDetachment: false, IsDeprived: false,
+			},
+		},
+		{
+			name: "Detachment",
+			text: "  Bandit Gang :8 HP,detachment,  shortswords ( d6 )  ",
+			want: creat.Creature{
+				ID: "", Name: "Bandit Gang",
+				Attacks: []atk.Attack{
+					{
+						Name: "shortswords", TargetCharacteristic: atk.STR,
+						Dice: dice.D6, DiceCnt: 1, Charges: -1,
+						IsBlast: false, Pool: atk.PoolHighest, Traits: atk.TraitNone,
+						PairedWith: 0, Recharge: nil, DmgMod: 0, MinDmg: 0,
+						Usage: atk.UsageAlways, Area: atk.AreaZone, MaxTargets: 0,
+						Inflicts: atk.Condition{Kind: atk.ConditionNone, Rounds: 0},
+					},
+				},
+				Spellbooks: nil, Supports: nil, Items: nil, Conditions: nil,
+				STR: 10, DEX: 10, WIL: 10, HP: 8, MaxHP: 8, Scars: nil,
+				Armor: 0, Fatigue: 0, Zone: atk.ZoneEngaged,
+				IsDetachment: true, IsDeprived: false,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := Parse(test.text)
+			if err != nil {
+				t.Fatalf("Parse(): want nil error, got %v", err)
+			}
+			if !got.Equals(&test.want) {
+				t.Fatalf("Parse() = %v, want %v", &got, &test.want)
+			}
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		name        string
+		text        string
+		wantOffsets []int
+	}{
+		{
+			name: "UnknownPart", text: "Goblin: 4 HP, spear",
+			wantOffsets: []int{14, 7},
+		},
+		{
+			name: "DuplicateCharacteristic", text: "4 HP, 4 hp, spear (d6)",
+			wantOffsets: []int{8},
+		},
+		{
+			name: "UnknownCharacteristic", text: "4 HP, 8 CON, spear (d6)",
+			wantOffsets: []int{8},
+		},
+		{
+			name: "InvalidNumber", text: "300 HP, spear (d6)",
+			wantOffsets: []int{0, 0},
+		},
+		{name: "EmptyName", text: ": 4 HP, spear (d6)", wantOffsets: []int{0}},
+		{name: "EmptyPart", text: "4 HP, , spear (d6)", wantOffsets: []int{6}},
+		{name: "UnclosedParen", text: "4 HP, spear (d6", wantOffsets: []int{12}},
+		{name: "UnopenedParen", text: "4 HP, spear d6)", wantOffsets: []int{14}},
+		{name: "UnnamedAttack", text: "4 HP, (d6)", wantOffsets: []int{6}},
+		{
+			name: "AttackWithoutDice", text: "4 HP, bite (blast)",
+			wantOffsets: []int{6},
+		},
+		{name: "UnknownDice", text: "4 HP, spear (d7)", wantOffsets: []int{13}},
+		{
+			name: "InvalidModifier", text: "4 HP, spear (d6+200)",
+			wantOffsets: []int{13},
+		},
+		{
+			name: "DuplicateDice", text: "4 HP, spear (d6, d8)",
+			wantOffsets: []int{17},
+		},
+		{
+			name: "UnknownProperty", text: "4 HP, spear (d6, sharp)",
+			wantOffsets: []int{17},
+		},
+		{
+			name: "TooManyCharges", text: "4 HP, spear (d6, 200 charges)",
+			wantOffsets: []int{17},
+		},
+		{name: "Empty", text: "", wantOffsets: []int{0, 0, 0}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := Parse(test.text)
+			if err == nil {
+				t.Fatalf("Parse(): want error, got nil")
+			}
+
+			jointErr, ok := err.(interface{ Unwrap() []error })
+			if !ok {
+				t.Fatalf("Parse(): error must have `Unwrap() []error` method")
+			}
+
+			var offsets []int
+			for _, err := range jointErr.Unwrap() {
+				var parseErr *ParseError
+				if !errors.As(err, &parseErr) {
+					t.Fatalf("Parse(): want *ParseError, got %T: %v", err, err)
+				}
+				offsets = append(offsets, parseErr.Offset)
+			}
+			if !slices.Equal(offsets, test.wantOffsets) {
+				t.Fatalf(
+					"Parse(): want errors at offsets %v, got %v: %v",
+					test.wantOffsets, offsets, err,
+				)
+			}
+		})
+	}
+}
+
+func TestParseErrorsUnknownProperty(t *testing.T) {
+	for _, prop := range []string{"shielded", "d", "2d", "xd6", "dd6"} {
+		t.Run(prop, func(t *testing.T) {
+			_, err := Parse("4 HP, spear (d6, " + prop + ")")
+
+			want := &ParseError{
+				Msg: "unknown property " + strconv.Quote(prop), Offset: 17,
+			}
+			var parseErr *ParseError
+			if !errors.As(err, &parseErr) || *parseErr != *want {
+				t.Fatalf("Parse(): want %v, got %v", want, err)
+			}
+		})
+	}
+}
+
+func TestParseErrorError(t *testing.T) {
+	err := &ParseError{Msg: "unknown dice \"d7\"", Offset: 13}
+	if got, want := err.Error(), "offset 13: unknown dice \"d7\""; got != want {
+		t.Fatalf("ParseError.Error() = %q, want %q", got, want)
+	}
+}